@@ -20,12 +20,14 @@ import (
 	"context"
 	"encoding/json"
 	"io/ioutil"
+	"math/rand"
 	"net/http"
 	"time"
 
 	"github.com/insolar/insolar/api/seedmanager"
 	"github.com/insolar/insolar/application/extractor"
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/message"
 	"github.com/insolar/insolar/insolar/reply"
 	"github.com/insolar/insolar/insolar/utils"
 	"github.com/insolar/insolar/instrumentation/inslogger"
@@ -42,6 +44,10 @@ type Request struct {
 	Seed      []byte  `json:"seed"`
 	Signature []byte  `json:"signature"`
 	LogLevel  *string `json:"logLevel,omitempty"`
+	// Async makes api return request reference right after registration. Result can be fetched by request.Get.
+	Async bool `json:"async,omitempty"`
+	// Query makes api call immutable method of member without registration in ledger. Can't be combined with Async.
+	Query bool `json:"query,omitempty"`
 }
 
 type answer struct {
@@ -113,6 +119,110 @@ func (ar *Runner) makeCall(ctx context.Context, params Request) (interface{}, er
 	return result, nil
 }
 
+func (ar *Runner) makeQueryCall(ctx context.Context, params Request) (interface{}, error) {
+	ctx, span := instracer.StartSpan(ctx, "SendQuery "+params.Method)
+	defer span.End()
+
+	reference, err := insolar.NewReferenceFromBase58(params.Reference)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ makeQueryCall ] failed to parse params.Reference")
+	}
+
+	args, err := insolar.MarshalArgs(
+		*ar.CertificateManager.GetCertificate().GetRootDomainReference(),
+		params.Method,
+		params.Params,
+		params.Seed,
+		params.Signature,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ makeQueryCall ] Can't marshal args")
+	}
+
+	res, err := ar.ContractRequester.CallImmutable(
+		ctx,
+		&message.BaseLogicMessage{Nonce: rand.Uint64()},
+		reference,
+		"Query",
+		args,
+		nil,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ makeQueryCall ] Can't send request")
+	}
+
+	result, contractErr, err := extractor.CallResponse(res.(*reply.CallMethod).Result)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ makeQueryCall ] Can't extract response")
+	}
+
+	if contractErr != nil {
+		return nil, errors.Wrap(errors.New(contractErr.S), "[ makeQueryCall ] Error in called method")
+	}
+
+	return result, nil
+}
+
+func (ar *Runner) makeAsyncCall(ctx context.Context, params Request) (*insolar.Reference, error) {
+	ctx, span := instracer.StartSpan(ctx, "SendAsyncRequest "+params.Method)
+	defer span.End()
+
+	reference, err := insolar.NewReferenceFromBase58(params.Reference)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ makeAsyncCall ] failed to parse params.Reference")
+	}
+
+	args, err := insolar.MarshalArgs(
+		*ar.CertificateManager.GetCertificate().GetRootDomainReference(),
+		params.Method,
+		params.Params,
+		params.Seed,
+		params.Signature,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ makeAsyncCall ] Can't marshal args")
+	}
+
+	res, err := ar.ContractRequester.CallMethod(
+		ctx,
+		&message.BaseLogicMessage{Nonce: rand.Uint64()},
+		true,
+		reference,
+		"Call",
+		args,
+		nil,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ makeAsyncCall ] Can't send request")
+	}
+
+	r, ok := res.(*reply.RegisterRequest)
+	if !ok {
+		return nil, errors.Errorf("[ makeAsyncCall ] unexpected reply: %#v", res)
+	}
+
+	return &r.Request, nil
+}
+
+// publishCall sends result of the call to subscribers waiting for the call seed.
+func (ar *Runner) publishCall(params Request, traceID string, result interface{}, err error) {
+	if len(params.Seed) == 0 {
+		return
+	}
+	call := CallEvent{
+		Seed:      params.Seed,
+		TraceID:   traceID,
+		Reference: params.Reference,
+		Method:    params.Method,
+		Result:    result,
+	}
+	if err != nil {
+		call.Error = err.Error()
+	}
+	ar.subscriptions.publishCall(call)
+	ar.subscriptions.checkObjects()
+}
+
 func processError(err error, extraMsg string, resp *answer, insLog insolar.Logger) {
 	resp.Error = err.Error()
 	insLog.Error(errors.Wrapf(err, "[ CallHandler ] %s", extraMsg))
@@ -175,10 +285,38 @@ func (ar *Runner) callHandler() func(http.ResponseWriter, *http.Request) {
 			return
 		}
 
+		if params.Async && params.Query {
+			processError(errors.New("async and query flags can't be used together"), "Bad request", &resp, insLog)
+			return
+		}
+
+		if params.Async {
+			request, err := ar.makeAsyncCall(ctx, params)
+			if err != nil {
+				processError(err, "Can't makeAsyncCall", &resp, insLog)
+				return
+			}
+			resp.Result = request.String()
+			// Result of the call isn't known yet, subscribers get it when it's registered.
+			ar.subscriptions.watchCall(*request, CallEvent{
+				Seed:      params.Seed,
+				TraceID:   traceID,
+				Reference: params.Reference,
+				Method:    params.Method,
+			})
+			return
+		}
+
 		var result interface{}
 		ch := make(chan interface{}, 1)
 		go func() {
-			result, err = ar.makeCall(ctx, params)
+			if params.Query {
+				result, err = ar.makeQueryCall(ctx, params)
+			} else {
+				result, err = ar.makeCall(ctx, params)
+			}
+			// Subscribers get the result even if the call has exceeded the timeout.
+			ar.publishCall(params, traceID, result, err)
 			ch <- nil
 		}()
 		select {
@@ -18,6 +18,7 @@ package api
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"net/http"
 	"testing"
@@ -28,6 +29,7 @@ import (
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/reply"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/storage/pulse"
 	"github.com/insolar/insolar/logicrunner/goplugin/foundation"
 	"github.com/insolar/insolar/platformpolicy"
 	"github.com/insolar/insolar/testutils"
@@ -37,6 +39,8 @@ import (
 
 const CallUrl = "http://localhost:19192/api/call"
 
+var asyncRequest = testutils.RandomRef()
+
 type TimeoutSuite struct {
 	suite.Suite
 	ctx   context.Context
@@ -50,10 +54,32 @@ type APIresp struct {
 	Error  string
 }
 
+// subscribe returns subscription for call events of the seed.
+func (suite *TimeoutSuite) subscribe(seed []byte) *subscription {
+	s := newSubscription()
+	s.seeds[base64.StdEncoding.EncodeToString(seed)] = struct{}{}
+	suite.api.subscriptions.subscribe(s)
+	return s
+}
+
+// callEvent waits for call event sent to the subscription.
+func (suite *TimeoutSuite) callEvent(s *subscription, timeout time.Duration) *CallEvent {
+	select {
+	case e := <-s.events:
+		suite.Equal(EventCall, e.Type)
+		call := e.Data.(CallEvent)
+		return &call
+	case <-time.After(timeout):
+		return nil
+	}
+}
+
 func (suite *TimeoutSuite) TestRunner_callHandler() {
 	seed, err := suite.api.SeedGenerator.Next()
 	suite.NoError(err)
 	suite.api.SeedManager.Add(*seed)
+	s := suite.subscribe(seed[:])
+	defer suite.api.subscriptions.unsubscribe(s)
 
 	resp, err := requester.SendWithSeed(
 		suite.ctx,
@@ -69,6 +95,11 @@ func (suite *TimeoutSuite) TestRunner_callHandler() {
 	suite.NoError(err)
 	suite.Equal("", result.Error)
 	suite.Equal("OK", result.Result)
+
+	call := suite.callEvent(s, time.Second)
+	suite.Require().NotNil(call)
+	suite.Equal("", call.Error)
+	suite.Equal("OK", call.Result)
 }
 
 func (suite *TimeoutSuite) TestRunner_callHandlerTimeout() {
@@ -76,7 +107,11 @@ func (suite *TimeoutSuite) TestRunner_callHandlerTimeout() {
 	suite.NoError(err)
 	suite.api.SeedManager.Add(*seed)
 
+	s := suite.subscribe(seed[:])
+	defer suite.api.subscriptions.unsubscribe(s)
+
 	suite.delay = true
+	defer func() { suite.delay = false }()
 	resp, err := requester.SendWithSeed(
 		suite.ctx,
 		CallUrl,
@@ -91,6 +126,80 @@ func (suite *TimeoutSuite) TestRunner_callHandlerTimeout() {
 	suite.NoError(err)
 	suite.Equal("Messagebus timeout exceeded", result.Error)
 	suite.Equal("", result.Result)
+
+	// The call is still in progress, subscriber gets its result when it's completed.
+	call := suite.callEvent(s, 25*time.Second)
+	suite.Require().NotNil(call)
+	suite.Equal("", call.Error)
+	suite.Equal("OK", call.Result)
+}
+
+func (suite *TimeoutSuite) TestRunner_callHandlerAsync() {
+	seed, err := suite.api.SeedGenerator.Next()
+	suite.NoError(err)
+	suite.api.SeedManager.Add(*seed)
+
+	resp, err := requester.SendWithSeed(
+		suite.ctx,
+		CallUrl,
+		suite.user,
+		&requester.RequestConfigJSON{Async: true},
+		seed[:],
+	)
+	suite.NoError(err)
+
+	var result APIresp
+	err = json.Unmarshal(resp, &result)
+	suite.NoError(err)
+	suite.Equal("", result.Error)
+	suite.Equal(asyncRequest.String(), result.Result)
+}
+
+func (suite *TimeoutSuite) TestRunner_callHandlerQuery() {
+	seed, err := suite.api.SeedGenerator.Next()
+	suite.NoError(err)
+	suite.api.SeedManager.Add(*seed)
+
+	resp, err := requester.SendWithSeed(
+		suite.ctx,
+		CallUrl,
+		suite.user,
+		&requester.RequestConfigJSON{Query: true},
+		seed[:],
+	)
+	suite.NoError(err)
+
+	var result APIresp
+	err = json.Unmarshal(resp, &result)
+	suite.NoError(err)
+	suite.Equal("", result.Error)
+	suite.Equal("QUERY", result.Result)
+}
+
+func (suite *TimeoutSuite) TestRunner_callHandlerAsyncQuery() {
+	seed, err := suite.api.SeedGenerator.Next()
+	suite.NoError(err)
+	suite.api.SeedManager.Add(*seed)
+	s := suite.subscribe(seed[:])
+	defer suite.api.subscriptions.unsubscribe(s)
+
+	resp, err := requester.SendWithSeed(
+		suite.ctx,
+		CallUrl,
+		suite.user,
+		&requester.RequestConfigJSON{Async: true, Query: true},
+		seed[:],
+	)
+	suite.NoError(err)
+
+	var result APIresp
+	err = json.Unmarshal(resp, &result)
+	suite.NoError(err)
+	suite.Equal("async and query flags can't be used together", result.Error)
+	suite.Equal("", result.Result)
+
+	// Rejected request isn't executed, so it isn't published.
+	suite.Nil(suite.callEvent(s, time.Second))
 }
 
 func TestTimeoutSuite(t *testing.T) {
@@ -149,8 +258,25 @@ func TestTimeoutSuite(t *testing.T) {
 		}
 	}
 
+	cr.CallMethodFunc = func(_ context.Context, _ insolar.Message, async bool, _ *insolar.Reference, method string, _ insolar.Arguments, _ *insolar.Reference) (insolar.Reply, error) {
+		require.True(t, async)
+		require.Equal(t, "Call", method)
+		return &reply.RegisterRequest{Request: asyncRequest}, nil
+	}
+
+	cr.CallImmutableFunc = func(_ context.Context, _ insolar.Message, _ *insolar.Reference, method string, _ insolar.Arguments, _ *insolar.Reference) (insolar.Reply, error) {
+		require.Equal(t, "Query", method)
+		var result = "QUERY"
+		var contractErr *foundation.Error
+		data, _ := insolar.MarshalArgs(result, contractErr)
+		return &reply.CallMethod{
+			Result: data,
+		}, nil
+	}
+
 	timeoutSuite.api.ContractRequester = cr
 	timeoutSuite.api.CertificateManager = cm
+	timeoutSuite.api.PulseNotifier = pulse.NewStorageMem()
 	timeoutSuite.api.Start(timeoutSuite.ctx)
 
 	requester.SetTimeout(25)
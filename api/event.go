@@ -0,0 +1,160 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+// EventService is a service that provides events emitted by contracts.
+type EventService struct {
+	runner *Runner
+}
+
+// NewEventService creates new Event service instance.
+func NewEventService(runner *Runner) *EventService {
+	return &EventService{runner: runner}
+}
+
+// EventGetArgs is arguments that Event.Get accepts.
+type EventGetArgs struct {
+	Object string
+	Name   string
+	From   uint32
+	To     uint32
+}
+
+// EventGetReply is reply that Event.Get returns.
+type EventGetReply struct {
+	Events []Event
+}
+
+// Event is an event emitted by a contract.
+type Event struct {
+	Name    string
+	Payload interface{}
+	Pulse   uint32
+	Result  string
+	Index   int
+}
+
+// Get returns events emitted by an object in pulse range.
+//
+//	Request structure:
+//	{
+//		"jsonrpc": "2.0",
+//		"method": "event.Get",
+//		"params": {
+//			// Reference of the object which emitted events
+//			"Object": str,
+//			// Event name, events of all names are returned if it's empty
+//			"Name": str,
+//			// Pulse range, both ends included. Zero To means no upper bound
+//			"From": int,
+//			"To": int
+//		},
+//		"id": str|int|null
+//	}
+//
+//	Response structure:
+//	{
+//		"jsonrpc": "2.0",
+//		"result": {
+//			"Events": [
+//				{
+//					"Name": str, // event name
+//					"Payload": any, // decoded event payload
+//					"Pulse": int, // pulse of the call which emitted the event
+//					"Result": str, // result record of the call
+//					"Index": int // position of the event among events of the call
+//				}
+//			]
+//		},
+//		"id": str|int|null // same as in request
+//	}
+func (s *EventService) Get(r *http.Request, args *EventGetArgs, reply *EventGetReply) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Infof("[ EventService.Get ] Incoming request: %s", r.RequestURI)
+
+	object, err := insolar.NewReferenceFromBase58(args.Object)
+	if err != nil {
+		return errors.Wrap(err, "[ EventService.Get ] failed to parse reference")
+	}
+	to := insolar.PulseNumber(args.To)
+	if to == 0 {
+		to = math.MaxUint32
+	}
+	if to < insolar.PulseNumber(args.From) {
+		return errors.New("[ EventService.Get ] To should not be less than From")
+	}
+
+	events, err := s.runner.ArtifactManager.GetEvents(ctx, *object, args.Name, insolar.PulseNumber(args.From), to)
+	if err != nil {
+		return errors.Wrap(err, "[ EventService.Get ] failed to get events")
+	}
+
+	reply.Events = make([]Event, 0, len(events))
+	for _, e := range events {
+		reply.Events = append(reply.Events, Event{
+			Name:    e.Name,
+			Payload: eventPayload(e.Payload),
+			Pulse:   uint32(e.Result.Pulse()),
+			Result:  e.Result.String(),
+			Index:   e.Index,
+		})
+	}
+
+	return nil
+}
+
+// eventPayload decodes CBOR payload of an event to a value that can be marshaled to JSON.
+// Payload which is not a valid CBOR is returned as is.
+func eventPayload(data []byte) interface{} {
+	var v interface{}
+	err := insolar.Deserialize(data, &v)
+	if err != nil {
+		return data
+	}
+	return jsonCompatible(v)
+}
+
+func jsonCompatible(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			m[fmt.Sprint(key)] = jsonCompatible(val)
+		}
+		return m
+	case []interface{}:
+		for i := range v {
+			v[i] = jsonCompatible(v[i])
+		}
+		return v
+	}
+	return v
+}
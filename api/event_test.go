@@ -0,0 +1,80 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/logicrunner/artifacts"
+)
+
+func TestEventService_Get(t *testing.T) {
+	object := gen.Reference()
+	result := gen.ID()
+
+	payload, err := insolar.Serialize(map[string]interface{}{"amount": 100, "to": "someone"})
+	require.NoError(t, err)
+
+	am := artifacts.NewClientMock(t)
+	am.GetEventsFunc = func(
+		_ context.Context, obj insolar.Reference, name string, from, to insolar.PulseNumber,
+	) ([]insolar.EmittedEvent, error) {
+		assert.Equal(t, object, obj)
+		assert.Equal(t, "Transfer", name)
+		assert.Equal(t, insolar.PulseNumber(insolar.FirstPulseNumber), from)
+		assert.Equal(t, insolar.PulseNumber(math.MaxUint32), to)
+		return []insolar.EmittedEvent{
+			{Event: insolar.Event{Name: "Transfer", Payload: payload}, Object: *obj.Record(), Result: result, Index: 0},
+			{Event: insolar.Event{Name: "Transfer", Payload: []byte{0xff}}, Object: *obj.Record(), Result: result, Index: 1},
+		}, nil
+	}
+	s := NewEventService(&Runner{ArtifactManager: am})
+
+	reply := &EventGetReply{}
+	err = s.Get(&http.Request{}, &EventGetArgs{
+		Object: object.String(),
+		Name:   "Transfer",
+		From:   insolar.FirstPulseNumber,
+	}, reply)
+	require.NoError(t, err)
+	require.Len(t, reply.Events, 2)
+
+	assert.Equal(t, "Transfer", reply.Events[0].Name)
+	assert.Equal(t, map[string]interface{}{"amount": uint64(100), "to": "someone"}, reply.Events[0].Payload)
+	assert.Equal(t, uint32(result.Pulse()), reply.Events[0].Pulse)
+	assert.Equal(t, result.String(), reply.Events[0].Result)
+	assert.Equal(t, 1, reply.Events[1].Index)
+	assert.Equal(t, []byte{0xff}, reply.Events[1].Payload)
+}
+
+func TestEventService_Get_BadArgs(t *testing.T) {
+	s := NewEventService(&Runner{})
+
+	err := s.Get(&http.Request{}, &EventGetArgs{Object: "not a reference"}, &EventGetReply{})
+	assert.Error(t, err)
+
+	err = s.Get(&http.Request{}, &EventGetArgs{Object: gen.Reference().String(), From: 20, To: 10}, &EventGetReply{})
+	assert.Error(t, err)
+}
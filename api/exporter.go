@@ -0,0 +1,132 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+// StorageExporterArgs is arguments that StorageExporter service accepts.
+type StorageExporterArgs struct {
+	From   uint32
+	To     uint32
+	Size   int
+	Cursor string
+	// JetID is a binary jet prefix, e.g. "0110".
+	JetID string
+	Types []string
+}
+
+// StorageExporterReply is reply for StorageExporter service requests.
+type StorageExporterReply = insolar.StorageExportResult
+
+// StorageExporterService is a service that provides API for exporting storage data.
+type StorageExporterService struct {
+	runner *Runner
+}
+
+// NewStorageExporterService creates new StorageExporter service instance.
+func NewStorageExporterService(runner *Runner) *StorageExporterService {
+	return &StorageExporterService{runner: runner}
+}
+
+// Export returns records of heavy material node storage for pulse range.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "exporter.Export",
+//     "params": {
+//       // Pulse range. To is included, zero To means up to the last exportable pulse
+//       "From": int,
+//       "To": int,
+//       // Maximum number of records in response, 100 by default, 1000 at most
+//       "Size": int,
+//       // Cursor from previous response to continue export
+//       "Cursor": str,
+//       // Binary jet prefix. Only records of the jet and its children are exported
+//       "JetID": str,
+//       // Record types, e.g. ["ActivateRecord", "AmendRecord"]
+//       "Types": [str]
+//     },
+//     "id": str|int|null
+//   }
+//
+//     Response structure:
+// 	{
+// 		"jsonrpc": "2.0",
+// 		"result": {
+// 			"Data": {
+// 				"<pulse number>": {
+// 					"Pulse": int,
+// 					"Timestamp": int, // pulse unix time
+// 					"Records": [
+// 						{
+// 							"ID": str, // record id
+// 							"Type": str, // record type
+// 							"JetID": str, // jet of record
+// 							"Object": str, // object id for requests, results and object states
+// 							"Data": {...}, // record fields
+// 							"Memory": {...} // decoded object memory for activation and amend records
+// 						}
+// 					]
+// 				}
+// 			},
+// 			"NextFrom": int|null, // pulse of next records, null if range is exported
+// 			"Cursor": str, // cursor for next request
+// 			"Size": int // number of exported records
+// 		},
+// 		"id": str|int|null // same as in request
+// 	}
+//
+func (s *StorageExporterService) Export(r *http.Request, args *StorageExporterArgs, reply *StorageExporterReply) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Infof("[ StorageExporterService.Export ] Incoming request: %s", r.RequestURI)
+
+	query := insolar.StorageExportQuery{
+		From:   insolar.PulseNumber(args.From),
+		To:     insolar.PulseNumber(args.To),
+		Size:   args.Size,
+		Cursor: args.Cursor,
+		Types:  args.Types,
+	}
+	if args.JetID != "" {
+		if len(args.JetID) > insolar.JetMaximumDepth || strings.Trim(args.JetID, "01") != "" {
+			return errors.New("[ StorageExporterService.Export ] JetID should be a binary prefix")
+		}
+		jetID := jet.NewIDFromString(args.JetID)
+		query.JetID = &jetID
+	}
+
+	result, err := s.runner.StorageExporter.Export(ctx, query)
+	if err != nil {
+		return errors.Wrap(err, "[ StorageExporterService.Export ]")
+	}
+
+	*reply = *result
+	return nil
+}
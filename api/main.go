@@ -50,7 +50,9 @@ type Runner struct {
 	NetworkSwitcher     insolar.NetworkSwitcher     `inject:""`
 	NodeNetwork         insolar.NodeNetwork         `inject:""`
 	PulseAccessor       pulse.Accessor              `inject:""`
+	PulseNotifier       pulse.Notifier              `inject:""`
 	ArtifactManager     artifacts.Client            `inject:""`
+	StorageExporter     insolar.StorageExporter     `inject:""`
 	server              *http.Server
 	rpcServer           *rpc.Server
 	cfg                 *configuration.APIRunner
@@ -58,6 +60,11 @@ type Runner struct {
 	cacheLock           *sync.RWMutex
 	SeedManager         *seedmanager.SeedManager
 	SeedGenerator       seedmanager.SeedGenerator
+
+	subscriptions     *subscriptions
+	stopWatch         chan struct{}
+	stopWatchOnce     sync.Once
+	unsubscribePulses func()
 }
 
 func checkConfig(cfg *configuration.APIRunner) error {
@@ -96,6 +103,26 @@ func (ar *Runner) registerServices(rpcServer *rpc.Server) error {
 		return errors.Wrap(err, "[ registerServices ] Can't RegisterService: status")
 	}
 
+	err = rpcServer.RegisterService(NewStorageExporterService(ar), "exporter")
+	if err != nil {
+		return errors.Wrap(err, "[ registerServices ] Can't RegisterService: exporter")
+	}
+
+	err = rpcServer.RegisterService(NewRequestService(ar), "request")
+	if err != nil {
+		return errors.Wrap(err, "[ registerServices ] Can't RegisterService: request")
+	}
+
+	err = rpcServer.RegisterService(NewEventService(ar), "event")
+	if err != nil {
+		return errors.Wrap(err, "[ registerServices ] Can't RegisterService: event")
+	}
+
+	err = rpcServer.RegisterService(NewRecordService(ar), "record")
+	if err != nil {
+		return errors.Wrap(err, "[ registerServices ] Can't RegisterService: record")
+	}
+
 	err = rpcServer.RegisterService(NewNodeCertService(ar), "cert")
 	if err != nil {
 		return errors.Wrap(err, "[ registerServices ] Can't RegisterService: cert")
@@ -124,6 +151,9 @@ func NewRunner(cfg *configuration.APIRunner) (*Runner, error) {
 		cfg:       cfg,
 		keyCache:  make(map[string]crypto.PublicKey),
 		cacheLock: &sync.RWMutex{},
+
+		subscriptions: newSubscriptions(),
+		stopWatch:     make(chan struct{}),
 	}
 
 	rpcServer.RegisterCodec(jsonrpc.NewCodec(), "application/json")
@@ -145,6 +175,18 @@ func (ar *Runner) Start(ctx context.Context) error {
 	ar.SeedManager = seedmanager.New()
 	http.HandleFunc(ar.cfg.Call, ar.callHandler())
 	http.Handle(ar.cfg.RPC, ar.rpcServer)
+	if ar.cfg.Subscribe != "" {
+		http.HandleFunc(ar.cfg.Subscribe, ar.subscribeHandler())
+		pulses := make(chan insolar.Pulse, pulseQueueSize)
+		ar.unsubscribePulses = ar.PulseNotifier.Subscribe(func(pulse insolar.Pulse) {
+			select {
+			case pulses <- pulse:
+			default:
+				inslogger.FromContext(ctx).Warnf("[ Start ] pulse %v is not published, queue is full", pulse.PulseNumber)
+			}
+		})
+		go ar.watchEvents(ctx, pulses, ar.stopWatch)
+	}
 	inslog := inslogger.FromContext(ctx)
 	inslog.Info("Starting ApiRunner ...")
 	inslog.Info("Config: ", ar.cfg)
@@ -164,6 +206,14 @@ func (ar *Runner) Start(ctx context.Context) error {
 func (ar *Runner) Stop(ctx context.Context) error {
 	const timeOut = 5
 
+	ar.stopWatchOnce.Do(func() {
+		if ar.unsubscribePulses != nil {
+			ar.unsubscribePulses()
+		}
+		close(ar.stopWatch)
+	})
+	ar.subscriptions.close()
+
 	inslogger.FromContext(ctx).Infof("Shutting down server gracefully ...(waiting for %d seconds)", timeOut)
 	ctxWithTimeout, cancel := context.WithTimeout(ctx, time.Duration(timeOut)*time.Second)
 	defer cancel()
@@ -26,6 +26,7 @@ import (
 	"github.com/insolar/insolar/certificate"
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/storage/pulse"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/insolar/insolar/configuration"
@@ -104,9 +105,12 @@ func TestMainTestSuite(t *testing.T) {
 
 	cm := certificate.NewCertificateManager(&certificate.Certificate{})
 	api.CertificateManager = cm
+	api.PulseNotifier = pulse.NewStorageMem()
 	api.Start(ctx)
 
 	suite.Run(t, new(MainAPISuite))
 
 	api.Stop(ctx)
+	// Repeated stop should not panic.
+	api.Stop(ctx)
 }
@@ -0,0 +1,110 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/jbenet/go-base58"
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+// RecordService is a service that provides proofs of records inclusion into jet drops.
+type RecordService struct {
+	runner *Runner
+}
+
+// NewRecordService creates new Record service instance.
+func NewRecordService(runner *Runner) *RecordService {
+	return &RecordService{runner: runner}
+}
+
+// RecordGetProofArgs is arguments that Record.GetProof accepts.
+type RecordGetProofArgs struct {
+	Record string
+}
+
+// RecordGetProofReply is reply that Record.GetProof returns.
+type RecordGetProofReply struct {
+	Pulse      uint32
+	JetID      string
+	MerkleRoot string
+	Index      uint64
+	Leaves     uint64
+	Siblings   []string
+}
+
+// GetProof returns a proof that a record is included into its jet drop.
+//
+// Proof can be checked offline with drop.VerifyRecord.
+//
+//	Request structure:
+//	{
+//		"jsonrpc": "2.0",
+//		"method": "record.GetProof",
+//		"params": {
+//			// Record id
+//			"Record": str
+//		},
+//		"id": str|int|null
+//	}
+//
+//	Response structure:
+//	{
+//		"jsonrpc": "2.0",
+//		"result": {
+//			"Pulse": int, // pulse of the drop
+//			"JetID": str, // jet of the drop
+//			"MerkleRoot": str, // base58 encoded Merkle root of the drop records
+//			"Index": int, // position of the record among sorted records of the drop
+//			"Leaves": int, // number of records in the drop
+//			"Siblings": [str] // base58 encoded sibling hashes from the leaf up to the root
+//		},
+//		"id": str|int|null // same as in request
+//	}
+func (s *RecordService) GetProof(r *http.Request, args *RecordGetProofArgs, reply *RecordGetProofReply) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Infof("[ RecordService.GetProof ] Incoming request: %s", r.RequestURI)
+
+	id, err := insolar.NewIDFromBase58(args.Record)
+	if err != nil {
+		return errors.Wrap(err, "[ RecordService.GetProof ] failed to parse record id")
+	}
+
+	dr, proof, err := s.runner.ArtifactManager.GetRecordProof(ctx, *id)
+	if err != nil {
+		return errors.Wrap(err, "[ RecordService.GetProof ] failed to get proof")
+	}
+
+	reply.Pulse = uint32(dr.Pulse)
+	reply.JetID = insolar.ID(dr.JetID).String()
+	reply.MerkleRoot = base58.Encode(dr.MerkleRoot)
+	reply.Index = proof.Index
+	reply.Leaves = proof.Leaves
+	reply.Siblings = make([]string, 0, len(proof.Siblings))
+	for _, sibling := range proof.Siblings {
+		reply.Siblings = append(reply.Siblings, base58.Encode(sibling))
+	}
+
+	return nil
+}
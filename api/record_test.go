@@ -0,0 +1,66 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/jbenet/go-base58"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/ledger/storage/drop"
+	"github.com/insolar/insolar/logicrunner/artifacts"
+	"github.com/insolar/insolar/network/merkle"
+)
+
+func TestRecordService_GetProof(t *testing.T) {
+	id := gen.ID()
+	jetID := gen.JetID()
+
+	am := artifacts.NewClientMock(t)
+	am.GetRecordProofFunc = func(_ context.Context, rec insolar.ID) (*drop.Drop, *merkle.InclusionProof, error) {
+		assert.Equal(t, id, rec)
+		return &drop.Drop{Pulse: id.Pulse(), JetID: jetID, MerkleRoot: []byte{1, 2, 3}},
+			&merkle.InclusionProof{Index: 1, Leaves: 3, Siblings: [][]byte{{4}, {5, 6}}}, nil
+	}
+	s := NewRecordService(&Runner{ArtifactManager: am})
+
+	reply := &RecordGetProofReply{}
+	err := s.GetProof(&http.Request{}, &RecordGetProofArgs{Record: id.String()}, reply)
+	require.NoError(t, err)
+
+	assert.Equal(t, uint32(id.Pulse()), reply.Pulse)
+	assert.Equal(t, insolar.ID(jetID).String(), reply.JetID)
+	assert.Equal(t, base58.Encode([]byte{1, 2, 3}), reply.MerkleRoot)
+	assert.Equal(t, uint64(1), reply.Index)
+	assert.Equal(t, uint64(3), reply.Leaves)
+	assert.Equal(t, []string{base58.Encode([]byte{4}), base58.Encode([]byte{5, 6})}, reply.Siblings)
+}
+
+func TestRecordService_GetProof_NotFound(t *testing.T) {
+	am := artifacts.NewClientMock(t)
+	am.GetRecordProofMock.Return(nil, nil, insolar.ErrNotFound)
+	s := NewRecordService(&Runner{ArtifactManager: am})
+
+	err := s.GetProof(&http.Request{}, &RecordGetProofArgs{Record: gen.ID().String()}, &RecordGetProofReply{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,148 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/application/extractor"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/insolar/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+// Request statuses returned by request.Get.
+const (
+	// RequestPending means request is registered but not executed yet.
+	RequestPending = "pending"
+	// RequestExecuted means request is executed and its result is registered.
+	RequestExecuted = "executed"
+	// RequestFailed means called method returned an error or the call has exceeded its execution budget.
+	RequestFailed = "failed"
+)
+
+// RequestService is a service that provides info about requests submitted asynchronously.
+type RequestService struct {
+	runner *Runner
+}
+
+// NewRequestService creates new Request service instance.
+func NewRequestService(runner *Runner) *RequestService {
+	return &RequestService{runner: runner}
+}
+
+// RequestGetArgs is arguments that Request.Get accepts.
+type RequestGetArgs struct {
+	Reference string
+}
+
+// RequestGetReply is reply that Request.Get returns.
+type RequestGetReply struct {
+	Status string
+	Result interface{} `json:",omitempty"`
+	Error  string      `json:",omitempty"`
+}
+
+// Get returns status and result of request by its reference.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "request.Get",
+//     "params": {
+//       // Request reference returned by async call
+//       "Reference": str
+//     },
+//     "id": str|int|null
+//   }
+//
+//     Response structure:
+// 	{
+// 		"jsonrpc": "2.0",
+// 		"result": {
+// 			"Status": "pending"|"executed"|"failed",
+// 			"Result": any, // result of executed request
+// 			"Error": str // error of failed request
+// 		},
+// 		"id": str|int|null // same as in request
+// 	}
+//
+func (s *RequestService) Get(r *http.Request, args *RequestGetArgs, reply *RequestGetReply) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Infof("[ RequestService.Get ] Incoming request: %s", r.RequestURI)
+
+	request, err := insolar.NewReferenceFromBase58(args.Reference)
+	if err != nil {
+		return errors.Wrap(err, "[ RequestService.Get ] failed to parse reference")
+	}
+
+	status, err := s.runner.requestStatus(ctx, *request)
+	if err != nil {
+		return errors.Wrap(err, "[ RequestService.Get ]")
+	}
+	*reply = *status
+
+	return nil
+}
+
+// requestStatus returns status and result of the request submitted asynchronously.
+func (ar *Runner) requestStatus(ctx context.Context, request insolar.Reference) (*RequestGetReply, error) {
+	result, payload, err := ar.ArtifactManager.GetRequestResult(ctx, request)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get request result")
+	}
+	if result == nil {
+		return &RequestGetReply{Status: RequestPending}, nil
+	}
+
+	if len(payload) == 0 {
+		return &RequestGetReply{Status: RequestExecuted}, nil
+	}
+
+	if callErr := callError(payload); callErr != nil {
+		return &RequestGetReply{Status: RequestFailed, Error: callErr.Error()}, nil
+	}
+
+	res, contractErr, err := extractor.CallResponse(payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "Can't extract response")
+	}
+	if contractErr != nil {
+		return &RequestGetReply{Status: RequestFailed, Error: contractErr.S}, nil
+	}
+
+	return &RequestGetReply{Status: RequestExecuted, Result: res}, nil
+}
+
+// callError returns an error recorded by logicrunner instead of call results,
+// e.g. when the call has exceeded its execution budget.
+func callError(payload []byte) error {
+	if insolar.ReplyType(payload[0]) != reply.TypeError {
+		return nil
+	}
+	rep, err := reply.Deserialize(bytes.NewReader(payload))
+	if err != nil {
+		return nil
+	}
+	return rep.(*reply.Error).Error()
+}
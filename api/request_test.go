@@ -0,0 +1,97 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/logicrunner/artifacts"
+	"github.com/insolar/insolar/logicrunner/goplugin/foundation"
+)
+
+func TestRequestService_Get(t *testing.T) {
+	request := gen.Reference()
+	resultID := gen.ID()
+
+	get := func(t *testing.T, result *insolar.ID, payload []byte, err error) (*RequestGetReply, error) {
+		am := artifacts.NewClientMock(t)
+		am.GetRequestResultFunc = func(_ context.Context, ref insolar.Reference) (*insolar.ID, []byte, error) {
+			assert.Equal(t, request, ref)
+			return result, payload, err
+		}
+		s := NewRequestService(&Runner{ArtifactManager: am})
+
+		reply := &RequestGetReply{}
+		getErr := s.Get(&http.Request{}, &RequestGetArgs{Reference: request.String()}, reply)
+		return reply, getErr
+	}
+
+	t.Run("pending", func(t *testing.T) {
+		reply, err := get(t, nil, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, RequestPending, reply.Status)
+	})
+
+	t.Run("executed", func(t *testing.T) {
+		var contractErr *foundation.Error
+		payload, err := insolar.MarshalArgs("OK", contractErr)
+		require.NoError(t, err)
+
+		reply, err := get(t, &resultID, payload, nil)
+		require.NoError(t, err)
+		assert.Equal(t, RequestExecuted, reply.Status)
+		assert.Equal(t, "OK", reply.Result)
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		payload, err := insolar.MarshalArgs(nil, &foundation.Error{S: "insufficient balance"})
+		require.NoError(t, err)
+
+		reply, err := get(t, &resultID, payload, nil)
+		require.NoError(t, err)
+		assert.Equal(t, RequestFailed, reply.Status)
+		assert.Equal(t, "insufficient balance", reply.Error)
+	})
+
+	t.Run("budget exceeded", func(t *testing.T) {
+		payload := reply.ToBytes(&reply.Error{ErrType: reply.ErrExecutionBudgetExceeded})
+
+		res, err := get(t, &resultID, payload, nil)
+		require.NoError(t, err)
+		assert.Equal(t, RequestFailed, res.Status)
+		assert.Equal(t, insolar.ErrExecutionBudgetExceeded.Error(), res.Error)
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		_, err := get(t, nil, nil, insolar.ErrNotFound)
+		assert.Error(t, err)
+	})
+
+	t.Run("bad reference", func(t *testing.T) {
+		s := NewRequestService(&Runner{})
+		err := s.Get(&http.Request{}, &RequestGetArgs{Reference: "not a reference"}, &RequestGetReply{})
+		assert.Error(t, err)
+	})
+}
@@ -40,6 +40,10 @@ type RequestConfigJSON struct {
 	Params   []interface{} `json:"params"`
 	Method   string        `json:"method"`
 	LogLevel interface{}   `json:"logLevel,omitempty"`
+	// Async makes api return request reference without waiting for result.
+	Async bool `json:"async,omitempty"`
+	// Query makes api call immutable method without registration in ledger.
+	Query bool `json:"query,omitempty"`
 }
 
 func readFile(path string, configType interface{}) error {
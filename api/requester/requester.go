@@ -186,6 +186,12 @@ func SendWithSeed(ctx context.Context, url string, userCfg *UserConfigJSON, reqC
 	if reqCfg.LogLevel != nil {
 		postParams["logLevel"] = reqCfg.LogLevel
 	}
+	if reqCfg.Async {
+		postParams["async"] = true
+	}
+	if reqCfg.Query {
+		postParams["query"] = true
+	}
 
 	body, err := GetResponseBody(url, postParams)
 
@@ -213,6 +219,32 @@ func Send(ctx context.Context, url string, userCfg *UserConfigJSON, reqCfg *Requ
 	return response, nil
 }
 
+// ApproveProposal co-signs pending proposal of multisig member with key from user config
+func ApproveProposal(ctx context.Context, url string, userCfg *UserConfigJSON, proposal string) ([]byte, error) {
+	response, err := Send(ctx, url, userCfg, &RequestConfigJSON{
+		Method: "ApproveProposal",
+		Params: []interface{}{proposal},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "[ ApproveProposal ]")
+	}
+
+	return response, nil
+}
+
+// GetProposals returns pending proposals of multisig member
+func GetProposals(ctx context.Context, url string, userCfg *UserConfigJSON) ([]byte, error) {
+	response, err := Send(ctx, url, userCfg, &RequestConfigJSON{
+		Method: "GetProposals",
+		Params: []interface{}{},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "[ GetProposals ]")
+	}
+
+	return response, nil
+}
+
 func getDefaultRPCParams(method string) PostParams {
 	return PostParams{
 		"jsonrpc": "2.0",
@@ -69,9 +69,12 @@ func FakeHandler(response http.ResponseWriter, req *http.Request) {
 	}
 
 	answer := map[string]interface{}{}
-	if params.Method == "CreateMember" {
+	switch params.Method {
+	case "CreateMember":
 		answer["reference"] = TESTREFERENCE
-	} else {
+	case "ApproveProposal", "GetProposals":
+		answer["result"] = params.Method
+	default:
 		answer["random_data"] = TESTSEED
 	}
 
@@ -266,3 +269,19 @@ func TestStatus(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, resp, &testStatusResponse)
 }
+
+func TestApproveProposal(t *testing.T) {
+	ctx := inslogger.ContextWithTrace(context.Background(), "TestApproveProposal")
+	userConf, _ := readConfigs(t)
+	resp, err := ApproveProposal(ctx, URL, userConf, "proposal")
+	require.NoError(t, err)
+	require.Contains(t, string(resp), "ApproveProposal")
+}
+
+func TestGetProposals(t *testing.T) {
+	ctx := inslogger.ContextWithTrace(context.Background(), "TestGetProposals")
+	userConf, _ := readConfigs(t)
+	resp, err := GetProposals(ctx, URL, userConf)
+	require.NoError(t, err)
+	require.Contains(t, string(resp), "GetProposals")
+}
@@ -223,3 +223,25 @@ func (sdk *SDK) GetBalance(m *Member) (uint64, error) {
 	// TODO FIXME don't transfer money in floats!
 	return uint64(response.Result.(float64)), nil
 }
+
+// UpgradePrototype replaces code of the given prototype, only root member is allowed to do it.
+// Returns reference to the new code.
+func (sdk *SDK) UpgradePrototype(prototype string, code []byte, machineType insolar.MachineType) (string, error) {
+	ctx := inslogger.ContextWithTrace(context.Background(), "UpgradePrototype")
+	params := []interface{}{prototype, code, machineType}
+	body, err := sdk.sendRequest(ctx, "UpgradePrototype", params, sdk.rootMember)
+	if err != nil {
+		return "", errors.Wrap(err, "[ UpgradePrototype ] can't send request")
+	}
+
+	response, err := sdk.getResponse(body)
+	if err != nil {
+		return "", errors.Wrap(err, "[ UpgradePrototype ] can't get response")
+	}
+
+	if response.Error != "" {
+		return "", errors.New(response.Error)
+	}
+
+	return response.Result.(string), nil
+}
@@ -0,0 +1,232 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+const (
+	// maxSubscriptionObjects is a maximum number of objects one subscriber can watch.
+	maxSubscriptionObjects = 100
+	// pulseQueueSize is a number of new pulses queued for publishing.
+	pulseQueueSize = 16
+	// maxStateHistory is a maximum number of intermediate object states published at once.
+	maxStateHistory = 100
+	// keepAliveInterval is an interval of comments sent to idle subscribers to keep connections open.
+	keepAliveInterval = 15 * time.Second
+)
+
+// parseSubscription parses subscription query parameters:
+//
+//	pulse=true     - subscribe to new pulses
+//	object=<ref>   - subscribe to new states of object, can be repeated
+//	seed=<base64>  - subscribe to completion of /api/call request with the seed, can be repeated
+func parseSubscription(query url.Values) (*subscription, error) {
+	s := newSubscription()
+
+	if p := query.Get("pulse"); p != "" {
+		pulses, err := strconv.ParseBool(p)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse pulse")
+		}
+		s.pulses = pulses
+	}
+
+	objects := query["object"]
+	if len(objects) > maxSubscriptionObjects {
+		return nil, errors.Errorf("too many objects, %d at most", maxSubscriptionObjects)
+	}
+	for _, o := range objects {
+		ref, err := insolar.NewReferenceFromBase58(o)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse object %s", o)
+		}
+		s.objects[*ref] = struct{}{}
+	}
+
+	for _, seed := range query["seed"] {
+		raw, err := base64.StdEncoding.DecodeString(seed)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse seed %s", seed)
+		}
+		s.seeds[base64.StdEncoding.EncodeToString(raw)] = struct{}{}
+	}
+
+	if !s.pulses && len(s.objects) == 0 && len(s.seeds) == 0 {
+		return nil, errors.New("nothing to subscribe to")
+	}
+	return s, nil
+}
+
+func writeEvent(w http.ResponseWriter, e Event) error {
+	data, err := json.Marshal(e.Data)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", e.Type, data)
+	return err
+}
+
+// subscribeHandler streams subscribed events as server-sent events.
+func (ar *Runner) subscribeHandler() func(http.ResponseWriter, *http.Request) {
+	return func(response http.ResponseWriter, req *http.Request) {
+		ctx, insLog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+		insLog.Infof("[ subscribeHandler ] Incoming request: %s", req.RequestURI)
+
+		flusher, ok := response.(http.Flusher)
+		if !ok {
+			http.Error(response, "streaming is not supported", http.StatusInternalServerError)
+			return
+		}
+
+		s, err := parseSubscription(req.URL.Query())
+		if err != nil {
+			http.Error(response, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		response.Header().Set("Content-Type", "text/event-stream")
+		response.Header().Set("Cache-Control", "no-cache")
+		response.Header().Set("Connection", "keep-alive")
+		response.WriteHeader(http.StatusOK)
+		flusher.Flush()
+
+		ar.subscriptions.subscribe(s)
+		defer ar.subscriptions.unsubscribe(s)
+
+		for ref := range s.objects {
+			ar.checkObject(ctx, ref, s)
+		}
+
+		keepAlive := time.NewTicker(keepAliveInterval)
+		defer keepAlive.Stop()
+		for {
+			select {
+			case e, ok := <-s.events:
+				if !ok {
+					return
+				}
+				if err := writeEvent(response, e); err != nil {
+					insLog.Debug(errors.Wrap(err, "[ subscribeHandler ] failed to write event"))
+					return
+				}
+			case <-keepAlive.C:
+				if _, err := fmt.Fprint(response, ": keep-alive\n\n"); err != nil {
+					return
+				}
+			case <-req.Context().Done():
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+// watchEvents publishes new pulses and checks states of watched objects on every pulse and on request. Pulses are
+// delivered by the pulse storage which PulseManager appends every new pulse to.
+func (ar *Runner) watchEvents(ctx context.Context, pulses <-chan insolar.Pulse, done <-chan struct{}) {
+	for {
+		select {
+		case <-done:
+			return
+		case <-ar.subscriptions.check:
+			ar.checkObjects(ctx)
+			ar.checkCalls(ctx)
+		case pulse := <-pulses:
+			if !ar.subscriptions.active() {
+				continue
+			}
+			ar.subscriptions.publishPulse(pulse)
+			ar.checkObjects(ctx)
+			ar.checkCalls(ctx)
+		}
+	}
+}
+
+// checkCalls publishes results of asynchronous calls which are registered since the last check.
+func (ar *Runner) checkCalls(ctx context.Context) {
+	logger := inslogger.FromContext(ctx)
+
+	for request, call := range ar.subscriptions.pendingCalls() {
+		status, err := ar.requestStatus(ctx, request)
+		if err != nil {
+			logger.Debug(errors.Wrapf(err, "[ checkCalls ] failed to get result of request %s", request.String()))
+			continue
+		}
+		if status.Status == RequestPending {
+			continue
+		}
+		call.Result = status.Result
+		call.Error = status.Error
+		ar.subscriptions.completeCall(request, call)
+	}
+}
+
+func (ar *Runner) checkObjects(ctx context.Context) {
+	for _, ref := range ar.subscriptions.objects() {
+		ar.checkObject(ctx, ref, nil)
+	}
+}
+
+// checkObject publishes new states of object. States created since the last check are published in order, so
+// watchers don't miss intermediate states. Initial subscriber gets the latest state only.
+func (ar *Runner) checkObject(ctx context.Context, ref insolar.Reference, initial *subscription) {
+	logger := inslogger.FromContext(ctx)
+
+	desc, err := ar.ArtifactManager.GetObject(ctx, ref, nil, false)
+	if err != nil {
+		logger.Debug(errors.Wrapf(err, "[ checkObject ] failed to get object %s", ref.String()))
+		return
+	}
+	known, ok := ar.subscriptions.state(ref)
+	if !ok || initial != nil {
+		ar.subscriptions.publishState(ref, *desc.StateID(), initial)
+		return
+	}
+
+	states := []insolar.ID{*desc.StateID()}
+	for len(states) < maxStateHistory {
+		prev := desc.PrevStateID()
+		if prev == nil || *prev == known {
+			break
+		}
+		desc, err = ar.ArtifactManager.GetObject(ctx, ref, prev, false)
+		if err != nil {
+			logger.Debug(errors.Wrapf(err, "[ checkObject ] failed to get state %s of object %s", prev, ref))
+			break
+		}
+		states = append(states, *prev)
+	}
+	for i := len(states) - 1; i >= 0; i-- {
+		ar.subscriptions.publishState(ref, states[i], nil)
+	}
+}
@@ -0,0 +1,332 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"encoding/base64"
+	"sync"
+
+	"github.com/insolar/insolar/insolar"
+)
+
+const (
+	// EventPulse is a type of events about new pulses.
+	EventPulse = "pulse"
+	// EventObject is a type of events about new states of objects.
+	EventObject = "object"
+	// EventCall is a type of events about completed /api/call requests.
+	EventCall = "call"
+)
+
+// subscriberBuffer is a number of events queued for a subscriber. Subscribers which don't read events fast enough
+// are disconnected.
+const subscriberBuffer = 64
+
+// Event is a message pushed to subscribers.
+type Event struct {
+	Type string
+	Data interface{}
+}
+
+// PulseEvent is sent when node gets a new pulse.
+type PulseEvent struct {
+	PulseNumber     uint32
+	PrevPulseNumber uint32
+	NextPulseNumber uint32
+	Timestamp       int64
+	Entropy         []byte
+}
+
+// ObjectEvent is sent on subscription and when object gets a new state.
+type ObjectEvent struct {
+	Reference string
+	State     string
+	PrevState string `json:",omitempty"`
+}
+
+// CallEvent is sent when request submitted via /api/call is completed. Requests which are rejected by api aren't
+// published, result of request that has exceeded api timeout is published when the request is completed.
+// Result of asynchronous request is published when it's registered on ledger.
+type CallEvent struct {
+	Seed      []byte
+	TraceID   string
+	Reference string
+	Method    string
+	Result    interface{} `json:",omitempty"`
+	Error     string      `json:",omitempty"`
+}
+
+// subscription describes events subscriber is interested in.
+type subscription struct {
+	pulses  bool
+	objects map[insolar.Reference]struct{}
+	// seeds holds base64 seeds of calls subscriber is waiting for.
+	seeds map[string]struct{}
+
+	events chan Event
+}
+
+func newSubscription() *subscription {
+	return &subscription{
+		objects: map[insolar.Reference]struct{}{},
+		seeds:   map[string]struct{}{},
+		events:  make(chan Event, subscriberBuffer),
+	}
+}
+
+// subscriptions dispatches events to subscribers and tracks states of watched objects.
+type subscriptions struct {
+	lock        sync.Mutex
+	subscribers map[*subscription]struct{}
+	states      map[insolar.Reference]insolar.ID
+	// calls holds asynchronous calls by their requests until results of the calls are published.
+	calls map[insolar.Reference]CallEvent
+
+	// check is signalled when watched objects should be checked for new states.
+	check chan struct{}
+}
+
+func newSubscriptions() *subscriptions {
+	return &subscriptions{
+		subscribers: map[*subscription]struct{}{},
+		states:      map[insolar.Reference]insolar.ID{},
+		calls:       map[insolar.Reference]CallEvent{},
+		check:       make(chan struct{}, 1),
+	}
+}
+
+func (ss *subscriptions) subscribe(s *subscription) {
+	ss.lock.Lock()
+	defer ss.lock.Unlock()
+
+	ss.subscribers[s] = struct{}{}
+}
+
+func (ss *subscriptions) unsubscribe(s *subscription) {
+	ss.lock.Lock()
+	defer ss.lock.Unlock()
+
+	ss.remove(s)
+}
+
+// active checks if there are any subscribers.
+func (ss *subscriptions) active() bool {
+	ss.lock.Lock()
+	defer ss.lock.Unlock()
+
+	return len(ss.subscribers) > 0
+}
+
+// remove drops subscriber and forgets objects nobody watches anymore. Lock should be held.
+func (ss *subscriptions) remove(s *subscription) {
+	if _, ok := ss.subscribers[s]; !ok {
+		return
+	}
+	delete(ss.subscribers, s)
+	close(s.events)
+
+	for ref := range s.objects {
+		if !ss.watched(ref) {
+			delete(ss.states, ref)
+		}
+	}
+}
+
+// watched checks if anybody watches object. Lock should be held.
+func (ss *subscriptions) watched(ref insolar.Reference) bool {
+	for s := range ss.subscribers {
+		if _, ok := s.objects[ref]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// awaited checks if anybody waits for the call with the seed. Lock should be held.
+func (ss *subscriptions) awaited(seed []byte) bool {
+	key := base64.StdEncoding.EncodeToString(seed)
+	for s := range ss.subscribers {
+		if _, ok := s.seeds[key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// state returns the latest published state of object.
+func (ss *subscriptions) state(ref insolar.Reference) (insolar.ID, bool) {
+	ss.lock.Lock()
+	defer ss.lock.Unlock()
+
+	state, ok := ss.states[ref]
+	return state, ok
+}
+
+// objects returns all watched objects.
+func (ss *subscriptions) objects() []insolar.Reference {
+	ss.lock.Lock()
+	defer ss.lock.Unlock()
+
+	var refs []insolar.Reference
+	for s := range ss.subscribers {
+		for ref := range s.objects {
+			refs = append(refs, ref)
+		}
+	}
+	return unique(refs)
+}
+
+// send queues event for subscriber. Lock should be held.
+func (ss *subscriptions) send(s *subscription, e Event) {
+	if _, ok := ss.subscribers[s]; !ok {
+		return
+	}
+	select {
+	case s.events <- e:
+	default:
+		ss.remove(s)
+	}
+}
+
+func (ss *subscriptions) publishPulse(pulse insolar.Pulse) {
+	ss.lock.Lock()
+	defer ss.lock.Unlock()
+
+	e := Event{Type: EventPulse, Data: PulseEvent{
+		PulseNumber:     uint32(pulse.PulseNumber),
+		PrevPulseNumber: uint32(pulse.PrevPulseNumber),
+		NextPulseNumber: uint32(pulse.NextPulseNumber),
+		Timestamp:       pulse.PulseTimestamp,
+		Entropy:         pulse.Entropy[:],
+	}}
+	for s := range ss.subscribers {
+		if s.pulses {
+			ss.send(s, e)
+		}
+	}
+}
+
+// publishState remembers object state. If the state differs from the known one, all watchers get event. Otherwise
+// event is sent only to the initial subscriber (if any), so new subscribers get current state.
+func (ss *subscriptions) publishState(ref insolar.Reference, state insolar.ID, initial *subscription) {
+	ss.lock.Lock()
+	defer ss.lock.Unlock()
+
+	if !ss.watched(ref) {
+		return
+	}
+	prev, known := ss.states[ref]
+	ss.states[ref] = state
+
+	changed := known && prev != state
+	data := ObjectEvent{Reference: ref.String(), State: state.String()}
+	if changed {
+		data.PrevState = prev.String()
+	}
+	e := Event{Type: EventObject, Data: data}
+
+	if !changed {
+		if initial != nil {
+			ss.send(initial, e)
+		}
+		return
+	}
+	for s := range ss.subscribers {
+		if _, ok := s.objects[ref]; ok {
+			ss.send(s, e)
+		}
+	}
+}
+
+func (ss *subscriptions) publishCall(call CallEvent) {
+	ss.lock.Lock()
+	defer ss.lock.Unlock()
+
+	seed := base64.StdEncoding.EncodeToString(call.Seed)
+	e := Event{Type: EventCall, Data: call}
+	for s := range ss.subscribers {
+		if _, ok := s.seeds[seed]; ok {
+			ss.send(s, e)
+		}
+	}
+}
+
+// watchCall remembers asynchronous call if anybody waits for it, the call is published when its result is registered.
+func (ss *subscriptions) watchCall(request insolar.Reference, call CallEvent) {
+	ss.lock.Lock()
+	defer ss.lock.Unlock()
+
+	if ss.awaited(call.Seed) {
+		ss.calls[request] = call
+	}
+}
+
+// pendingCalls returns asynchronous calls which results aren't published yet. Calls nobody waits for are forgotten.
+func (ss *subscriptions) pendingCalls() map[insolar.Reference]CallEvent {
+	ss.lock.Lock()
+	defer ss.lock.Unlock()
+
+	calls := make(map[insolar.Reference]CallEvent, len(ss.calls))
+	for request, call := range ss.calls {
+		if !ss.awaited(call.Seed) {
+			delete(ss.calls, request)
+			continue
+		}
+		calls[request] = call
+	}
+	return calls
+}
+
+// completeCall publishes result of asynchronous call and forgets the call.
+func (ss *subscriptions) completeCall(request insolar.Reference, call CallEvent) {
+	ss.lock.Lock()
+	delete(ss.calls, request)
+	ss.lock.Unlock()
+
+	ss.publishCall(call)
+}
+
+// checkObjects requests check of watched objects states. Requests are coalesced.
+func (ss *subscriptions) checkObjects() {
+	select {
+	case ss.check <- struct{}{}:
+	default:
+	}
+}
+
+// close disconnects all subscribers.
+func (ss *subscriptions) close() {
+	ss.lock.Lock()
+	defer ss.lock.Unlock()
+
+	for s := range ss.subscribers {
+		ss.remove(s)
+	}
+}
+
+func unique(refs []insolar.Reference) []insolar.Reference {
+	seen := map[insolar.Reference]struct{}{}
+	res := refs[:0]
+	for _, ref := range refs {
+		if _, ok := seen[ref]; ok {
+			continue
+		}
+		seen[ref] = struct{}{}
+		res = append(res, ref)
+	}
+	return res
+}
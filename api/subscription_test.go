@@ -0,0 +1,283 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/logicrunner/artifacts"
+	"github.com/insolar/insolar/logicrunner/goplugin/foundation"
+)
+
+func TestParseSubscription(t *testing.T) {
+	ref := gen.Reference()
+	seed := base64.StdEncoding.EncodeToString([]byte("seed"))
+
+	s, err := parseSubscription(url.Values{
+		"pulse":  {"true"},
+		"object": {ref.String()},
+		"seed":   {seed},
+	})
+	require.NoError(t, err)
+	assert.True(t, s.pulses)
+	assert.Contains(t, s.objects, ref)
+	assert.Contains(t, s.seeds, seed)
+
+	for _, query := range []url.Values{
+		{},
+		{"pulse": {"false"}},
+		{"pulse": {"maybe"}},
+		{"object": {"not a reference"}},
+		{"seed": {"not base64!"}},
+	} {
+		_, err := parseSubscription(query)
+		assert.Error(t, err, query.Encode())
+	}
+}
+
+func TestSubscriptions_Publish(t *testing.T) {
+	ss := newSubscriptions()
+	ref := gen.Reference()
+	seed := []byte("seed")
+
+	pulses := newSubscription()
+	pulses.pulses = true
+	objects := newSubscription()
+	objects.objects[ref] = struct{}{}
+	objects.seeds[base64.StdEncoding.EncodeToString(seed)] = struct{}{}
+	ss.subscribe(pulses)
+	ss.subscribe(objects)
+
+	ss.publishPulse(insolar.Pulse{PulseNumber: insolar.FirstPulseNumber})
+	e := <-pulses.events
+	assert.Equal(t, EventPulse, e.Type)
+	assert.Equal(t, uint32(insolar.FirstPulseNumber), e.Data.(PulseEvent).PulseNumber)
+
+	ss.publishCall(CallEvent{Seed: []byte("other")})
+	ss.publishCall(CallEvent{Seed: seed, Method: "Transfer"})
+	e = <-objects.events
+	assert.Equal(t, EventCall, e.Type)
+	assert.Equal(t, "Transfer", e.Data.(CallEvent).Method)
+
+	// Unknown state is only remembered.
+	first, second := gen.ID(), gen.ID()
+	ss.publishState(ref, first, nil)
+	ss.publishState(ref, first, nil)
+	ss.publishState(ref, second, nil)
+	e = <-objects.events
+	assert.Equal(t, EventObject, e.Type)
+	assert.Equal(t, ObjectEvent{
+		Reference: ref.String(),
+		State:     second.String(),
+		PrevState: first.String(),
+	}, e.Data)
+
+	assert.Empty(t, pulses.events)
+	assert.Empty(t, objects.events)
+
+	ss.unsubscribe(objects)
+	assert.Empty(t, ss.states)
+	assert.Empty(t, ss.objects())
+	_, ok := <-objects.events
+	assert.False(t, ok)
+}
+
+func TestSubscriptions_SlowSubscriber(t *testing.T) {
+	ss := newSubscriptions()
+	s := newSubscription()
+	s.pulses = true
+	ss.subscribe(s)
+
+	for i := 0; i <= subscriberBuffer; i++ {
+		ss.publishPulse(insolar.Pulse{PulseNumber: insolar.PulseNumber(i)})
+	}
+	assert.False(t, ss.active())
+
+	received := 0
+	for range s.events {
+		received++
+	}
+	assert.Equal(t, subscriberBuffer, received)
+}
+
+func TestSubscriptions_Calls(t *testing.T) {
+	ss := newSubscriptions()
+	request := gen.Reference()
+	seed := []byte("seed")
+
+	// Nobody waits for the call.
+	ss.watchCall(request, CallEvent{Seed: seed})
+	assert.Empty(t, ss.pendingCalls())
+
+	s := newSubscription()
+	s.seeds[base64.StdEncoding.EncodeToString(seed)] = struct{}{}
+	ss.subscribe(s)
+
+	ss.watchCall(request, CallEvent{Seed: seed, Method: "Transfer"})
+	assert.Equal(t, map[insolar.Reference]CallEvent{
+		request: {Seed: seed, Method: "Transfer"},
+	}, ss.pendingCalls())
+	assert.Empty(t, s.events)
+
+	ss.completeCall(request, CallEvent{Seed: seed, Method: "Transfer", Result: "OK"})
+	e := <-s.events
+	assert.Equal(t, EventCall, e.Type)
+	assert.Equal(t, "OK", e.Data.(CallEvent).Result)
+	assert.Empty(t, ss.pendingCalls())
+
+	// Calls are forgotten when subscriber leaves.
+	ss.watchCall(request, CallEvent{Seed: seed})
+	ss.unsubscribe(s)
+	assert.Empty(t, ss.pendingCalls())
+	assert.Empty(t, ss.calls)
+}
+
+func TestRunner_CheckCalls(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	pending, executed := gen.Reference(), gen.Reference()
+	resultID := gen.ID()
+	seed := []byte("seed")
+
+	var contractErr *foundation.Error
+	payload, err := insolar.MarshalArgs("OK", contractErr)
+	require.NoError(t, err)
+
+	am := artifacts.NewClientMock(t)
+	am.GetRequestResultFunc = func(_ context.Context, ref insolar.Reference) (*insolar.ID, []byte, error) {
+		if ref == executed {
+			return &resultID, payload, nil
+		}
+		return nil, nil, nil
+	}
+	ar := &Runner{ArtifactManager: am, subscriptions: newSubscriptions()}
+
+	s := newSubscription()
+	s.seeds[base64.StdEncoding.EncodeToString(seed)] = struct{}{}
+	ar.subscriptions.subscribe(s)
+	ar.subscriptions.watchCall(pending, CallEvent{Seed: seed, Method: "Pending"})
+	ar.subscriptions.watchCall(executed, CallEvent{Seed: seed, Method: "Executed"})
+
+	ar.checkCalls(ctx)
+	e := <-s.events
+	assert.Equal(t, CallEvent{Seed: seed, Method: "Executed", Result: "OK"}, e.Data)
+	assert.Empty(t, s.events)
+	assert.Contains(t, ar.subscriptions.pendingCalls(), pending)
+	assert.NotContains(t, ar.subscriptions.pendingCalls(), executed)
+}
+
+func readEvent(t *testing.T, r *bufio.Reader) (string, map[string]interface{}) {
+	var typ string
+	var data map[string]interface{}
+	for {
+		line, err := r.ReadString('\n')
+		require.NoError(t, err)
+		line = strings.TrimSuffix(line, "\n")
+		switch {
+		case line == "":
+			return typ, data
+		case strings.HasPrefix(line, "event: "):
+			typ = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &data))
+		}
+	}
+}
+
+func TestRunner_SubscribeHandler(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	ref := gen.Reference()
+	states := []insolar.ID{gen.ID(), gen.ID(), gen.ID()}
+	var current int32
+
+	am := artifacts.NewClientMock(t)
+	am.GetObjectFunc = func(_ context.Context, head insolar.Reference, state *insolar.ID, _ bool) (artifacts.ObjectDescriptor, error) {
+		assert.Equal(t, ref, head)
+		i := int(atomic.LoadInt32(&current))
+		if state != nil {
+			for i = range states {
+				if states[i] == *state {
+					break
+				}
+			}
+		}
+		desc := artifacts.NewObjectDescriptorMock(t)
+		desc.StateIDMock.Return(&states[i])
+		if i > 0 {
+			desc.PrevStateIDMock.Return(&states[i-1])
+		} else {
+			desc.PrevStateIDMock.Return(nil)
+		}
+		return desc, nil
+	}
+	ar := &Runner{ArtifactManager: am, subscriptions: newSubscriptions()}
+
+	server := httptest.NewServer(http.HandlerFunc(ar.subscribeHandler()))
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "?object=" + ref.String() + "&pulse=true")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+	r := bufio.NewReader(resp.Body)
+
+	typ, data := readEvent(t, r)
+	assert.Equal(t, EventObject, typ)
+	assert.Equal(t, states[0].String(), data["State"])
+
+	pulses := make(chan insolar.Pulse)
+	done := make(chan struct{})
+	defer close(done)
+	go ar.watchEvents(ctx, pulses, done)
+
+	// Object got two new states before the pulse, both of them are published.
+	atomic.StoreInt32(&current, 2)
+	pulses <- insolar.Pulse{PulseNumber: insolar.FirstPulseNumber}
+	typ, data = readEvent(t, r)
+	assert.Equal(t, EventPulse, typ)
+	assert.Equal(t, float64(insolar.FirstPulseNumber), data["PulseNumber"])
+	for i := 1; i < len(states); i++ {
+		typ, data = readEvent(t, r)
+		assert.Equal(t, EventObject, typ)
+		assert.Equal(t, states[i].String(), data["State"])
+		assert.Equal(t, states[i-1].String(), data["PrevState"])
+	}
+
+	ar.subscriptions.close()
+	_, err = r.ReadString('\n')
+	assert.Error(t, err)
+
+	resp, err = http.Get(server.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
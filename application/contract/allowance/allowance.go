@@ -28,11 +28,22 @@ import (
 type Allowance struct {
 	foundation.BaseContract
 	To         insolar.Reference
+	Asset      string
 	Amount     uint
 	ExpireTime int64
+	// Arbiter is set only for escrow, it can release amount to recipient before Deadline
+	Arbiter  insolar.Reference
+	Deadline insolar.PulseNumber
+}
+
+func (a *Allowance) isEscrow() bool {
+	return !a.Arbiter.IsEmpty()
 }
 
 func (a *Allowance) isExpired() bool {
+	if a.isEscrow() {
+		return a.GetContext().Pulse.PulseNumber > a.Deadline
+	}
 	return a.GetContext().Time.After(time.Unix(a.ExpireTime, 0))
 }
 
@@ -50,11 +61,53 @@ func (a *Allowance) TakeAmount() (uint, error) {
 	return a.Amount, nil
 }
 
+// Release allows arbiter to pass escrow amount to recipient
+func (a *Allowance) Release() error {
+	if !a.isEscrow() {
+		return fmt.Errorf("[ Release ] Allowance is not an escrow")
+	}
+	if *(a.GetContext().Caller) != a.Arbiter {
+		return fmt.Errorf("[ Release ] Only arbiter can release escrow")
+	}
+	if a.isExpired() {
+		return fmt.Errorf("[ Release ] Escrow deadline has passed")
+	}
+	r := a.GetReference()
+	return wallet.GetObject(a.To).AcceptNoWait(&r)
+}
+
+// GetSender returns reference to wallet which created allowance
+func (a *Allowance) GetSender() (insolar.Reference, error) {
+	return *a.GetContext().Parent, nil
+}
+
+// GetArbiter returns arbiter of escrow, empty for ordinary allowance
+func (a *Allowance) GetArbiter() (insolar.Reference, error) {
+	return a.Arbiter, nil
+}
+
 // GetBalanceForOwner returns balance
 func (a *Allowance) GetBalanceForOwner() (uint, error) {
 	return a.Amount, nil
 }
 
+var INSATTR_GetAsset_Immutable = true
+
+// GetAsset returns name of allowed asset, empty for wallet's own currency
+func (a *Allowance) GetAsset() (string, error) {
+	return a.Asset, nil
+}
+
+var INSATTR_GetExpiredAmount_Immutable = true
+
+// GetExpiredAmount returns amount of expired allowance which is not returned to owner yet, allowance is kept
+func (a *Allowance) GetExpiredAmount() (uint, error) {
+	if a.isExpired() {
+		return a.Amount, nil
+	}
+	return 0, nil
+}
+
 // GetExpiredBalance gets balance from expired allowance and delete allowance
 func (a *Allowance) GetExpiredBalance() (uint, error) {
 	if *(a.GetContext().Caller) != *(a.GetContext().Parent) {
@@ -76,3 +129,22 @@ func New(to *insolar.Reference, amount uint, expire int64) (*Allowance, error) {
 	}
 	return &Allowance{To: *to, Amount: amount, ExpireTime: expire}, nil
 }
+
+// NewAsset check is caller wallet and makes new allowance for given asset
+func NewAsset(asset string, to *insolar.Reference, amount uint, expire int64) (*Allowance, error) {
+	if !wallet.PrototypeReference.Equal(*foundation.GetContext().CallerPrototype) {
+		return nil, fmt.Errorf("[ NewAsset Allowance ] : Can't create allowance from not wallet contract")
+	}
+	return &Allowance{To: *to, Asset: asset, Amount: amount, ExpireTime: expire}, nil
+}
+
+// NewEscrow check is caller wallet and makes new escrow which can be released by arbiter until deadline pulse
+func NewEscrow(asset string, to *insolar.Reference, arbiter *insolar.Reference, amount uint, deadline insolar.PulseNumber) (*Allowance, error) {
+	if !wallet.PrototypeReference.Equal(*foundation.GetContext().CallerPrototype) {
+		return nil, fmt.Errorf("[ NewEscrow Allowance ] : Can't create escrow from not wallet contract")
+	}
+	if arbiter.IsEmpty() {
+		return nil, fmt.Errorf("[ NewEscrow Allowance ] : Arbiter is required")
+	}
+	return &Allowance{To: *to, Asset: asset, Amount: amount, Arbiter: *arbiter, Deadline: deadline}, nil
+}
@@ -0,0 +1,160 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package assetregistry
+
+import (
+	"fmt"
+
+	"github.com/insolar/insolar/application/contract/wallet/safemath"
+	"github.com/insolar/insolar/application/proxy/rootdomain"
+	"github.com/insolar/insolar/application/proxy/wallet"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/logicrunner/goplugin/foundation"
+)
+
+// AssetInfo holds asset info
+type AssetInfo struct {
+	Issuer insolar.Reference
+	Supply uint
+}
+
+// AssetRegistry holds registered assets and mints or burns them
+type AssetRegistry struct {
+	foundation.BaseContract
+
+	Assets map[string]AssetInfo
+}
+
+// NewAssetRegistry creates new AssetRegistry
+func NewAssetRegistry() (*AssetRegistry, error) {
+	return &AssetRegistry{
+		Assets: make(map[string]AssetInfo),
+	}, nil
+}
+
+func (ar *AssetRegistry) getAsset(name string) (AssetInfo, error) {
+	asset, ok := ar.Assets[name]
+	if !ok {
+		return AssetInfo{}, fmt.Errorf("asset %s is not registered", name)
+	}
+	return asset, nil
+}
+
+func (ar *AssetRegistry) checkIssuer(asset AssetInfo) error {
+	if *ar.GetContext().Caller != asset.Issuer {
+		return fmt.Errorf("only issuer of asset can do it")
+	}
+	return nil
+}
+
+// RegisterAsset registers new asset with given issuer
+func (ar *AssetRegistry) RegisterAsset(name string, issuer string) error {
+	root, err := rootdomain.GetObject(*ar.GetContext().Parent).GetRootMemberRef()
+	if err != nil {
+		return fmt.Errorf("[ RegisterAsset ] Couldn't get root member reference: %s", err.Error())
+	}
+	if *ar.GetContext().Caller != *root {
+		return fmt.Errorf("[ RegisterAsset ] Only Root member can register asset")
+	}
+
+	if len(name) == 0 {
+		return fmt.Errorf("[ RegisterAsset ] Asset name is required")
+	}
+	if _, ok := ar.Assets[name]; ok {
+		return fmt.Errorf("[ RegisterAsset ] Asset %s is already registered", name)
+	}
+	issuerRef, err := insolar.NewReferenceFromBase58(issuer)
+	if err != nil {
+		return fmt.Errorf("[ RegisterAsset ] Failed to parse issuer reference: %s", err.Error())
+	}
+
+	ar.Assets[name] = AssetInfo{Issuer: *issuerRef}
+	return nil
+}
+
+// Mint issues amount of asset to given member's wallet
+func (ar *AssetRegistry) Mint(name string, amount uint, to *insolar.Reference) error {
+	asset, err := ar.getAsset(name)
+	if err != nil {
+		return fmt.Errorf("[ Mint ] %s", err.Error())
+	}
+	if err := ar.checkIssuer(asset); err != nil {
+		return fmt.Errorf("[ Mint ] %s", err.Error())
+	}
+
+	asset.Supply, err = safemath.Add(asset.Supply, amount)
+	if err != nil {
+		return fmt.Errorf("[ Mint ] Couldn't increase supply: %s", err.Error())
+	}
+
+	w, err := wallet.GetImplementationFrom(*to)
+	if err != nil {
+		return fmt.Errorf("[ Mint ] Can't get implementation: %s", err.Error())
+	}
+	err = w.DepositAsset(name, amount)
+	if err != nil {
+		return fmt.Errorf("[ Mint ] Can't deposit asset: %s", err.Error())
+	}
+
+	// Changing supply only after asset was successfully deposited
+	ar.Assets[name] = asset
+	return nil
+}
+
+// Burn destroys amount of asset from issuer's wallet
+func (ar *AssetRegistry) Burn(name string, amount uint) error {
+	asset, err := ar.getAsset(name)
+	if err != nil {
+		return fmt.Errorf("[ Burn ] %s", err.Error())
+	}
+	if err := ar.checkIssuer(asset); err != nil {
+		return fmt.Errorf("[ Burn ] %s", err.Error())
+	}
+
+	asset.Supply, err = safemath.Sub(asset.Supply, amount)
+	if err != nil {
+		return fmt.Errorf("[ Burn ] Not enough supply: %s", err.Error())
+	}
+
+	w, err := wallet.GetImplementationFrom(asset.Issuer)
+	if err != nil {
+		return fmt.Errorf("[ Burn ] Can't get implementation: %s", err.Error())
+	}
+	err = w.WithdrawAsset(name, amount)
+	if err != nil {
+		return fmt.Errorf("[ Burn ] Can't withdraw asset: %s", err.Error())
+	}
+
+	// Changing supply only after asset was successfully withdrawn
+	ar.Assets[name] = asset
+	return nil
+}
+
+// GetAssetInfo returns issuer and total supply of asset
+func (ar *AssetRegistry) GetAssetInfo(name string) (AssetInfo, error) {
+	asset, err := ar.getAsset(name)
+	if err != nil {
+		return AssetInfo{}, fmt.Errorf("[ GetAssetInfo ] %s", err.Error())
+	}
+	return asset, nil
+}
+
+// IsRegistered checks if asset is registered
+func (ar *AssetRegistry) IsRegistered(name string) (bool, error) {
+	_, ok := ar.Assets[name]
+	return ok, nil
+}
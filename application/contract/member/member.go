@@ -17,29 +17,73 @@
 package member
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
+	"sort"
 
 	"github.com/insolar/insolar/application/contract/member/signer"
+	"github.com/insolar/insolar/application/proxy/allowance"
+	"github.com/insolar/insolar/application/proxy/assetregistry"
 	"github.com/insolar/insolar/application/proxy/nodedomain"
 	"github.com/insolar/insolar/application/proxy/rootdomain"
 	"github.com/insolar/insolar/application/proxy/wallet"
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/logicrunner/goplugin/foundation"
+	"github.com/jbenet/go-base58"
 )
 
 type Member struct {
 	foundation.BaseContract
 	Name      string
 	PublicKey string
+	// PublicKeys and Threshold are set only for multisig member
+	PublicKeys []string
+	Threshold  uint
+	Proposals  map[string]*Proposal
 }
 
+// Proposal is a call of multisig member waiting for approvals
+type Proposal struct {
+	Method      string
+	Params      []byte
+	Seed        []byte
+	ExpirePulse insolar.PulseNumber
+	Approvals   []string // public keys which signed the proposal
+}
+
+// proposalTTL is a number of pulses while proposal can be approved, pulse numbers follow seconds, so it's a day
+const proposalTTL insolar.PulseNumber = 24 * 60 * 60
+
+// multisigReadOnlyMethods can be called by any single key of multisig member
+var multisigReadOnlyMethods = map[string]bool{
+	"GetMyBalance":       true,
+	"GetBalance":         true,
+	"GetAssetBalance":    true,
+	"DumpUserInfo":       true,
+	"GetNodeRef":         true,
+	"GetTransferHistory": true,
+	"GetProposals":       true,
+}
+
+// queryMethods can be called with Query, they don't change state of objects
+var queryMethods = map[string]bool{
+	"GetMyBalance":       true,
+	"GetBalance":         true,
+	"GetAssetBalance":    true,
+	"GetTransferHistory": true,
+	"GetProposals":       true,
+}
+
+var INSATTR_GetName_Immutable = true
+
 func (m *Member) GetName() (string, error) {
 	return m.Name, nil
 }
 
 var INSATTR_GetPublicKey_API = true
+var INSATTR_GetPublicKey_Immutable = true
 
 func (m *Member) GetPublicKey() (string, error) {
 	return m.PublicKey, nil
@@ -52,15 +96,49 @@ func New(name string, key string) (*Member, error) {
 	}, nil
 }
 
-func (m *Member) verifySig(method string, params []byte, seed []byte, sign []byte) error {
-	args, err := insolar.MarshalArgs(m.GetReference(), method, params, seed)
-	if err != nil {
-		return fmt.Errorf("[ verifySig ] Can't MarshalArgs: %s", err.Error())
+// NewMultisig creates member which requires threshold of signatures made by keys to make a call
+func NewMultisig(name string, keys []string, threshold uint) (*Member, error) {
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("[ NewMultisig ] At least one key is required")
+	}
+	if threshold == 0 || threshold > uint(len(keys)) {
+		return nil, fmt.Errorf("[ NewMultisig ] Threshold should be from 1 to %d", len(keys))
+	}
+	for i, key := range keys {
+		if _, err := foundation.ImportPublicKey(key); err != nil {
+			return nil, fmt.Errorf("[ NewMultisig ] Invalid public key #%d", i)
+		}
+		for _, other := range keys[:i] {
+			if other == key {
+				return nil, fmt.Errorf("[ NewMultisig ] Duplicate public key #%d", i)
+			}
+		}
 	}
+	return &Member{
+		Name:       name,
+		PublicKeys: keys,
+		Threshold:  threshold,
+		Proposals:  make(map[string]*Proposal),
+	}, nil
+}
+
+func (m *Member) isMultisig() bool {
+	return len(m.PublicKeys) > 0
+}
+
+func (m *Member) verifySig(method string, params []byte, seed []byte, sign []byte) error {
 	key, err := m.GetPublicKey()
 	if err != nil {
 		return fmt.Errorf("[ verifySig ]: %s", err.Error())
 	}
+	return m.verifySigWithKey(key, method, params, seed, sign)
+}
+
+func (m *Member) verifySigWithKey(key string, method string, params []byte, seed []byte, sign []byte) error {
+	args, err := insolar.MarshalArgs(m.GetReference(), method, params, seed)
+	if err != nil {
+		return fmt.Errorf("[ verifySig ] Can't MarshalArgs: %s", err.Error())
+	}
 
 	publicKey, err := foundation.ImportPublicKey(key)
 	if err != nil {
@@ -82,12 +160,56 @@ func (m *Member) Call(rootDomain insolar.Reference, method string, params []byte
 	switch method {
 	case "CreateMember":
 		return m.createMemberCall(rootDomain, params)
+	case "CreateMultisigMember":
+		return m.createMultisigMemberCall(rootDomain, params)
+	}
+
+	if m.isMultisig() {
+		return m.multisigCall(rootDomain, method, params, seed, sign)
 	}
 
 	if err := m.verifySig(method, params, seed, sign); err != nil {
 		return nil, fmt.Errorf("[ Call ]: %s", err.Error())
 	}
 
+	return m.call(rootDomain, method, params)
+}
+
+var INSATTR_Query_API = true
+var INSATTR_Query_Immutable = true
+
+// Query method for authorized read only calls, unlike Call it's executed without registration in ledger,
+// so only methods which don't change state of objects are available
+func (m *Member) Query(rootDomain insolar.Reference, method string, params []byte, seed []byte, sign []byte) (interface{}, error) {
+	if !queryMethods[method] {
+		return nil, &foundation.Error{S: "Method is not available for query"}
+	}
+
+	var err error
+	if m.isMultisig() {
+		_, err = m.findSigner(method, params, seed, sign)
+	} else {
+		err = m.verifySig(method, params, seed, sign)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("[ Query ]: %s", err.Error())
+	}
+
+	switch method {
+	case "GetMyBalance":
+		return m.getMyBalanceQuery()
+	case "GetBalance":
+		return m.getBalanceQuery(params)
+	case "GetAssetBalance":
+		return m.getAssetBalanceQuery(params)
+	case "GetTransferHistory":
+		return m.getTransferHistoryCall(rootDomain, params)
+	default:
+		return m.getProposalsCall()
+	}
+}
+
+func (m *Member) call(rootDomain insolar.Reference, method string, params []byte) (interface{}, error) {
 	switch method {
 	case "GetMyBalance":
 		return m.getMyBalanceCall()
@@ -103,6 +225,26 @@ func (m *Member) Call(rootDomain insolar.Reference, method string, params []byte
 		return m.registerNodeCall(rootDomain, params)
 	case "GetNodeRef":
 		return m.getNodeRefCall(rootDomain, params)
+	case "TransferAsset":
+		return m.transferAssetCall(params)
+	case "GetAssetBalance":
+		return m.getAssetBalanceCall(params)
+	case "RegisterAsset":
+		return m.registerAssetCall(rootDomain, params)
+	case "MintAsset":
+		return m.mintAssetCall(rootDomain, params)
+	case "BurnAsset":
+		return m.burnAssetCall(rootDomain, params)
+	case "GetTransferHistory":
+		return m.getTransferHistoryCall(rootDomain, params)
+	case "GetProposals":
+		return m.getProposalsCall()
+	case "CreateEscrow":
+		return m.createEscrowCall(params)
+	case "ReleaseEscrow":
+		return m.releaseEscrowCall(params)
+	case "UpgradePrototype":
+		return m.upgradePrototypeCall(rootDomain, params)
 	}
 	return nil, &foundation.Error{S: "Unknown method"}
 }
@@ -117,6 +259,134 @@ func (m *Member) createMemberCall(ref insolar.Reference, params []byte) (interfa
 	return rootDomain.CreateMember(name, key)
 }
 
+func (m *Member) createMultisigMemberCall(ref insolar.Reference, params []byte) (interface{}, error) {
+	rootDomain := rootdomain.GetObject(ref)
+	var name string
+	var keys []string
+	var inThreshold interface{}
+	if err := signer.UnmarshalParams(params, &name, &keys, &inThreshold); err != nil {
+		return nil, fmt.Errorf("[ createMultisigMemberCall ]: %s", err.Error())
+	}
+	threshold, err := parseAmount(inThreshold)
+	if err != nil {
+		return nil, fmt.Errorf("[ createMultisigMemberCall ] Failed to parse 'threshold' param: %s", err.Error())
+	}
+	return rootDomain.CreateMultisigMember(name, keys, threshold)
+}
+
+// multisigCall checks that request is signed by one of member keys and either makes read only call
+// or collects approval for proposal, which is called when threshold of approvals is reached
+func (m *Member) multisigCall(rootDomain insolar.Reference, method string, params []byte, seed []byte, sign []byte) (interface{}, error) {
+	key, err := m.findSigner(method, params, seed, sign)
+	if err != nil {
+		return nil, fmt.Errorf("[ Call ]: %s", err.Error())
+	}
+
+	if multisigReadOnlyMethods[method] {
+		return m.call(rootDomain, method, params)
+	}
+
+	m.removeExpiredProposals()
+
+	if method == "ApproveProposal" {
+		var id string
+		if err := signer.UnmarshalParams(params, &id); err != nil {
+			return nil, fmt.Errorf("[ ApproveProposal ] Can't unmarshal params: %s", err.Error())
+		}
+		return m.approveProposal(rootDomain, id, key)
+	}
+
+	id := base58.Encode(seed)
+	if _, ok := m.Proposals[id]; ok {
+		return nil, fmt.Errorf("[ Call ] Proposal with this seed already exists")
+	}
+	if m.Proposals == nil {
+		m.Proposals = make(map[string]*Proposal)
+	}
+	m.Proposals[id] = &Proposal{
+		Method:      method,
+		Params:      params,
+		Seed:        seed,
+		ExpirePulse: m.GetContext().Pulse.PulseNumber + proposalTTL,
+		Approvals:   []string{key},
+	}
+	return m.executeIfApproved(rootDomain, id)
+}
+
+// findSigner returns member key which made the signature
+func (m *Member) findSigner(method string, params []byte, seed []byte, sign []byte) (string, error) {
+	for _, key := range m.PublicKeys {
+		if err := m.verifySigWithKey(key, method, params, seed, sign); err == nil {
+			return key, nil
+		}
+	}
+	return "", fmt.Errorf("[ verifySig ] Incorrect signature")
+}
+
+func (m *Member) removeExpiredProposals() {
+	now := m.GetContext().Pulse.PulseNumber
+	for id, p := range m.Proposals {
+		if p.ExpirePulse < now {
+			delete(m.Proposals, id)
+		}
+	}
+}
+
+func (m *Member) approveProposal(rootDomain insolar.Reference, id string, key string) (interface{}, error) {
+	p, ok := m.Proposals[id]
+	if !ok {
+		return nil, fmt.Errorf("[ ApproveProposal ] Proposal not found or expired")
+	}
+	for _, approved := range p.Approvals {
+		if approved == key {
+			return nil, fmt.Errorf("[ ApproveProposal ] Proposal is already approved with this key")
+		}
+	}
+	p.Approvals = append(p.Approvals, key)
+	return m.executeIfApproved(rootDomain, id)
+}
+
+// executeIfApproved calls proposed method if proposal has enough approvals, otherwise returns proposal status
+func (m *Member) executeIfApproved(rootDomain insolar.Reference, id string) (interface{}, error) {
+	p := m.Proposals[id]
+	if uint(len(p.Approvals)) < m.Threshold {
+		return json.Marshal(map[string]interface{}{
+			"proposal":  id,
+			"method":    p.Method,
+			"approvals": len(p.Approvals),
+			"threshold": m.Threshold,
+		})
+	}
+
+	delete(m.Proposals, id)
+	return m.call(rootDomain, p.Method, p.Params)
+}
+
+func (m *Member) getProposalsCall() (interface{}, error) {
+	ids := make([]string, 0, len(m.Proposals))
+	for id := range m.Proposals {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	now := m.GetContext().Pulse.PulseNumber
+	res := []map[string]interface{}{}
+	for _, id := range ids {
+		p := m.Proposals[id]
+		if p.ExpirePulse < now {
+			continue
+		}
+		res = append(res, map[string]interface{}{
+			"proposal":    id,
+			"method":      p.Method,
+			"approvals":   len(p.Approvals),
+			"threshold":   m.Threshold,
+			"expirePulse": p.ExpirePulse,
+		})
+	}
+	return json.Marshal(res)
+}
+
 func (m *Member) getMyBalanceCall() (interface{}, error) {
 	w, err := wallet.GetImplementationFrom(m.GetReference())
 	if err != nil {
@@ -143,33 +413,82 @@ func (m *Member) getBalanceCall(params []byte) (interface{}, error) {
 	return w.GetBalance()
 }
 
-func (m *Member) transferCall(params []byte) (interface{}, error) {
-	var amount uint
-	var toStr string
-	var inAmount interface{}
-	if err := signer.UnmarshalParams(params, &inAmount, &toStr); err != nil {
-		return nil, fmt.Errorf("[ transferCall ] Can't unmarshal params: %s", err.Error())
+func (m *Member) getMyBalanceQuery() (interface{}, error) {
+	w, err := wallet.GetImplementationFrom(m.GetReference())
+	if err != nil {
+		return 0, fmt.Errorf("[ getMyBalanceQuery ]: %s", err.Error())
+	}
+
+	return w.GetCurrentBalance("")
+}
+
+func (m *Member) getBalanceQuery(params []byte) (interface{}, error) {
+	var member string
+	if err := signer.UnmarshalParams(params, &member); err != nil {
+		return nil, fmt.Errorf("[ getBalanceQuery ] : %s", err.Error())
+	}
+	return memberBalance(member, "")
+}
+
+func (m *Member) getAssetBalanceQuery(params []byte) (interface{}, error) {
+	var asset string
+	var member string
+	if err := signer.UnmarshalParams(params, &asset, &member); err != nil {
+		return nil, fmt.Errorf("[ getAssetBalanceQuery ] : %s", err.Error())
+	}
+	if asset == "" {
+		return nil, fmt.Errorf("[ getAssetBalanceQuery ] Asset name is required")
+	}
+	return memberBalance(member, asset)
+}
+
+// memberBalance returns current balance of asset in wallet of member without changing the wallet
+func memberBalance(member string, asset string) (uint, error) {
+	memberRef, err := insolar.NewReferenceFromBase58(member)
+	if err != nil {
+		return 0, fmt.Errorf("[ memberBalance ] : %s", err.Error())
+	}
+	w, err := wallet.GetImplementationFrom(*memberRef)
+	if err != nil {
+		return 0, fmt.Errorf("[ memberBalance ] : %s", err.Error())
 	}
+
+	return w.GetCurrentBalance(asset)
+}
+
+func parseAmount(inAmount interface{}) (uint, error) {
 	switch a := inAmount.(type) {
 	case uint:
-		amount = a
+		return a, nil
 	case uint64:
 		if a > math.MaxUint32 {
-			return nil, errors.New("Transfer ammount bigger than integer")
+			return 0, errors.New("Transfer ammount bigger than integer")
 		}
-		amount = uint(a)
+		return uint(a), nil
 	case float32:
 		if a > math.MaxUint32 {
-			return nil, errors.New("Transfer ammount bigger than integer")
+			return 0, errors.New("Transfer ammount bigger than integer")
 		}
-		amount = uint(a)
+		return uint(a), nil
 	case float64:
 		if a > math.MaxUint32 {
-			return nil, errors.New("Transfer ammount bigger than integer")
+			return 0, errors.New("Transfer ammount bigger than integer")
 		}
-		amount = uint(a)
+		return uint(a), nil
 	default:
-		return nil, fmt.Errorf("Wrong type for amount %t", inAmount)
+		return 0, fmt.Errorf("Wrong type for amount %t", inAmount)
+	}
+}
+
+func (m *Member) transferCall(params []byte) (interface{}, error) {
+	var toStr string
+	var inAmount interface{}
+	if err := signer.UnmarshalParams(params, &inAmount, &toStr); err != nil {
+		return nil, fmt.Errorf("[ transferCall ] Can't unmarshal params: %s", err.Error())
+	}
+	amount, err := parseAmount(inAmount)
+	if err != nil {
+		return nil, err
 	}
 	to, err := insolar.NewReferenceFromBase58(toStr)
 	if err != nil {
@@ -186,6 +505,239 @@ func (m *Member) transferCall(params []byte) (interface{}, error) {
 	return nil, w.Transfer(amount, to)
 }
 
+func (m *Member) transferAssetCall(params []byte) (interface{}, error) {
+	var asset string
+	var toStr string
+	var inAmount interface{}
+	if err := signer.UnmarshalParams(params, &asset, &inAmount, &toStr); err != nil {
+		return nil, fmt.Errorf("[ transferAssetCall ] Can't unmarshal params: %s", err.Error())
+	}
+	amount, err := parseAmount(inAmount)
+	if err != nil {
+		return nil, fmt.Errorf("[ transferAssetCall ] %s", err.Error())
+	}
+	to, err := insolar.NewReferenceFromBase58(toStr)
+	if err != nil {
+		return nil, fmt.Errorf("[ transferAssetCall ] Failed to parse 'to' param: %s", err.Error())
+	}
+	if m.GetReference() == *to {
+		return nil, fmt.Errorf("[ transferAssetCall ] Recipient must be different from the sender")
+	}
+	w, err := wallet.GetImplementationFrom(m.GetReference())
+	if err != nil {
+		return nil, fmt.Errorf("[ transferAssetCall ] Can't get implementation: %s", err.Error())
+	}
+
+	return nil, w.TransferAsset(asset, amount, to)
+}
+
+func (m *Member) getAssetBalanceCall(params []byte) (interface{}, error) {
+	var asset string
+	var member string
+	if err := signer.UnmarshalParams(params, &asset, &member); err != nil {
+		return nil, fmt.Errorf("[ getAssetBalanceCall ] : %s", err.Error())
+	}
+	memberRef, err := insolar.NewReferenceFromBase58(member)
+	if err != nil {
+		return nil, fmt.Errorf("[ getAssetBalanceCall ] : %s", err.Error())
+	}
+	w, err := wallet.GetImplementationFrom(*memberRef)
+	if err != nil {
+		return nil, fmt.Errorf("[ getAssetBalanceCall ] : %s", err.Error())
+	}
+
+	return w.GetAssetBalance(asset)
+}
+
+func (m *Member) createEscrowCall(params []byte) (interface{}, error) {
+	var toStr string
+	var arbiterStr string
+	var inAmount interface{}
+	var inDeadline interface{}
+	if err := signer.UnmarshalParams(params, &inAmount, &toStr, &arbiterStr, &inDeadline); err != nil {
+		return nil, fmt.Errorf("[ createEscrowCall ] Can't unmarshal params: %s", err.Error())
+	}
+	amount, err := parseAmount(inAmount)
+	if err != nil {
+		return nil, fmt.Errorf("[ createEscrowCall ] %s", err.Error())
+	}
+	deadline, err := parseAmount(inDeadline)
+	if err != nil {
+		return nil, fmt.Errorf("[ createEscrowCall ] Failed to parse 'deadline' param: %s", err.Error())
+	}
+	to, err := insolar.NewReferenceFromBase58(toStr)
+	if err != nil {
+		return nil, fmt.Errorf("[ createEscrowCall ] Failed to parse 'to' param: %s", err.Error())
+	}
+	if m.GetReference() == *to {
+		return nil, fmt.Errorf("[ createEscrowCall ] Recipient must be different from the sender")
+	}
+	arbiter, err := insolar.NewReferenceFromBase58(arbiterStr)
+	if err != nil {
+		return nil, fmt.Errorf("[ createEscrowCall ] Failed to parse 'arbiter' param: %s", err.Error())
+	}
+	w, err := wallet.GetImplementationFrom(m.GetReference())
+	if err != nil {
+		return nil, fmt.Errorf("[ createEscrowCall ] Can't get implementation: %s", err.Error())
+	}
+
+	escrow, err := w.CreateEscrow(amount, to, arbiter, insolar.PulseNumber(deadline))
+	if err != nil {
+		return nil, fmt.Errorf("[ createEscrowCall ] %s", err.Error())
+	}
+	return escrow.String(), nil
+}
+
+func (m *Member) releaseEscrowCall(params []byte) (interface{}, error) {
+	var escrowStr string
+	if err := signer.UnmarshalParams(params, &escrowStr); err != nil {
+		return nil, fmt.Errorf("[ releaseEscrowCall ] Can't unmarshal params: %s", err.Error())
+	}
+	escrowRef, err := insolar.NewReferenceFromBase58(escrowStr)
+	if err != nil {
+		return nil, fmt.Errorf("[ releaseEscrowCall ] Failed to parse 'escrow' param: %s", err.Error())
+	}
+	escrow := allowance.GetObject(*escrowRef)
+	arbiter, err := escrow.GetArbiter()
+	if err != nil {
+		return nil, fmt.Errorf("[ releaseEscrowCall ] Can't get arbiter: %s", err.Error())
+	}
+	if arbiter.IsEmpty() {
+		return nil, fmt.Errorf("[ releaseEscrowCall ] Allowance is not an escrow")
+	}
+
+	if arbiter == m.GetReference() {
+		return nil, escrow.Release()
+	}
+
+	// Recipient releases escrow by accepting it into own wallet
+	w, err := wallet.GetImplementationFrom(m.GetReference())
+	if err != nil {
+		return nil, fmt.Errorf("[ releaseEscrowCall ] Can't get implementation: %s", err.Error())
+	}
+	return nil, w.Accept(escrowRef)
+}
+
+func (m *Member) getTransferHistoryCall(ref insolar.Reference, params []byte) (interface{}, error) {
+	var member string
+	var cursor string
+	var inLimit interface{}
+	if err := signer.UnmarshalParams(params, &member, &cursor, &inLimit); err != nil {
+		return nil, fmt.Errorf("[ getTransferHistoryCall ] Can't unmarshal params: %s", err.Error())
+	}
+	memberRef, err := insolar.NewReferenceFromBase58(member)
+	if err != nil {
+		return nil, fmt.Errorf("[ getTransferHistoryCall ] Failed to parse 'member' param: %s", err.Error())
+	}
+	if *memberRef != m.GetReference() {
+		rootMember, err := rootdomain.GetObject(ref).GetRootMemberRef()
+		if err != nil {
+			return nil, fmt.Errorf("[ getTransferHistoryCall ] Can't get root member: %s", err.Error())
+		}
+		if m.GetReference() != *rootMember {
+			return nil, fmt.Errorf("[ getTransferHistoryCall ] You can get only your own history")
+		}
+	}
+	limit, err := parseAmount(inLimit)
+	if err != nil {
+		return nil, fmt.Errorf("[ getTransferHistoryCall ] Failed to parse 'limit' param: %s", err.Error())
+	}
+	w, err := wallet.GetImplementationFrom(*memberRef)
+	if err != nil {
+		return nil, fmt.Errorf("[ getTransferHistoryCall ] Can't get implementation: %s", err.Error())
+	}
+
+	history, err := w.GetTransferHistory(cursor, int(limit))
+	if err != nil {
+		return nil, fmt.Errorf("[ getTransferHistoryCall ] %s", err.Error())
+	}
+
+	transfers := []map[string]interface{}{}
+	for _, t := range history.Transfers {
+		direction := "out"
+		if t.Incoming {
+			direction = "in"
+		}
+		transfers = append(transfers, map[string]interface{}{
+			"counterparty": t.Counterparty.String(),
+			"amount":       t.Amount,
+			"asset":        t.Asset,
+			"direction":    direction,
+			"pulse":        t.Pulse,
+			"request":      t.Request.String(),
+		})
+	}
+
+	return json.Marshal(map[string]interface{}{
+		"transfers": transfers,
+		"cursor":    history.Cursor,
+	})
+}
+
+func (m *Member) getAssetRegistry(ref insolar.Reference) (*assetregistry.AssetRegistry, error) {
+	rootDomain := rootdomain.GetObject(ref)
+	assetRegistryRef, err := rootDomain.GetAssetRegistryRef()
+	if err != nil {
+		return nil, fmt.Errorf("Can't get asset registry reference: %s", err.Error())
+	}
+	return assetregistry.GetObject(assetRegistryRef), nil
+}
+
+func (m *Member) registerAssetCall(ref insolar.Reference, params []byte) (interface{}, error) {
+	var name string
+	var issuer string
+	if err := signer.UnmarshalParams(params, &name, &issuer); err != nil {
+		return nil, fmt.Errorf("[ registerAssetCall ] Can't unmarshal params: %s", err.Error())
+	}
+	ar, err := m.getAssetRegistry(ref)
+	if err != nil {
+		return nil, fmt.Errorf("[ registerAssetCall ] %s", err.Error())
+	}
+
+	return nil, ar.RegisterAsset(name, issuer)
+}
+
+func (m *Member) mintAssetCall(ref insolar.Reference, params []byte) (interface{}, error) {
+	var name string
+	var toStr string
+	var inAmount interface{}
+	if err := signer.UnmarshalParams(params, &name, &inAmount, &toStr); err != nil {
+		return nil, fmt.Errorf("[ mintAssetCall ] Can't unmarshal params: %s", err.Error())
+	}
+	amount, err := parseAmount(inAmount)
+	if err != nil {
+		return nil, fmt.Errorf("[ mintAssetCall ] %s", err.Error())
+	}
+	to, err := insolar.NewReferenceFromBase58(toStr)
+	if err != nil {
+		return nil, fmt.Errorf("[ mintAssetCall ] Failed to parse 'to' param: %s", err.Error())
+	}
+	ar, err := m.getAssetRegistry(ref)
+	if err != nil {
+		return nil, fmt.Errorf("[ mintAssetCall ] %s", err.Error())
+	}
+
+	return nil, ar.Mint(name, amount, to)
+}
+
+func (m *Member) burnAssetCall(ref insolar.Reference, params []byte) (interface{}, error) {
+	var name string
+	var inAmount interface{}
+	if err := signer.UnmarshalParams(params, &name, &inAmount); err != nil {
+		return nil, fmt.Errorf("[ burnAssetCall ] Can't unmarshal params: %s", err.Error())
+	}
+	amount, err := parseAmount(inAmount)
+	if err != nil {
+		return nil, fmt.Errorf("[ burnAssetCall ] %s", err.Error())
+	}
+	ar, err := m.getAssetRegistry(ref)
+	if err != nil {
+		return nil, fmt.Errorf("[ burnAssetCall ] %s", err.Error())
+	}
+
+	return nil, ar.Burn(name, amount)
+}
+
 func (m *Member) dumpUserInfoCall(ref insolar.Reference, params []byte) (interface{}, error) {
 	rootDomain := rootdomain.GetObject(ref)
 	var user string
@@ -242,3 +794,31 @@ func (m *Member) getNodeRefCall(ref insolar.Reference, params []byte) (interface
 
 	return nodeRef, nil
 }
+
+func (m *Member) upgradePrototypeCall(ref insolar.Reference, params []byte) (interface{}, error) {
+	var prototype string
+	var code []byte
+	var machineType insolar.MachineType
+	if err := signer.UnmarshalParams(params, &prototype, &code, &machineType); err != nil {
+		return nil, fmt.Errorf("[ upgradePrototypeCall ] Can't unmarshal params: %s", err.Error())
+	}
+
+	rootMember, err := rootdomain.GetObject(ref).GetRootMemberRef()
+	if err != nil {
+		return nil, fmt.Errorf("[ upgradePrototypeCall ] Can't get root member: %s", err.Error())
+	}
+	if m.GetReference() != *rootMember {
+		return nil, fmt.Errorf("[ upgradePrototypeCall ] Only root member can upgrade prototypes")
+	}
+
+	prototypeRef, err := insolar.NewReferenceFromBase58(prototype)
+	if err != nil {
+		return nil, fmt.Errorf("[ upgradePrototypeCall ] Failed to parse 'prototype' param: %s", err.Error())
+	}
+	codeRef, err := foundation.UpgradePrototype(*prototypeRef, code, machineType)
+	if err != nil {
+		return nil, fmt.Errorf("[ upgradePrototypeCall ] Can't upgrade prototype: %s", err.Error())
+	}
+
+	return codeRef.String(), nil
+}
@@ -29,8 +29,9 @@ import (
 // RootDomain is smart contract representing entrance point to system
 type RootDomain struct {
 	foundation.BaseContract
-	RootMember    insolar.Reference
-	NodeDomainRef insolar.Reference
+	RootMember       insolar.Reference
+	NodeDomainRef    insolar.Reference
+	AssetRegistryRef insolar.Reference
 }
 
 var INSATTR_CreateMember_API = true
@@ -52,6 +53,27 @@ func (rd *RootDomain) CreateMember(name string, key string) (string, error) {
 	return m.GetReference().String(), nil
 }
 
+var INSATTR_CreateMultisigMember_API = true
+
+// CreateMultisigMember processes create multisig member request
+func (rd *RootDomain) CreateMultisigMember(name string, keys []string, threshold uint) (string, error) {
+	memberHolder := member.NewMultisig(name, keys, threshold)
+	m, err := memberHolder.AsChild(rd.GetReference())
+	if err != nil {
+		return "", fmt.Errorf("[ CreateMultisigMember ] Can't save as child: %s", err.Error())
+	}
+
+	wHolder := wallet.New(1000 * 1000 * 1000)
+	_, err = wHolder.AsDelegate(m.GetReference())
+	if err != nil {
+		return "", fmt.Errorf("[ CreateMultisigMember ] Can't save as delegate: %s", err.Error())
+	}
+
+	return m.GetReference().String(), nil
+}
+
+var INSATTR_GetRootMemberRef_Immutable = true
+
 // GetRootMemberRef returns root member's reference
 func (rd *RootDomain) GetRootMemberRef() (*insolar.Reference, error) {
 	return &rd.RootMember, nil
@@ -134,8 +156,9 @@ var INSATTR_Info_API = true
 // Info returns information about basic objects
 func (rd *RootDomain) Info() (interface{}, error) {
 	res := map[string]interface{}{
-		"root_member": rd.RootMember.String(),
-		"node_domain": rd.NodeDomainRef.String(),
+		"root_member":    rd.RootMember.String(),
+		"node_domain":    rd.NodeDomainRef.String(),
+		"asset_registry": rd.AssetRegistryRef.String(),
 	}
 	resJSON, err := json.Marshal(res)
 	if err != nil {
@@ -149,6 +172,11 @@ func (rd *RootDomain) GetNodeDomainRef() (insolar.Reference, error) {
 	return rd.NodeDomainRef, nil
 }
 
+// GetAssetRegistryRef returns reference of AssetRegistry instance
+func (rd *RootDomain) GetAssetRegistryRef() (insolar.Reference, error) {
+	return rd.AssetRegistryRef, nil
+}
+
 // NewRootDomain creates new RootDomain
 func NewRootDomain() (*RootDomain, error) {
 	return &RootDomain{}, nil
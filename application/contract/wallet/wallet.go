@@ -21,6 +21,7 @@ import (
 
 	"github.com/insolar/insolar/application/contract/wallet/safemath"
 	"github.com/insolar/insolar/application/proxy/allowance"
+	"github.com/insolar/insolar/application/proxy/assetregistry"
 	"github.com/insolar/insolar/application/proxy/wallet"
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/logicrunner/goplugin/foundation"
@@ -29,32 +30,135 @@ import (
 // Wallet - basic wallet contract
 type Wallet struct {
 	foundation.BaseContract
-	Balance uint
+	Balance      uint
+	Assets       map[string]uint
+	LastTransfer *TransferRecord
+}
+
+// TransferRecord describes a transfer which changed wallet balance
+type TransferRecord struct {
+	Request      insolar.Reference
+	Counterparty insolar.Reference
+	Asset        string
+	Amount       uint
+	Incoming     bool
+	Pulse        insolar.PulseNumber
+}
+
+// TransferHistory is a page of wallet transfers, latest first
+type TransferHistory struct {
+	Transfers []TransferRecord
+	// Cursor points to the next page, empty if there are no more transfers
+	Cursor string
+}
+
+// nativeAsset is a name of wallet's own currency which is held in Balance
+const nativeAsset = ""
+
+// maxTransferHistoryLimit is a maximum number of transfers returned at once
+const maxTransferHistoryLimit = 100
+
+// maxTransferHistoryScan is a maximum number of wallet states scanned at once
+const maxTransferHistoryScan = 1000
+
+func (w *Wallet) getBalance(asset string) uint {
+	if asset == nativeAsset {
+		return w.Balance
+	}
+	return w.Assets[asset]
+}
+
+func (w *Wallet) setBalance(asset string, amount uint) {
+	if asset == nativeAsset {
+		w.Balance = amount
+		return
+	}
+	if w.Assets == nil {
+		w.Assets = make(map[string]uint)
+	}
+	if amount == 0 {
+		delete(w.Assets, asset)
+		return
+	}
+	w.Assets[asset] = amount
+}
+
+func (w *Wallet) credit(asset string, amount uint) error {
+	newBalance, err := safemath.Add(w.getBalance(asset), amount)
+	if err != nil {
+		return err
+	}
+	w.setBalance(asset, newBalance)
+	return nil
+}
+
+// recordTransfer saves transfer made by current request in wallet state
+func (w *Wallet) recordTransfer(counterparty insolar.Reference, asset string, amount uint, incoming bool) {
+	ctx := w.GetContext()
+	w.LastTransfer = &TransferRecord{
+		Request:      *ctx.Request,
+		Counterparty: counterparty,
+		Asset:        asset,
+		Amount:       amount,
+		Incoming:     incoming,
+		Pulse:        ctx.Pulse.PulseNumber,
+	}
+}
+
+func (w *Wallet) checkAssetRegistry() error {
+	if !assetregistry.PrototypeReference.Equal(*w.GetContext().CallerPrototype) {
+		return fmt.Errorf("only asset registry can do it")
+	}
+	return nil
 }
 
 // Transfer transfers money to given wallet
 func (w *Wallet) Transfer(amount uint, to *insolar.Reference) error {
+	if err := w.transfer(nativeAsset, amount, to); err != nil {
+		return fmt.Errorf("[ Transfer ] %s", err.Error())
+	}
+	return nil
+}
+
+// TransferAsset transfers amount of asset to given wallet
+func (w *Wallet) TransferAsset(asset string, amount uint, to *insolar.Reference) error {
+	if asset == nativeAsset {
+		return fmt.Errorf("[ TransferAsset ] Asset name is required")
+	}
+	if err := w.transfer(asset, amount, to); err != nil {
+		return fmt.Errorf("[ TransferAsset ] %s", err.Error())
+	}
+	return nil
+}
+
+func (w *Wallet) transfer(asset string, amount uint, to *insolar.Reference) error {
 
 	toWallet, err := wallet.GetImplementationFrom(*to)
 	if err != nil {
-		return fmt.Errorf("[ Transfer ] Can't get implementation: %s", err.Error())
+		return fmt.Errorf("Can't get implementation: %s", err.Error())
 	}
 
 	toWalletRef := toWallet.GetReference()
 
-	newBalance, err := safemath.Sub(w.Balance, amount)
+	newBalance, err := safemath.Sub(w.getBalance(asset), amount)
 	if err != nil {
-		return fmt.Errorf("[ Transfer ] Not enough balance for transfer: %s", err.Error())
+		return fmt.Errorf("Not enough balance for transfer: %s", err.Error())
 	}
 
-	ah := allowance.New(&toWalletRef, amount, w.GetContext().Time.Unix()+10)
+	var ah *allowance.ContractConstructorHolder
+	if asset == nativeAsset {
+		ah = allowance.New(&toWalletRef, amount, w.GetContext().Time.Unix()+10)
+	} else {
+		ah = allowance.NewAsset(asset, &toWalletRef, amount, w.GetContext().Time.Unix()+10)
+	}
 	a, err := ah.AsChild(w.GetReference())
 	if err != nil {
-		return fmt.Errorf("[ Transfer ] Can't save as child: %s", err.Error())
+		return fmt.Errorf("Can't save as child: %s", err.Error())
 	}
 
 	// Changing balance only after allowance was successfully create
-	w.Balance = newBalance
+	w.setBalance(asset, newBalance)
+	w.recordTransfer(toWalletRef, asset, amount, false)
 
 	r := a.GetReference()
 	err = toWallet.AcceptNoWait(&r)
@@ -63,51 +167,238 @@ func (w *Wallet) Transfer(amount uint, to *insolar.Reference) error {
 
 // Accept transforms allowance to balance
 func (w *Wallet) Accept(aRef *insolar.Reference) error {
-	b, err := allowance.GetObject(*aRef).TakeAmount()
+	a := allowance.GetObject(*aRef)
+	asset, err := a.GetAsset()
+	if err != nil {
+		return fmt.Errorf("[ Accept ] Can't get asset: %s", err.Error())
+	}
+	sender, err := a.GetSender()
+	if err != nil {
+		return fmt.Errorf("[ Accept ] Can't get sender: %s", err.Error())
+	}
+	b, err := a.TakeAmount()
 	if err != nil {
 		return fmt.Errorf("[ Accept ] Can't take amount: %s", err.Error())
 	}
-	w.Balance, err = safemath.Add(w.Balance, b)
+	err = w.credit(asset, b)
 	if err != nil {
 		return fmt.Errorf("[ Accept ] Couldn't add amount to balance: %s", err.Error())
 	}
+	w.recordTransfer(sender, asset, b, true)
 	return nil
 }
 
-// GetBalance gets total balance
-func (w *Wallet) GetBalance() (uint, error) {
+// CreateEscrow moves amount to escrow which arbiter can release to given wallet until deadline pulse,
+// after deadline amount returns to balance
+func (w *Wallet) CreateEscrow(amount uint, to *insolar.Reference, arbiter *insolar.Reference, deadline insolar.PulseNumber) (*insolar.Reference, error) {
+	if deadline <= w.GetContext().Pulse.PulseNumber {
+		return nil, fmt.Errorf("[ CreateEscrow ] Deadline should be in future")
+	}
+
+	toWallet, err := wallet.GetImplementationFrom(*to)
+	if err != nil {
+		return nil, fmt.Errorf("[ CreateEscrow ] Can't get implementation: %s", err.Error())
+	}
+
+	toWalletRef := toWallet.GetReference()
+
+	newBalance, err := safemath.Sub(w.Balance, amount)
+	if err != nil {
+		return nil, fmt.Errorf("[ CreateEscrow ] Not enough balance for escrow: %s", err.Error())
+	}
+
+	ah := allowance.NewEscrow(nativeAsset, &toWalletRef, arbiter, amount, deadline)
+	a, err := ah.AsChild(w.GetReference())
+	if err != nil {
+		return nil, fmt.Errorf("[ CreateEscrow ] Can't save as child: %s", err.Error())
+	}
+
+	// Changing balance only after escrow was successfully create
+	w.Balance = newBalance
+	w.recordTransfer(toWalletRef, nativeAsset, amount, false)
+
+	r := a.GetReference()
+	return &r, nil
+}
+
+// DepositAsset adds minted asset to balance
+func (w *Wallet) DepositAsset(asset string, amount uint) error {
+	if err := w.checkAssetRegistry(); err != nil {
+		return fmt.Errorf("[ DepositAsset ] %s", err.Error())
+	}
+	if asset == nativeAsset {
+		return fmt.Errorf("[ DepositAsset ] Asset name is required")
+	}
+	if err := w.credit(asset, amount); err != nil {
+		return fmt.Errorf("[ DepositAsset ] Couldn't add amount to balance: %s", err.Error())
+	}
+	return nil
+}
+
+// WithdrawAsset removes burned asset from balance
+func (w *Wallet) WithdrawAsset(asset string, amount uint) error {
+	if err := w.checkAssetRegistry(); err != nil {
+		return fmt.Errorf("[ WithdrawAsset ] %s", err.Error())
+	}
+	if asset == nativeAsset {
+		return fmt.Errorf("[ WithdrawAsset ] Asset name is required")
+	}
+	newBalance, err := safemath.Sub(w.getBalance(asset), amount)
+	if err != nil {
+		return fmt.Errorf("[ WithdrawAsset ] Not enough balance: %s", err.Error())
+	}
+	w.setBalance(asset, newBalance)
+	return nil
+}
+
+// reclaimExpired returns amounts of expired allowances to balances
+func (w *Wallet) reclaimExpired() error {
 	iterator, err := w.NewChildrenTypedIterator(allowance.GetPrototype())
 	if err != nil {
-		return 0, fmt.Errorf("[ GetBalance ] Can't get children: %s", err.Error())
+		return fmt.Errorf("Can't get children: %s", err.Error())
 	}
 
 	for iterator.HasNext() {
 		cref, err := iterator.Next()
 		if err != nil {
-			return 0, fmt.Errorf("[ GetBalance ] Can't get next child: %s", err.Error())
+			return fmt.Errorf("Can't get next child: %s", err.Error())
 		}
 
 		if !cref.IsEmpty() {
 			a := allowance.GetObject(cref)
+			asset, err := a.GetAsset()
+			if err != nil {
+				continue
+			}
 			balance, err := a.GetExpiredBalance()
 
 			if err != nil {
 				balance = 0
-				//return 0, fmt.Errorf("[ GetBalance ] Can't get balance for owner: %s", err.Error())
 			}
 
-			w.Balance, err = safemath.Add(w.Balance, balance)
+			err = w.credit(asset, balance)
 			if err != nil {
-				return 0, fmt.Errorf("[ GetBalance ] Couldn't add expired allowance to balance: %s", err.Error())
+				return fmt.Errorf("Couldn't add expired allowance to balance: %s", err.Error())
 			}
 		}
 	}
+	return nil
+}
+
+// GetBalance gets total balance
+func (w *Wallet) GetBalance() (uint, error) {
+	if err := w.reclaimExpired(); err != nil {
+		return 0, fmt.Errorf("[ GetBalance ] %s", err.Error())
+	}
 	return w.Balance, nil
 }
 
+// GetAssetBalance gets total balance of asset
+func (w *Wallet) GetAssetBalance(asset string) (uint, error) {
+	if asset == nativeAsset {
+		return 0, fmt.Errorf("[ GetAssetBalance ] Asset name is required")
+	}
+	if err := w.reclaimExpired(); err != nil {
+		return 0, fmt.Errorf("[ GetAssetBalance ] %s", err.Error())
+	}
+	return w.getBalance(asset), nil
+}
+
+var INSATTR_GetCurrentBalance_Immutable = true
+
+// GetCurrentBalance gets balance of asset (wallet's own currency if asset is empty) including expired allowances
+// which are not reclaimed yet, unlike GetBalance it doesn't change the wallet
+func (w *Wallet) GetCurrentBalance(asset string) (uint, error) {
+	iterator, err := w.NewChildrenTypedIterator(allowance.GetPrototype())
+	if err != nil {
+		return 0, fmt.Errorf("[ GetCurrentBalance ] Can't get children: %s", err.Error())
+	}
+
+	balance := w.getBalance(asset)
+	for iterator.HasNext() {
+		cref, err := iterator.Next()
+		if err != nil {
+			return 0, fmt.Errorf("[ GetCurrentBalance ] Can't get next child: %s", err.Error())
+		}
+		if cref.IsEmpty() {
+			continue
+		}
+
+		a := allowance.GetObject(cref)
+		allowanceAsset, err := a.GetAsset()
+		if err != nil || allowanceAsset != asset {
+			continue
+		}
+		expired, err := a.GetExpiredAmount()
+		if err != nil {
+			continue
+		}
+		balance, err = safemath.Add(balance, expired)
+		if err != nil {
+			return 0, fmt.Errorf("[ GetCurrentBalance ] %s", err.Error())
+		}
+	}
+	return balance, nil
+}
+
+var INSATTR_GetTransferHistory_Immutable = true
+
+// GetTransferHistory returns transfers of wallet, latest first, starting from cursor (from the latest if empty).
+// At most maxTransferHistoryScan states are scanned per call, so a page may hold less than limit transfers
+// while cursor is not empty.
+func (w *Wallet) GetTransferHistory(cursor string, limit int) (*TransferHistory, error) {
+	if limit <= 0 || limit > maxTransferHistoryLimit {
+		return nil, fmt.Errorf("[ GetTransferHistory ] Limit should be from 1 to %d", maxTransferHistoryLimit)
+	}
+
+	var from *insolar.ID
+	if cursor != "" {
+		id, err := insolar.NewIDFromBase58(cursor)
+		if err != nil {
+			return nil, fmt.Errorf("[ GetTransferHistory ] Invalid cursor: %s", err.Error())
+		}
+		from = id
+	}
+
+	history := &TransferHistory{Transfers: []TransferRecord{}}
+	scanned := 0
+	for first := true; first || from != nil; first = false {
+		states, next, err := w.GetStates(from, limit)
+		if err != nil {
+			return nil, fmt.Errorf("[ GetTransferHistory ] Can't get states: %s", err.Error())
+		}
+
+		for _, s := range states {
+			if len(history.Transfers) == limit || scanned == maxTransferHistoryScan {
+				history.Cursor = s.State.String()
+				return history, nil
+			}
+			scanned++
+
+			var state Wallet
+			if err := insolar.Deserialize(s.Memory, &state); err != nil {
+				return nil, fmt.Errorf("[ GetTransferHistory ] Can't decode state: %s", err.Error())
+			}
+			// State holds transfer only if it was produced by the request which made the transfer
+			if state.LastTransfer != nil && state.LastTransfer.Request.Equal(s.Request) {
+				history.Transfers = append(history.Transfers, *state.LastTransfer)
+			}
+		}
+
+		if (len(history.Transfers) == limit || scanned == maxTransferHistoryScan) && next != nil {
+			history.Cursor = next.String()
+			return history, nil
+		}
+		from = next
+	}
+
+	return history, nil
+}
+
 // New creates new allowance
 func New(balance uint) (*Wallet, error) {
 	return &Wallet{
 		Balance: balance,
+		Assets:  make(map[string]uint),
 	}, nil
 }
@@ -0,0 +1,114 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package wallet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/application/contract/allowance"
+	"github.com/insolar/insolar/application/contract/member"
+	allowanceproxy "github.com/insolar/insolar/application/proxy/allowance"
+	memberproxy "github.com/insolar/insolar/application/proxy/member"
+	walletproxy "github.com/insolar/insolar/application/proxy/wallet"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/testutils/contracttest"
+)
+
+type testMember struct {
+	ref    insolar.Reference
+	wallet *walletproxy.Wallet
+}
+
+func newHarness(t *testing.T) *contracttest.Harness {
+	h := contracttest.New()
+	h.Register(*memberproxy.PrototypeReference, member.Initialize())
+	h.Register(*walletproxy.PrototypeReference, Initialize())
+	h.Register(*allowanceproxy.PrototypeReference, allowance.Initialize())
+	return h
+}
+
+func newMember(t *testing.T, h *contracttest.Harness, name string, balance uint) testMember {
+	ref, err := h.Create(*memberproxy.PrototypeReference, "New", name, name+" key")
+	require.NoError(t, err)
+	w, err := walletproxy.New(balance).AsDelegate(ref)
+	require.NoError(t, err)
+	return testMember{ref: ref, wallet: w}
+}
+
+func requireBalance(t *testing.T, expected uint, w *walletproxy.Wallet) {
+	balance, err := w.GetBalance()
+	require.NoError(t, err)
+	require.Equal(t, expected, balance)
+}
+
+func TestWallet_Transfer(t *testing.T) {
+	h := newHarness(t)
+	alice := newMember(t, h, "alice", 1000)
+	bob := newMember(t, h, "bob", 1000)
+
+	err := alice.wallet.Transfer(100, &bob.ref)
+	require.NoError(t, err)
+	requireBalance(t, 900, alice.wallet)
+	requireBalance(t, 1100, bob.wallet)
+
+	// allowance is destroyed when recipient takes amount
+	allowances := h.Children(alice.wallet.GetReference())
+	require.Len(t, allowances, 1)
+	require.False(t, h.Active(allowances[0]))
+
+	err = alice.wallet.Transfer(1000, &bob.ref)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Not enough balance for transfer")
+
+	history, err := bob.wallet.GetTransferHistory("", 10)
+	require.NoError(t, err)
+	require.Len(t, history.Transfers, 1)
+	require.Equal(t, alice.wallet.GetReference(), history.Transfers[0].Counterparty)
+	require.Equal(t, uint(100), history.Transfers[0].Amount)
+	require.True(t, history.Transfers[0].Incoming)
+}
+
+func TestWallet_Escrow(t *testing.T) {
+	h := newHarness(t)
+	alice := newMember(t, h, "alice", 1000)
+	bob := newMember(t, h, "bob", 1000)
+	arbiter := newMember(t, h, "arbiter", 0)
+
+	escrow, err := alice.wallet.CreateEscrow(100, &bob.ref, &arbiter.ref, h.Pulse.PulseNumber+10)
+	require.NoError(t, err)
+	requireBalance(t, 900, alice.wallet)
+
+	err = allowanceproxy.GetObject(*escrow).Release()
+	require.Error(t, err, "only arbiter can release escrow")
+
+	h.Caller = arbiter.ref
+	err = allowanceproxy.GetObject(*escrow).Release()
+	require.NoError(t, err)
+	require.False(t, h.Active(*escrow))
+	requireBalance(t, 1100, bob.wallet)
+
+	// expired escrow returns to sender's balance
+	_, err = alice.wallet.CreateEscrow(100, &bob.ref, &arbiter.ref, h.Pulse.PulseNumber+10)
+	require.NoError(t, err)
+	requireBalance(t, 800, alice.wallet)
+
+	h.Pulse.PulseNumber += 20
+	requireBalance(t, 900, alice.wallet)
+	requireBalance(t, 1100, bob.wallet)
+}
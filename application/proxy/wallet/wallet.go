@@ -22,9 +22,23 @@ import (
 	"github.com/insolar/insolar/logicrunner/goplugin/proxyctx"
 )
 
+type TransferRecord struct {
+	Request      insolar.Reference
+	Counterparty insolar.Reference
+	Asset        string
+	Amount       uint
+	Incoming     bool
+	Pulse        insolar.PulseNumber
+}
+type TransferHistory struct {
+	Transfers []TransferRecord
+	// Cursor points to the next page, empty if there are no more transfers
+	Cursor string
+}
+
 // PrototypeReference to prototype of this contract
 // error checking hides in generator
-var PrototypeReference, _ = insolar.NewReferenceFromBase58("1111KNes6JwRyqX7HTNnX56VdGzD3UttLTDMYmDrx6.11111111111111111111111111111111")
+var PrototypeReference, _ = insolar.NewReferenceFromBase58("1111Qhfv68mU2MUbrgRySgCxm3ykC5XyaaU84bgk87.11111111111111111111111111111111")
 
 // Wallet holds proxy type
 type Wallet struct {
@@ -208,6 +222,62 @@ func (r *Wallet) TransferNoWait(amount uint, to *insolar.Reference) error {
 	return nil
 }
 
+// TransferAsset is proxy generated method
+func (r *Wallet) TransferAsset(asset string, amount uint, to *insolar.Reference) error {
+	var args [3]interface{}
+	args[0] = asset
+	args[1] = amount
+	args[2] = to
+
+	var argsSerialized []byte
+
+	ret := [1]interface{}{}
+	var ret0 *foundation.Error
+	ret[0] = &ret0
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	res, err := proxyctx.Current.RouteCall(r.Reference, true, "TransferAsset", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	err = proxyctx.Current.Deserialize(res, &ret)
+	if err != nil {
+		return err
+	}
+
+	if ret0 != nil {
+		return ret0
+	}
+	return nil
+}
+
+// TransferAssetNoWait is proxy generated method
+func (r *Wallet) TransferAssetNoWait(asset string, amount uint, to *insolar.Reference) error {
+	var args [3]interface{}
+	args[0] = asset
+	args[1] = amount
+	args[2] = to
+
+	var argsSerialized []byte
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	_, err = proxyctx.Current.RouteCall(r.Reference, false, "TransferAsset", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // Accept is proxy generated method
 func (r *Wallet) Accept(aRef *insolar.Reference) error {
 	var args [1]interface{}
@@ -260,6 +330,174 @@ func (r *Wallet) AcceptNoWait(aRef *insolar.Reference) error {
 	return nil
 }
 
+// CreateEscrow is proxy generated method
+func (r *Wallet) CreateEscrow(amount uint, to *insolar.Reference, arbiter *insolar.Reference, deadline insolar.PulseNumber) (*insolar.Reference, error) {
+	var args [4]interface{}
+	args[0] = amount
+	args[1] = to
+	args[2] = arbiter
+	args[3] = deadline
+
+	var argsSerialized []byte
+
+	ret := [2]interface{}{}
+	var ret0 *insolar.Reference
+	ret[0] = &ret0
+	var ret1 *foundation.Error
+	ret[1] = &ret1
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return ret0, err
+	}
+
+	res, err := proxyctx.Current.RouteCall(r.Reference, true, "CreateEscrow", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return ret0, err
+	}
+
+	err = proxyctx.Current.Deserialize(res, &ret)
+	if err != nil {
+		return ret0, err
+	}
+
+	if ret1 != nil {
+		return ret0, ret1
+	}
+	return ret0, nil
+}
+
+// CreateEscrowNoWait is proxy generated method
+func (r *Wallet) CreateEscrowNoWait(amount uint, to *insolar.Reference, arbiter *insolar.Reference, deadline insolar.PulseNumber) error {
+	var args [4]interface{}
+	args[0] = amount
+	args[1] = to
+	args[2] = arbiter
+	args[3] = deadline
+
+	var argsSerialized []byte
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	_, err = proxyctx.Current.RouteCall(r.Reference, false, "CreateEscrow", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DepositAsset is proxy generated method
+func (r *Wallet) DepositAsset(asset string, amount uint) error {
+	var args [2]interface{}
+	args[0] = asset
+	args[1] = amount
+
+	var argsSerialized []byte
+
+	ret := [1]interface{}{}
+	var ret0 *foundation.Error
+	ret[0] = &ret0
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	res, err := proxyctx.Current.RouteCall(r.Reference, true, "DepositAsset", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	err = proxyctx.Current.Deserialize(res, &ret)
+	if err != nil {
+		return err
+	}
+
+	if ret0 != nil {
+		return ret0
+	}
+	return nil
+}
+
+// DepositAssetNoWait is proxy generated method
+func (r *Wallet) DepositAssetNoWait(asset string, amount uint) error {
+	var args [2]interface{}
+	args[0] = asset
+	args[1] = amount
+
+	var argsSerialized []byte
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	_, err = proxyctx.Current.RouteCall(r.Reference, false, "DepositAsset", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// WithdrawAsset is proxy generated method
+func (r *Wallet) WithdrawAsset(asset string, amount uint) error {
+	var args [2]interface{}
+	args[0] = asset
+	args[1] = amount
+
+	var argsSerialized []byte
+
+	ret := [1]interface{}{}
+	var ret0 *foundation.Error
+	ret[0] = &ret0
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	res, err := proxyctx.Current.RouteCall(r.Reference, true, "WithdrawAsset", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	err = proxyctx.Current.Deserialize(res, &ret)
+	if err != nil {
+		return err
+	}
+
+	if ret0 != nil {
+		return ret0
+	}
+	return nil
+}
+
+// WithdrawAssetNoWait is proxy generated method
+func (r *Wallet) WithdrawAssetNoWait(asset string, amount uint) error {
+	var args [2]interface{}
+	args[0] = asset
+	args[1] = amount
+
+	var argsSerialized []byte
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	_, err = proxyctx.Current.RouteCall(r.Reference, false, "WithdrawAsset", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // GetBalance is proxy generated method
 func (r *Wallet) GetBalance() (uint, error) {
 	var args [0]interface{}
@@ -311,3 +549,167 @@ func (r *Wallet) GetBalanceNoWait() error {
 
 	return nil
 }
+
+// GetAssetBalance is proxy generated method
+func (r *Wallet) GetAssetBalance(asset string) (uint, error) {
+	var args [1]interface{}
+	args[0] = asset
+
+	var argsSerialized []byte
+
+	ret := [2]interface{}{}
+	var ret0 uint
+	ret[0] = &ret0
+	var ret1 *foundation.Error
+	ret[1] = &ret1
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return ret0, err
+	}
+
+	res, err := proxyctx.Current.RouteCall(r.Reference, true, "GetAssetBalance", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return ret0, err
+	}
+
+	err = proxyctx.Current.Deserialize(res, &ret)
+	if err != nil {
+		return ret0, err
+	}
+
+	if ret1 != nil {
+		return ret0, ret1
+	}
+	return ret0, nil
+}
+
+// GetAssetBalanceNoWait is proxy generated method
+func (r *Wallet) GetAssetBalanceNoWait(asset string) error {
+	var args [1]interface{}
+	args[0] = asset
+
+	var argsSerialized []byte
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	_, err = proxyctx.Current.RouteCall(r.Reference, false, "GetAssetBalance", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetCurrentBalance is proxy generated method
+func (r *Wallet) GetCurrentBalance(asset string) (uint, error) {
+	var args [1]interface{}
+	args[0] = asset
+
+	var argsSerialized []byte
+
+	ret := [2]interface{}{}
+	var ret0 uint
+	ret[0] = &ret0
+	var ret1 *foundation.Error
+	ret[1] = &ret1
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return ret0, err
+	}
+
+	res, err := proxyctx.Current.RouteCall(r.Reference, true, "GetCurrentBalance", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return ret0, err
+	}
+
+	err = proxyctx.Current.Deserialize(res, &ret)
+	if err != nil {
+		return ret0, err
+	}
+
+	if ret1 != nil {
+		return ret0, ret1
+	}
+	return ret0, nil
+}
+
+// GetCurrentBalanceNoWait is proxy generated method
+func (r *Wallet) GetCurrentBalanceNoWait(asset string) error {
+	var args [1]interface{}
+	args[0] = asset
+
+	var argsSerialized []byte
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	_, err = proxyctx.Current.RouteCall(r.Reference, false, "GetCurrentBalance", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetTransferHistory is proxy generated method
+func (r *Wallet) GetTransferHistory(cursor string, limit int) (*TransferHistory, error) {
+	var args [2]interface{}
+	args[0] = cursor
+	args[1] = limit
+
+	var argsSerialized []byte
+
+	ret := [2]interface{}{}
+	var ret0 *TransferHistory
+	ret[0] = &ret0
+	var ret1 *foundation.Error
+	ret[1] = &ret1
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return ret0, err
+	}
+
+	res, err := proxyctx.Current.RouteCall(r.Reference, true, "GetTransferHistory", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return ret0, err
+	}
+
+	err = proxyctx.Current.Deserialize(res, &ret)
+	if err != nil {
+		return ret0, err
+	}
+
+	if ret1 != nil {
+		return ret0, ret1
+	}
+	return ret0, nil
+}
+
+// GetTransferHistoryNoWait is proxy generated method
+func (r *Wallet) GetTransferHistoryNoWait(cursor string, limit int) error {
+	var args [2]interface{}
+	args[0] = cursor
+	args[1] = limit
+
+	var argsSerialized []byte
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	_, err = proxyctx.Current.RouteCall(r.Reference, false, "GetTransferHistory", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -69,6 +69,17 @@ func main() {
 	output := newOutputFlag("-")
 	proxyOut := newOutputFlag("")
 
+	var sandboxAllow []string
+	noSandbox := false
+	parseFile := func(fileName string) (*preprocessor.ParsedFile, error) {
+		if noSandbox {
+			return preprocessor.ParseFileWithSandbox(fileName, nil)
+		}
+		sandbox := preprocessor.DefaultSandbox()
+		sandbox.Allow(sandboxAllow...)
+		return preprocessor.ParseFileWithSandbox(fileName, sandbox)
+	}
+
 	var cmdProxy = &cobra.Command{
 		Use:   "proxy [flags] <file name to process>",
 		Short: "Generate contract's proxy",
@@ -79,7 +90,7 @@ func main() {
 				os.Exit(1)
 			}
 
-			parsed, err := preprocessor.ParseFile(args[0])
+			parsed, err := parseFile(args[0])
 			if err != nil {
 				fmt.Println(errors.Wrap(err, "couldn't parse"))
 				os.Exit(1)
@@ -124,6 +135,7 @@ func main() {
 	cmdProxy.Flags().StringVarP(&reference, "code-reference", "r", "", "reference to code of")
 	cmdProxy.Flags().VarP(proxyOut, "output", "o", "output file (use - for STDOUT)")
 
+	builtin := false
 	var cmdWrapper = &cobra.Command{
 		Use:   "wrapper [flags] <file name to process>",
 		Short: "Generate contract's wrapper",
@@ -132,13 +144,17 @@ func main() {
 				fmt.Println("wrapper command should be followed by exactly one file name to process")
 				os.Exit(1)
 			}
-			parsed, err := preprocessor.ParseFile(args[0])
+			parsed, err := parseFile(args[0])
 			if err != nil {
 				fmt.Println(errors.Wrap(err, "couldn't parse"))
 				os.Exit(1)
 			}
 
-			err = parsed.WriteWrapper(output.writer)
+			if builtin {
+				err = parsed.WriteBuiltinWrapper(output.writer)
+			} else {
+				err = parsed.WriteWrapper(output.writer)
+			}
 			if err != nil {
 				fmt.Println(err)
 				os.Exit(1)
@@ -146,6 +162,7 @@ func main() {
 		},
 	}
 	cmdWrapper.Flags().VarP(output, "output", "o", "output file (use - for STDOUT)")
+	cmdWrapper.Flags().BoolVarP(&builtin, "builtin", "b", false, "generate wrapper for builtin machine type in package of the contract (default \"false\")")
 
 	var cmdImports = &cobra.Command{
 		Use:   "imports [flags] <file name to process>",
@@ -155,7 +172,7 @@ func main() {
 				fmt.Println("imports command should be followed by exactly one file name to process")
 				os.Exit(1)
 			}
-			parsed, err := preprocessor.ParseFile(args[0])
+			parsed, err := parseFile(args[0])
 			if err != nil {
 				fmt.Println(errors.Wrap(err, "couldn't parse"))
 				os.Exit(1)
@@ -173,6 +190,7 @@ func main() {
 	// PLEASE NOTE that `insgocc compile` is in fact not used for compiling contracts by insolard.
 	// Instead contracts are compiled when `insolard genesis` is executed without using `insgocc`.
 	keepTemp := false
+	upgradeOf := ""
 	var cmdCompile = &cobra.Command{
 		Use:   "compile [flags] <file name to compile>",
 		Short: "Compile contract",
@@ -186,12 +204,25 @@ func main() {
 				fmt.Println("compile command should be followed by exactly one file name to compile")
 				os.Exit(1)
 			}
-			parsed, err := preprocessor.ParseFile(args[0])
+			parsed, err := parseFile(args[0])
 			if err != nil {
 				fmt.Println(err)
 				os.Exit(1)
 			}
 
+			if upgradeOf != "" {
+				previous, err := parseFile(upgradeOf)
+				if err != nil {
+					fmt.Println(err)
+					os.Exit(1)
+				}
+				err = parsed.CheckUpgrade(previous)
+				if err != nil {
+					fmt.Println(err)
+					os.Exit(1)
+				}
+			}
+
 			// make temporary dir
 			tmpDir, err := ioutil.TempDir("", "temp-")
 			if err != nil {
@@ -251,10 +282,13 @@ func main() {
 	}
 	// default value for string flags is displayed automatically
 	cmdCompile.Flags().StringVarP(&outdir, "output-dir", "o", ".", "output dir")
+	cmdCompile.Flags().StringVarP(&upgradeOf, "upgrade-of", "u", "", "previous code of the contract, public methods of it should be kept")
 	// default value for bool flags is not displayed automatically, thus it's done manually here
 	cmdCompile.Flags().BoolVarP(&keepTemp, "keep-temp", "k", false, "keep temp directory (default \"false\")")
 
 	var rootCmd = &cobra.Command{Use: "insgocc"}
+	rootCmd.PersistentFlags().StringSliceVar(&sandboxAllow, "allow", nil, "packages or functions (like time.Now) contracts can use in addition to default ones")
+	rootCmd.PersistentFlags().BoolVar(&noSandbox, "no-sandbox", false, "don't check that contract code is deterministic, for tests only (default \"false\")")
 	rootCmd.AddCommand(cmdProxy, cmdWrapper, cmdImports, cmdCompile)
 	err := rootCmd.Execute()
 	if err != nil {
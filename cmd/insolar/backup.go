@@ -0,0 +1,129 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/backup"
+)
+
+func loadNodeConfig() configuration.Configuration {
+	cfgHolder := configuration.NewHolder()
+	err := cfgHolder.LoadFromFile(configPath)
+	check("Can't load node configuration:", err)
+	return cfgHolder.Configuration
+}
+
+// backupLedger writes backup archive of stopped heavy node. Use admin endpoint for running node.
+func backupLedger(out io.Writer) {
+	conf := loadNodeConfig().Ledger.Storage
+
+	legacy, err := store.Open(conf.Engine, conf.DataDirectory)
+	check("Can't open storage:", err)
+	db, err := store.Open(conf.Engine, conf.DataDirectoryNewDB)
+	check("Can't open storage:", err)
+
+	ctx := inslogger.ContextWithTrace(context.Background(), "insolarUtility")
+	pn, err := backup.Backup(ctx, legacy, db, insolar.PulseNumber(pulseNumber), out)
+	legacy.Close()
+	db.Close()
+	check("Backup failed:", err)
+	verboseInfo(fmt.Sprintf("Backup on pulse %v is done", pn))
+}
+
+// restoreLedger rebuilds node storage directories from backup archive. Directories should not exist or be empty.
+func restoreLedger(out io.Writer) {
+	conf := loadNodeConfig().Ledger.Storage
+
+	var in io.Reader = os.Stdin
+	if inputPath != defaultStdoutPath {
+		f, err := os.Open(inputPath)
+		check("Can't open archive:", err)
+		defer f.Close()
+		in = f
+	}
+
+	dirs := []string{conf.DataDirectory, conf.DataDirectoryNewDB}
+	tmpDirs := make([]string, len(dirs))
+	for i, dir := range dirs {
+		err := checkEmptyDir(dir)
+		check("Can't restore:", err)
+		parent := filepath.Dir(filepath.Clean(dir))
+		err = os.MkdirAll(parent, 0700)
+		check("Can't create directory:", err)
+		tmpDirs[i], err = ioutil.TempDir(parent, filepath.Base(dir)+".restore-")
+		check("Can't create directory:", err)
+	}
+
+	pn, err := restoreToDirs(in, conf.Engine, tmpDirs)
+	if err != nil {
+		for _, dir := range tmpDirs {
+			os.RemoveAll(dir)
+		}
+		check("Restore failed:", err)
+	}
+
+	for i, dir := range dirs {
+		// Empty directory is removed to be replaced.
+		os.Remove(dir)
+		err = os.Rename(tmpDirs[i], dir)
+		check("Can't move restored storage:", err)
+	}
+	writeToOutput(out, fmt.Sprintf("Restored on pulse %v\n", pn))
+}
+
+func restoreToDirs(in io.Reader, engine string, dirs []string) (insolar.PulseNumber, error) {
+	legacy, err := store.Open(engine, dirs[0])
+	if err != nil {
+		return 0, err
+	}
+	defer legacy.Close()
+	db, err := store.Open(engine, dirs[1])
+	if err != nil {
+		return 0, err
+	}
+	defer db.Close()
+
+	ctx := inslogger.ContextWithTrace(context.Background(), "insolarUtility")
+	return backup.Restore(ctx, in, legacy, db, insolar.PulseNumber(pulseNumber))
+}
+
+func checkEmptyDir(dir string) error {
+	files, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if len(files) > 0 {
+		return errors.Errorf("directory %s is not empty", dir)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/fsck"
+	"github.com/insolar/insolar/platformpolicy"
+)
+
+// checkLedger checks storage of stopped heavy node. Use admin endpoint for running node.
+func checkLedger(out io.Writer) {
+	conf := loadNodeConfig().Ledger.Storage
+
+	// Storage is not opened if it doesn't exist, otherwise an empty one would be created.
+	_, err := os.Stat(conf.DataDirectoryNewDB)
+	if os.IsNotExist(err) {
+		err = errors.Errorf("directory %s doesn't exist", conf.DataDirectoryNewDB)
+	}
+	check("Can't open storage:", err)
+	db, err := store.Open(conf.Engine, conf.DataDirectoryNewDB)
+	check("Can't open storage:", err)
+
+	ctx := inslogger.ContextWithTrace(context.Background(), "insolarUtility")
+	report, err := fsck.Check(ctx, db, platformpolicy.NewPlatformCryptographyScheme())
+	db.Close()
+	check("Check failed:", err)
+
+	if jsonReport {
+		err = report.WriteJSON(out)
+	} else {
+		err = report.WriteText(out)
+	}
+	check("Can't write report:", err)
+	if !report.OK() {
+		os.Exit(1)
+	}
+}
@@ -96,12 +96,15 @@ var (
 	sendUrls           string
 	rootAsCaller       bool
 	logLevelServer     insolar.LogLevel
+	inputPath          string
+	pulseNumber        uint32
+	jsonReport         bool
 )
 
 func parseInputParams() {
 	var rootCmd = &cobra.Command{}
 	rootCmd.Flags().StringVarP(&cmd, "cmd", "c", "",
-		"available commands: default_config | random_ref | version | gen_keys | gen_certificate | send_request | gen_send_configs | get_info | create_member")
+		"available commands: default_config | random_ref | version | gen_keys | gen_certificate | send_request | gen_send_configs | get_info | create_member | approve_proposal | get_proposals | backup | restore | fsck | inspect_tape | replay_tape")
 	rootCmd.Flags().BoolVarP(&verbose, "verbose", "v", false, "be verbose (default false)")
 	rootCmd.Flags().StringVarP(&output, "output", "o", defaultStdoutPath, "output file (use - for STDOUT)")
 	rootCmd.Flags().StringVarP(&sendUrls, "url", "u", defaultURL, "api url")
@@ -109,6 +112,9 @@ func parseInputParams() {
 	rootCmd.Flags().StringVarP(&configPath, "config", "g", "config.json", "path to configuration file")
 	rootCmd.Flags().StringVarP(&paramsPath, "params", "p", "", "path to params file (default params.json)")
 	rootCmd.Flags().BoolVarP(&rootAsCaller, "root_as_caller", "r", false, "use root member as caller")
+	rootCmd.Flags().StringVarP(&inputPath, "input", "i", defaultStdoutPath, "input file (use - for STDIN)")
+	rootCmd.Flags().Uint32VarP(&pulseNumber, "pulse", "P", 0, "backup pulse (default latest)")
+	rootCmd.Flags().BoolVarP(&jsonReport, "json", "j", false, "write fsck report as JSON")
 
 	var logLevelServerString string
 	rootCmd.Flags().StringVarP(&logLevelServerString, "log_level_server", "L", "", "server log level")
@@ -154,6 +160,20 @@ func main() {
 		getInfo(out)
 	case "create_member":
 		createMember(out)
+	case "approve_proposal":
+		approveProposal(out)
+	case "get_proposals":
+		getProposals(out)
+	case "backup":
+		backupLedger(out)
+	case "restore":
+		restoreLedger(out)
+	case "fsck":
+		checkLedger(out)
+	case "inspect_tape":
+		inspectTape(out)
+	case "replay_tape":
+		replayTape(out)
 	}
 }
 
@@ -299,6 +319,32 @@ func sendRequest(out io.Writer) {
 	writeToOutput(out, string(response))
 }
 
+func approveProposal(out io.Writer) {
+	proposal := os.Args[len(os.Args)-1]
+
+	requester.SetVerbose(verbose)
+	userCfg, err := requester.ReadUserConfigFromFile(configPath)
+	check("[ approveProposal ]", err)
+
+	ctx := inslogger.ContextWithTrace(context.Background(), "insolarUtility")
+	response, err := requester.ApproveProposal(ctx, sendUrls, userCfg, proposal)
+	check("[ approveProposal ]", err)
+
+	writeToOutput(out, string(response))
+}
+
+func getProposals(out io.Writer) {
+	requester.SetVerbose(verbose)
+	userCfg, err := requester.ReadUserConfigFromFile(configPath)
+	check("[ getProposals ]", err)
+
+	ctx := inslogger.ContextWithTrace(context.Background(), "insolarUtility")
+	response, err := requester.GetProposals(ctx, sendUrls, userCfg)
+	check("[ getProposals ]", err)
+
+	writeToOutput(out, string(response))
+}
+
 func genSendConfigs(out io.Writer) {
 	reqConf, err := genDefaultConfig(requester.RequestConfigJSON{})
 	check("[ genSendConfigs ]", err)
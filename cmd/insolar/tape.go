@@ -0,0 +1,107 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/logicrunner"
+	"github.com/insolar/insolar/messagebus"
+)
+
+func readTape() *messagebus.Tape {
+	var in io.Reader = os.Stdin
+	if inputPath != defaultStdoutPath {
+		f, err := os.Open(inputPath)
+		check("Can't open tape:", err)
+		defer f.Close()
+		in = f
+	}
+
+	tape, err := messagebus.ReadTape(in)
+	check("Can't read tape:", err)
+	return tape
+}
+
+// inspectTape prints messages with their replies recorded on tape. Contents of messages and replies are printed
+// in verbose mode.
+func inspectTape(out io.Writer) {
+	tape := readTape()
+
+	fmt.Fprintf(out, "Version  : %d\n", tape.Header.Version)
+	fmt.Fprintf(out, "Pulse    : %d\n", tape.Header.Pulse)
+	if req := tape.Header.Request; req != nil {
+		fmt.Fprintf(out, "Request  : %s %s\n", req.Parcel.Type(), req.Request.String())
+		printTapeValue(out, "message", req.Parcel.Message())
+		printTapeValue(out, "reply", req.Reply)
+		if req.Error != "" {
+			fmt.Fprintf(out, "  %-7s: %s\n", "error", req.Error)
+		}
+	}
+	fmt.Fprintf(out, "Messages : %d\n", len(tape.Entries))
+	for i, entry := range tape.Entries {
+		msgType := "unknown"
+		if entry.Message != nil {
+			msgType = entry.Message.Type().String()
+		}
+		fmt.Fprintf(out, "#%d %s %s\n", i, msgType, hex.EncodeToString(entry.MsgHash))
+		if verbose {
+			printTapeValue(out, "message", entry.Message)
+		}
+		printTapeValue(out, "reply", entry.Reply)
+		if entry.Error != nil {
+			fmt.Fprintf(out, "  %-7s: %s (%s)\n", "error", entry.Error, tapeErrorType(entry.Error))
+		}
+	}
+}
+
+// printTapeValue prints type of the value, its contents are printed in verbose mode.
+func printTapeValue(out io.Writer, name string, v interface{}) {
+	if v == nil {
+		return
+	}
+	if verbose {
+		fmt.Fprintf(out, "  %-7s: %T %+v\n", name, v, v)
+		return
+	}
+	fmt.Fprintf(out, "  %-7s: %T\n", name, v)
+}
+
+func tapeErrorType(err error) string {
+	if tapeErr, ok := err.(*messagebus.TapeError); ok {
+		return tapeErr.Type
+	}
+	return fmt.Sprintf("%T", errors.Cause(err))
+}
+
+// replayTape executes request recorded on tape with logic runner configured by node configuration.
+func replayTape(out io.Writer) {
+	tape := readTape()
+	conf := loadNodeConfig().LogicRunner
+
+	ctx := inslogger.ContextWithTrace(context.Background(), "insolarUtility")
+	err := logicrunner.Replay(ctx, conf, tape)
+	check("Replay failed:", err)
+	writeToOutput(out, "Replayed execution matches the recorded one\n")
+}
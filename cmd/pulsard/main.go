@@ -138,7 +138,12 @@ func initPulsar(ctx context.Context, cfg configuration.Configuration) (*componen
 	cryptographyService := cryptography.NewCryptographyService()
 	keyProcessor := platformpolicy.NewKeyProcessor()
 
-	tp, publicAddress, err := transport.NewTransport(cfg.Pulsar.DistributionTransport)
+	privateKey, err := keyStore.GetPrivateKey("")
+	if err != nil {
+		inslogger.FromContext(ctx).Fatal(err)
+	}
+
+	tp, publicAddress, err := transport.NewTransport(cfg.Pulsar.DistributionTransport, privateKey, nil)
 	if err != nil {
 		inslogger.FromContext(ctx).Fatal(err)
 	}
@@ -26,6 +26,8 @@ type APIRunner struct {
 	Call    string
 	RPC     string
 	Timeout uint32
+	// Subscribe is a path of server-sent events stream. Stream is disabled if path is empty.
+	Subscribe string
 }
 
 // NewAPIRunner creates new api config
@@ -35,10 +37,12 @@ func NewAPIRunner() APIRunner {
 		Call:    "/api/call",
 		RPC:     "/api/rpc",
 		Timeout: 15,
+
+		Subscribe: "/api/subscribe",
 	}
 }
 
 func (ar *APIRunner) String() string {
-	res := fmt.Sprintln("Addr ->", ar.Address, ", Call ->", ar.Call, ", RPC ->", ar.RPC)
+	res := fmt.Sprintln("Addr ->", ar.Address, ", Call ->", ar.Call, ", RPC ->", ar.RPC, ", Subscribe ->", ar.Subscribe)
 	return res
 }
@@ -22,6 +22,8 @@ import (
 
 // Storage configures Ledger's storage.
 type Storage struct {
+	// Engine is an embedded key-value engine used for persistent storages: "badger" or "bolt".
+	Engine string
 	// DataDirectory is a directory where database's files live.
 	DataDirectory string
 	// DataDirectoryNewDB is a directory where new database's files live.
@@ -37,10 +39,38 @@ type PulseManager struct {
 	HeavySyncEnabled bool
 	// HeavySyncMessageLimit soft limit of single message for replication to heavy.
 	HeavySyncMessageLimit int
+	// HeavySyncCompressions is a list of payload compressions offered to heavy in order of preference:
+	// "flate" or "none". Heavy picks the first one it supports.
+	HeavySyncCompressions []string
+	// HeavySyncChunkRetries is a number of attempts to resend a payload chunk to heavy before sync fails.
+	HeavySyncChunkRetries int
 	// Backoff configures retry backoff algorithm for Heavy Sync
 	HeavyBackoff Backoff
 	// SplitThreshold is a drop size threshold in bytes to perform split.
 	SplitThreshold uint64
+	// MergeThreshold is a combined drop size threshold in bytes of two sibling jets to perform merge with "size" split
+	// policy. It must be less than SplitThreshold. Zero value disables merge.
+	MergeThreshold uint64
+}
+
+// JetSplit holds configuration of the policy which decides when jets are split.
+type JetSplit struct {
+	// Policy is a name of the split policy:
+	//  "records" - split if drop has more records than RecordsThreshold;
+	//  "size" - split if drop is bigger than PulseManager.SplitThreshold;
+	//  "rate" - split if jet receives more requests per second than RateThreshold;
+	//  "depth" - split all jets until Depth is reached regardless of load.
+	// Only "size" policy merges jets, see PulseManager.MergeThreshold.
+	Policy string
+	// RecordsThreshold is a count of records in a drop to perform split.
+	RecordsThreshold int
+	// RateThreshold is a count of requests per second to perform split.
+	RateThreshold float64
+	// Depth is a jet depth to split all jets to with "depth" policy.
+	Depth uint8
+	// MaxDepth limits depth of jets produced by split. Zero value means no limit.
+	// It's not applied to "depth" policy.
+	MaxDepth uint8
 }
 
 // Backoff configures retry backoff algorithm
@@ -64,6 +94,18 @@ type Exporter struct {
 	ExportLag uint32
 }
 
+// Backup holds configuration of heavy node backups.
+type Backup struct {
+	// Endpoint is a path of API server where heavy node streams backup archives. Only local requests are served.
+	Endpoint string
+}
+
+// Fsck holds configuration of heavy node storage checks.
+type Fsck struct {
+	// Endpoint is a path of API server where heavy node serves storage check reports. Only local requests are served.
+	Endpoint string
+}
+
 // Ledger holds configuration for ledger.
 type Ledger struct {
 	// Storage defines storage configuration.
@@ -87,12 +129,22 @@ type Ledger struct {
 	// PendingRequestsLimit holds a number of pending requests, what can be stored in the system
 	// before they are declined
 	PendingRequestsLimit int
+
+	// Backup holds configuration of heavy node backups.
+	Backup Backup
+
+	// Fsck holds configuration of heavy node storage checks.
+	Fsck Fsck
+
+	// JetSplit holds configuration of jet split policy.
+	JetSplit JetSplit
 }
 
 // NewLedger creates new default Ledger configuration.
 func NewLedger() Ledger {
 	return Ledger{
 		Storage: Storage{
+			Engine:              "badger",
 			DataDirectory:       "./data",
 			DataDirectoryNewDB:  "./new-data",
 			TxRetriesOnConflict: 3,
@@ -101,6 +153,8 @@ func NewLedger() Ledger {
 		PulseManager: PulseManager{
 			HeavySyncEnabled:      true,
 			HeavySyncMessageLimit: 1 << 20, // 1Mb
+			HeavySyncCompressions: []string{"flate"},
+			HeavySyncChunkRetries: 3,
 			HeavyBackoff: Backoff{
 				Jitter: true,
 				Min:    200 * time.Millisecond,
@@ -121,5 +175,22 @@ func NewLedger() Ledger {
 		},
 
 		PendingRequestsLimit: 1000,
+
+		Backup: Backup{
+			Endpoint: "/admin/backup",
+		},
+
+		Fsck: Fsck{
+			Endpoint: "/admin/fsck",
+		},
+
+		// Jets are split regardless of load, so networks run with several jets from the start.
+		JetSplit: JetSplit{
+			Policy:           "depth",
+			RecordsThreshold: 1000,
+			RateThreshold:    100,
+			Depth:            2,
+			MaxDepth:         10,
+		},
 	}
 }
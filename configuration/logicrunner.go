@@ -16,6 +16,10 @@
 
 package configuration
 
+import (
+	"time"
+)
+
 // LogicRunner configuration
 type LogicRunner struct {
 	// RPCListen - address logic runner binds RPC API to
@@ -27,6 +31,33 @@ type LogicRunner struct {
 	BuiltIn *BuiltIn
 	// GoPlugin - configuration of executor based on Go plugins
 	GoPlugin *GoPlugin
+	// WASM - configuration of executor of WebAssembly modules
+	WASM *WASM
+	// Budget - default execution budget of a contract call
+	Budget *ExecutionBudget
+	// PrototypeBudgets - execution budgets of particular prototypes, they override the default one
+	PrototypeBudgets []PrototypeBudget
+	// TapeDir - directory messagebus tapes of executed requests are saved to, they can be replayed
+	// with `insolar -c=replay_tape`; tapes aren't saved if it's empty
+	TapeDir string
+}
+
+// ExecutionBudget limits resources a single contract call can consume, zero value means no limit
+type ExecutionBudget struct {
+	// MaxDuration - maximum wall time of the call
+	MaxDuration time.Duration
+	// MaxUpcalls - maximum number of outgoing calls and child object creations
+	MaxUpcalls int
+	// MaxStateSize - maximum size of serialized object state in bytes
+	MaxStateSize int
+}
+
+// PrototypeBudget is an execution budget of calls on objects of one prototype
+type PrototypeBudget struct {
+	// Prototype - base58 reference of the prototype
+	Prototype string
+	// Budget - execution budget, zero fields are taken from the default one
+	Budget ExecutionBudget
 }
 
 // BuiltIn configuration, no options at the moment
@@ -41,6 +72,10 @@ type GoPlugin struct {
 	RunnerProtocol string
 }
 
+// WASM configuration, no options at the moment, execution limits are the same on every node
+// and are defined by the executor itself
+type WASM struct{}
+
 // NewLogicRunner - returns default config of the logic runner
 func NewLogicRunner() LogicRunner {
 	return LogicRunner{
@@ -51,5 +86,11 @@ func NewLogicRunner() LogicRunner {
 			RunnerListen:   "127.0.0.1:7777",
 			RunnerProtocol: "tcp",
 		},
+		WASM: &WASM{},
+		Budget: &ExecutionBudget{
+			MaxDuration:  time.Minute,
+			MaxUpcalls:   1000,
+			MaxStateSize: 10 * 1024 * 1024,
+		},
 	}
 }
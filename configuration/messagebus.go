@@ -0,0 +1,79 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package configuration
+
+import (
+	"time"
+)
+
+// MessageBus holds configuration of message delivery.
+type MessageBus struct {
+	// High - delivery queue of latency-sensitive messages: contract calls and reads of objects they need
+	High DeliveryQueue
+	// Normal - delivery queue of messages of types not listed in other classes
+	Normal DeliveryQueue
+	// Low - delivery queue of bulk messages, e.g. heavy replication
+	Low DeliveryQueue
+	// BusyRetries - number of times a parcel is resent after receiver replied it is busy
+	BusyRetries int
+	// BusyRetryDelay - delay before the first resend of a parcel, it doubles with every next resend
+	BusyRetryDelay time.Duration
+}
+
+// DeliveryQueue holds configuration of a priority class of delivered messages.
+type DeliveryQueue struct {
+	// Types - names of message types of the class, e.g. TypeCallMethod
+	Types []string
+	// Workers - number of messages of the class handled concurrently, zero means handling on the network goroutine
+	Workers int
+	// Length - number of messages waiting for a worker, receiver replies busy to messages over the limit
+	Length int
+}
+
+// NewMessageBus creates new default configuration of message delivery.
+func NewMessageBus() MessageBus {
+	return MessageBus{
+		High: DeliveryQueue{
+			Types: []string{
+				"TypeCallMethod",
+				"TypeCallConstructor",
+				"TypeReturnResults",
+				"TypeGetCode",
+				"TypeGetObject",
+				"TypeGetDelegate",
+				"TypeGetChildren",
+			},
+			Workers: 64,
+			Length:  1024,
+		},
+		Normal: DeliveryQueue{
+			Types:   []string{},
+			Workers: 32,
+			Length:  1024,
+		},
+		Low: DeliveryQueue{
+			Types: []string{
+				"TypeHeavyStartStop",
+				"TypeHeavyPayload",
+			},
+			Workers: 2,
+			Length:  16,
+		},
+		BusyRetries:    3,
+		BusyRetryDelay: 100 * time.Millisecond,
+	}
+}
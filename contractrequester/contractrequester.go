@@ -35,7 +35,8 @@ import (
 
 // ContractRequester helps to call contracts
 type ContractRequester struct {
-	MessageBus  insolar.MessageBus `inject:""`
+	MessageBus  insolar.MessageBus  `inject:""`
+	NodeNetwork insolar.NodeNetwork `inject:""`
 	ResultMutex sync.Mutex
 	ResultMap   map[uint64]chan *message.ReturnResults
 	Sequence    uint64
@@ -159,6 +160,9 @@ func (cr *ContractRequester) CallMethod(ctx context.Context, base insolar.Messag
 		if ret.Error != "" {
 			return nil, errors.Wrap(errors.New(ret.Error), "CallMethod returns error")
 		}
+		if errReply, ok := ret.Reply.(*reply.Error); ok {
+			return nil, errors.Wrap(errReply.Error(), "CallMethod returns error")
+		}
 		retReply, ok := ret.Reply.(*reply.CallMethod)
 		if !ok {
 			return nil, errors.New("Reply is not CallMethod")
@@ -177,6 +181,51 @@ func (cr *ContractRequester) CallMethod(ctx context.Context, base insolar.Messag
 	return result, nil
 }
 
+// CallImmutable calls immutable method of contract on the current node, the call isn't registered in ledger
+// and its result is returned in reply right away
+func (cr *ContractRequester) CallImmutable(ctx context.Context, base insolar.Message, ref *insolar.Reference, method string, argsIn insolar.Arguments, mustPrototype *insolar.Reference) (insolar.Reply, error) {
+	ctx, span := instracer.StartSpan(ctx, "ContractRequester.CallImmutable "+method)
+	defer span.End()
+
+	baseMessage, ok := base.(*message.BaseLogicMessage)
+	if !ok {
+		return nil, errors.New("Wrong type for BaseMessage")
+	}
+
+	mb := insolar.MessageBusFromContext(ctx, cr.MessageBus)
+	if mb == nil {
+		inslogger.FromContext(ctx).Debug("Context doesn't provide MessageBus")
+		mb = cr.MessageBus
+	}
+
+	msg := &message.CallMethod{
+		BaseLogicMessage: *baseMessage,
+		ReturnMode:       message.ReturnResult,
+		ObjectRef:        *ref,
+		Method:           method,
+		Arguments:        argsIn,
+		Immutable:        true,
+	}
+	if mustPrototype != nil {
+		msg.ProxyPrototype = *mustPrototype
+	}
+
+	me := cr.NodeNetwork.GetOrigin().ID()
+	res, err := mb.Send(ctx, msg, &insolar.MessageSendOptions{Receiver: &me})
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't dispatch event")
+	}
+
+	switch r := res.(type) {
+	case *reply.CallMethod:
+		return r, nil
+	case *reply.Error:
+		return nil, errors.Wrap(r.Error(), "CallImmutable returns error")
+	default:
+		return nil, errors.Errorf("Got unexpected reply %T for CallImmutable", res)
+	}
+}
+
 func (cr *ContractRequester) CallConstructor(ctx context.Context, base insolar.Message, async bool,
 	prototype *insolar.Reference, to *insolar.Reference, method string,
 	argsIn insolar.Arguments, saveAs int) (*insolar.Reference, error) {
@@ -237,6 +286,9 @@ func (cr *ContractRequester) CallConstructor(ctx context.Context, base insolar.M
 		if ret.Error != "" {
 			return nil, errors.New(ret.Error)
 		}
+		if errReply, ok := ret.Reply.(*reply.Error); ok {
+			return nil, errReply.Error()
+		}
 		return &r.Request, nil
 	case <-ctx.Done():
 
@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/gojuno/minimock"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/insolar/insolar/component"
@@ -31,6 +32,7 @@ import (
 	"github.com/insolar/insolar/insolar/reply"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/testutils"
+	"github.com/insolar/insolar/testutils/network"
 	"github.com/stretchr/testify/require"
 )
 
@@ -44,14 +46,16 @@ func mockMessageBus(t *testing.T, result insolar.Reply) *testutils.MessageBusMoc
 
 func TestNew(t *testing.T) {
 	messageBus := mockMessageBus(t, nil)
+	nodeNetwork := network.NewNodeNetworkMock(t)
 
 	contractRequester, err := New()
 
 	cm := &component.Manager{}
-	cm.Inject(messageBus, contractRequester)
+	cm.Inject(messageBus, nodeNetwork, contractRequester)
 
 	require.NoError(t, err)
 	require.Equal(t, messageBus, contractRequester.MessageBus)
+	require.Equal(t, nodeNetwork, contractRequester.NodeNetwork)
 }
 
 func TestContractRequester_SendRequest(t *testing.T) {
@@ -198,3 +202,51 @@ func TestCallMethodWaitResults(t *testing.T) {
 	_, err = cr.CallMethod(ctx, msg, false, &ref, method, insolar.Arguments{}, &prototypeRef)
 	require.NoError(t, err)
 }
+
+func TestCallImmutable(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	me := testutils.RandomRef()
+	node := network.NewNetworkNodeMock(mc)
+	node.IDMock.Return(me)
+	nn := network.NewNodeNetworkMock(mc)
+	nn.GetOriginMock.Return(node)
+
+	mb := testutils.NewMessageBusMock(mc)
+
+	cr, err := New()
+	require.NoError(t, err)
+	cr.MessageBus = mb
+	cr.NodeNetwork = nn
+
+	ref := testutils.RandomRef()
+	prototypeRef := testutils.RandomRef()
+	method := testutils.RandomString()
+
+	mb.SendFunc = func(p context.Context, p1 insolar.Message, p2 *insolar.MessageSendOptions) (r insolar.Reply, r1 error) {
+		msg, ok := p1.(*message.CallMethod)
+		require.True(t, ok)
+		assert.True(t, msg.Immutable)
+		assert.Equal(t, ref, msg.ObjectRef)
+		assert.Equal(t, method, msg.Method)
+		assert.Equal(t, prototypeRef, msg.ProxyPrototype)
+		require.NotNil(t, p2)
+		assert.Equal(t, me, *p2.Receiver)
+		return &reply.CallMethod{Result: []byte{1, 2, 3}}, nil
+	}
+
+	res, err := cr.CallImmutable(ctx, &message.BaseLogicMessage{}, &ref, method, insolar.Arguments{}, &prototypeRef)
+	require.NoError(t, err)
+	assert.Equal(t, &reply.CallMethod{Result: []byte{1, 2, 3}}, res)
+	assert.Empty(t, cr.ResultMap)
+
+	mb.SendFunc = func(p context.Context, p1 insolar.Message, p2 *insolar.MessageSendOptions) (r insolar.Reply, r1 error) {
+		return &reply.Error{ErrType: reply.ErrExecutionBudgetExceeded}, nil
+	}
+	_, err = cr.CallImmutable(ctx, &message.BaseLogicMessage{}, &ref, method, insolar.Arguments{}, nil)
+	require.Error(t, err)
+	assert.Equal(t, insolar.ErrExecutionBudgetExceeded, errors.Cause(err))
+}
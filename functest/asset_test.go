@@ -0,0 +1,152 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// +build functest
+
+package functest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/insolar/insolar/testutils"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func getAssetBalance(caller *user, asset string, reference string) (int, error) {
+	res, err := signedRequest(caller, "GetAssetBalance", asset, reference)
+	if err != nil {
+		return 0, err
+	}
+	amount, ok := res.(float64)
+	if !ok {
+		return 0, errors.New("result is not int")
+	}
+	return int(amount), nil
+}
+
+func getAssetBalanceNoErr(t *testing.T, caller *user, asset string, reference string) int {
+	balance, err := getAssetBalance(caller, asset, reference)
+	require.NoError(t, err)
+	return balance
+}
+
+func checkAssetBalanceFewTimes(t *testing.T, caller *user, asset string, ref string, expected int) {
+	for i := 0; i < times; i++ {
+		balance := getAssetBalanceNoErr(t, caller, asset, ref)
+		if balance == expected {
+			return
+		}
+		time.Sleep(time.Second)
+	}
+	t.Error("Received asset balance is not equal expected")
+}
+
+func registerAsset(t *testing.T, issuer *user) string {
+	asset := "TOKEN" + testutils.RandomString()
+	_, err := signedRequest(&root, "RegisterAsset", asset, issuer.ref)
+	require.NoError(t, err)
+	return asset
+}
+
+func TestMintAsset(t *testing.T) {
+	issuer := createMember(t, "Issuer")
+	asset := registerAsset(t, issuer)
+
+	_, err := signedRequest(issuer, "MintAsset", asset, 1000, issuer.ref)
+	require.NoError(t, err)
+
+	require.Equal(t, 1000, getAssetBalanceNoErr(t, issuer, asset, issuer.ref))
+	require.Equal(t, 1000*1000*1000, getBalanceNoErr(t, issuer, issuer.ref))
+}
+
+func TestMintAssetNotIssuer(t *testing.T) {
+	issuer := createMember(t, "Issuer")
+	member := createMember(t, "Member")
+	asset := registerAsset(t, issuer)
+
+	_, err := signedRequest(member, "MintAsset", asset, 1000, member.ref)
+	require.Contains(t, err.Error(), "[ Mint ] only issuer of asset can do it")
+}
+
+func TestMintNotRegisteredAsset(t *testing.T) {
+	member := createMember(t, "Member")
+
+	_, err := signedRequest(member, "MintAsset", "UNKNOWN"+testutils.RandomString(), 1000, member.ref)
+	require.Contains(t, err.Error(), "is not registered")
+}
+
+func TestRegisterAssetNotRoot(t *testing.T) {
+	member := createMember(t, "Member")
+
+	_, err := signedRequest(member, "RegisterAsset", "TOKEN"+testutils.RandomString(), member.ref)
+	require.Contains(t, err.Error(), "[ RegisterAsset ] Only Root member can register asset")
+}
+
+func TestBurnAsset(t *testing.T) {
+	issuer := createMember(t, "Issuer")
+	asset := registerAsset(t, issuer)
+
+	_, err := signedRequest(issuer, "MintAsset", asset, 1000, issuer.ref)
+	require.NoError(t, err)
+
+	_, err = signedRequest(issuer, "BurnAsset", asset, 400)
+	require.NoError(t, err)
+	require.Equal(t, 600, getAssetBalanceNoErr(t, issuer, asset, issuer.ref))
+
+	_, err = signedRequest(issuer, "BurnAsset", asset, 1000)
+	require.Contains(t, err.Error(), "[ Burn ] Not enough supply")
+	require.Equal(t, 600, getAssetBalanceNoErr(t, issuer, asset, issuer.ref))
+}
+
+func TestTransferAsset(t *testing.T) {
+	issuer := createMember(t, "Issuer")
+	member := createMember(t, "Member")
+	asset := registerAsset(t, issuer)
+
+	_, err := signedRequest(issuer, "MintAsset", asset, 1000, issuer.ref)
+	require.NoError(t, err)
+
+	_, err = signedRequest(issuer, "TransferAsset", asset, 300, member.ref)
+	require.NoError(t, err)
+
+	checkAssetBalanceFewTimes(t, member, asset, member.ref, 300)
+	require.Equal(t, 700, getAssetBalanceNoErr(t, issuer, asset, issuer.ref))
+	require.Equal(t, 1000*1000*1000, getBalanceNoErr(t, member, member.ref))
+}
+
+func TestTransferAssetMoreThanAvailable(t *testing.T) {
+	issuer := createMember(t, "Issuer")
+	member := createMember(t, "Member")
+	asset := registerAsset(t, issuer)
+
+	_, err := signedRequest(issuer, "MintAsset", asset, 100, issuer.ref)
+	require.NoError(t, err)
+
+	_, err = signedRequest(issuer, "TransferAsset", asset, 101, member.ref)
+	require.Contains(t, err.Error(), "[ TransferAsset ] Not enough balance for transfer: subtrahend must be smaller than minuend")
+
+	require.Equal(t, 100, getAssetBalanceNoErr(t, issuer, asset, issuer.ref))
+	require.Equal(t, 0, getAssetBalanceNoErr(t, member, asset, member.ref))
+}
+
+func TestTransferAssetToMyself(t *testing.T) {
+	member := createMember(t, "Member")
+
+	_, err := signedRequest(member, "TransferAsset", "TOKEN", 100, member.ref)
+	require.Contains(t, err.Error(), "[ transferAssetCall ] Recipient must be different from the sender")
+}
@@ -0,0 +1,120 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// +build functest
+
+package functest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// escrowPulses is a number of pulses escrow stays releasable in tests
+const escrowPulses = 1000
+
+func createEscrow(t *testing.T, sender *user, amount int, to *user, arbiter *user, deadline uint32) string {
+	res, err := signedRequest(sender, "CreateEscrow", amount, to.ref, arbiter.ref, deadline)
+	require.NoError(t, err)
+	ref, ok := res.(string)
+	require.True(t, ok)
+	return ref
+}
+
+func TestEscrowReleaseByArbiter(t *testing.T) {
+	sender := createMember(t, "Sender")
+	receiver := createMember(t, "Receiver")
+	arbiter := createMember(t, "Arbiter")
+	oldReceiverBalance := getBalanceNoErr(t, receiver, receiver.ref)
+
+	escrow := createEscrow(t, sender, 100, receiver, arbiter, getStatus(t).PulseNumber+escrowPulses)
+	require.Equal(t, 1000*1000*1000-100, getBalanceNoErr(t, sender, sender.ref))
+
+	_, err := signedRequest(arbiter, "ReleaseEscrow", escrow)
+	require.NoError(t, err)
+
+	checkBalanceFewTimes(t, receiver, receiver.ref, oldReceiverBalance+100)
+	require.Equal(t, 1000*1000*1000-100, getBalanceNoErr(t, sender, sender.ref))
+}
+
+func TestEscrowReleaseByReceiver(t *testing.T) {
+	sender := createMember(t, "Sender")
+	receiver := createMember(t, "Receiver")
+	arbiter := createMember(t, "Arbiter")
+	oldReceiverBalance := getBalanceNoErr(t, receiver, receiver.ref)
+
+	escrow := createEscrow(t, sender, 100, receiver, arbiter, getStatus(t).PulseNumber+escrowPulses)
+
+	_, err := signedRequest(receiver, "ReleaseEscrow", escrow)
+	require.NoError(t, err)
+
+	require.Equal(t, oldReceiverBalance+100, getBalanceNoErr(t, receiver, receiver.ref))
+}
+
+func TestEscrowReleaseByStranger(t *testing.T) {
+	sender := createMember(t, "Sender")
+	receiver := createMember(t, "Receiver")
+	arbiter := createMember(t, "Arbiter")
+	stranger := createMember(t, "Stranger")
+
+	escrow := createEscrow(t, sender, 100, receiver, arbiter, getStatus(t).PulseNumber+escrowPulses)
+
+	_, err := signedRequest(stranger, "ReleaseEscrow", escrow)
+	require.Contains(t, err.Error(), "Only recepient can take amount")
+
+	_, err = signedRequest(sender, "ReleaseEscrow", escrow)
+	require.Contains(t, err.Error(), "Only recepient can take amount")
+}
+
+func TestEscrowRefundAfterDeadline(t *testing.T) {
+	sender := createMember(t, "Sender")
+	receiver := createMember(t, "Receiver")
+	arbiter := createMember(t, "Arbiter")
+	oldReceiverBalance := getBalanceNoErr(t, receiver, receiver.ref)
+
+	deadline := getStatus(t).PulseNumber + 1
+	escrow := createEscrow(t, sender, 100, receiver, arbiter, deadline)
+
+	for i := 0; i < times*5 && getStatus(t).PulseNumber <= deadline; i++ {
+		time.Sleep(time.Second)
+	}
+
+	_, err := signedRequest(arbiter, "ReleaseEscrow", escrow)
+	require.Contains(t, err.Error(), "Escrow deadline has passed")
+
+	checkBalanceFewTimes(t, sender, sender.ref, 1000*1000*1000)
+	require.Equal(t, oldReceiverBalance, getBalanceNoErr(t, receiver, receiver.ref))
+}
+
+func TestEscrowDeadlineInPast(t *testing.T) {
+	sender := createMember(t, "Sender")
+	receiver := createMember(t, "Receiver")
+	arbiter := createMember(t, "Arbiter")
+
+	_, err := signedRequest(sender, "CreateEscrow", 100, receiver.ref, arbiter.ref, 1)
+	require.Contains(t, err.Error(), "Deadline should be in future")
+}
+
+func TestEscrowNotEnoughBalance(t *testing.T) {
+	sender := createMember(t, "Sender")
+	receiver := createMember(t, "Receiver")
+	arbiter := createMember(t, "Arbiter")
+
+	_, err := signedRequest(sender, "CreateEscrow", 1000*1000*1000+1, receiver.ref, arbiter.ref, getStatus(t).PulseNumber+escrowPulses)
+	require.Contains(t, err.Error(), "Not enough balance for escrow")
+}
@@ -0,0 +1,140 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// +build functest
+
+package functest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type proposalStatus struct {
+	Proposal  string
+	Method    string
+	Approvals int
+	Threshold int
+}
+
+// createMultisigMember returns key holders of new multisig member, all of them use member reference as caller
+func createMultisigMember(t *testing.T, keys int, threshold int) []*user {
+	holders := make([]*user, 0, keys)
+	pubKeys := make([]string, 0, keys)
+	for i := 0; i < keys; i++ {
+		holder, err := newUserWithKeys()
+		require.NoError(t, err)
+		holders = append(holders, holder)
+		pubKeys = append(pubKeys, holder.pubKey)
+	}
+
+	result, err := signedRequest(&root, "CreateMultisigMember", "Treasury", pubKeys, threshold)
+	require.NoError(t, err)
+	ref, ok := result.(string)
+	require.True(t, ok)
+
+	for _, holder := range holders {
+		holder.ref = ref
+	}
+	return holders
+}
+
+func decodeProposalStatus(t *testing.T, result interface{}) proposalStatus {
+	data, err := base64.StdEncoding.DecodeString(result.(string))
+	require.NoError(t, err)
+
+	status := proposalStatus{}
+	err = json.Unmarshal(data, &status)
+	require.NoError(t, err)
+	return status
+}
+
+func TestMultisigTransfer(t *testing.T) {
+	holders := createMultisigMember(t, 3, 2)
+	receiver := createMember(t, "Receiver")
+	oldReceiverBalance := getBalanceNoErr(t, receiver, receiver.ref)
+
+	result, err := signedRequest(holders[0], "Transfer", 100, receiver.ref)
+	require.NoError(t, err)
+	status := decodeProposalStatus(t, result)
+	require.Equal(t, "Transfer", status.Method)
+	require.Equal(t, 1, status.Approvals)
+	require.Equal(t, 2, status.Threshold)
+
+	// transfer is not made until threshold is reached
+	require.Equal(t, 1000*1000*1000, getBalanceNoErr(t, holders[1], holders[1].ref))
+
+	_, err = signedRequest(holders[1], "ApproveProposal", status.Proposal)
+	require.NoError(t, err)
+
+	require.Equal(t, 1000*1000*1000-100, getBalanceNoErr(t, holders[2], holders[2].ref))
+	checkBalanceFewTimes(t, receiver, receiver.ref, oldReceiverBalance+100)
+
+	_, err = signedRequest(holders[2], "ApproveProposal", status.Proposal)
+	require.Contains(t, err.Error(), "Proposal not found or expired")
+}
+
+func TestMultisigApproveTwiceWithSameKey(t *testing.T) {
+	holders := createMultisigMember(t, 2, 2)
+	receiver := createMember(t, "Receiver")
+
+	result, err := signedRequest(holders[0], "Transfer", 100, receiver.ref)
+	require.NoError(t, err)
+	status := decodeProposalStatus(t, result)
+
+	_, err = signedRequest(holders[0], "ApproveProposal", status.Proposal)
+	require.Contains(t, err.Error(), "Proposal is already approved with this key")
+	require.Equal(t, 1000*1000*1000, getBalanceNoErr(t, holders[0], holders[0].ref))
+}
+
+func TestMultisigGetProposals(t *testing.T) {
+	holders := createMultisigMember(t, 2, 2)
+	receiver := createMember(t, "Receiver")
+
+	result, err := signedRequest(holders[0], "Transfer", 100, receiver.ref)
+	require.NoError(t, err)
+	status := decodeProposalStatus(t, result)
+
+	result, err = signedRequest(holders[1], "GetProposals")
+	require.NoError(t, err)
+	data, err := base64.StdEncoding.DecodeString(result.(string))
+	require.NoError(t, err)
+	proposals := []proposalStatus{}
+	err = json.Unmarshal(data, &proposals)
+	require.NoError(t, err)
+	require.Len(t, proposals, 1)
+	require.Equal(t, status.Proposal, proposals[0].Proposal)
+}
+
+func TestMultisigWrongKey(t *testing.T) {
+	holders := createMultisigMember(t, 2, 1)
+	stranger := createMember(t, "Stranger")
+	stranger.ref = holders[0].ref
+
+	_, err := signedRequest(stranger, "GetMyBalance")
+	require.Contains(t, err.Error(), "Incorrect signature")
+}
+
+func TestMultisigWrongThreshold(t *testing.T) {
+	holder, err := newUserWithKeys()
+	require.NoError(t, err)
+
+	_, err = signedRequest(&root, "CreateMultisigMember", "Treasury", []string{holder.pubKey}, 2)
+	require.Contains(t, err.Error(), "Threshold should be from 1 to 1")
+}
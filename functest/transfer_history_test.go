@@ -0,0 +1,125 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// +build functest
+
+package functest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type transferHistory struct {
+	Transfers []struct {
+		Counterparty string
+		Amount       int
+		Asset        string
+		Direction    string
+		Pulse        int
+		Request      string
+	}
+	Cursor string
+}
+
+func getTransferHistory(t *testing.T, caller *user, member string, cursor string, limit int) transferHistory {
+	resp, err := signedRequest(caller, "GetTransferHistory", member, cursor, limit)
+	require.NoError(t, err)
+
+	data, err := base64.StdEncoding.DecodeString(resp.(string))
+	require.NoError(t, err)
+
+	history := transferHistory{}
+	err = json.Unmarshal(data, &history)
+	require.NoError(t, err)
+	return history
+}
+
+func TestGetTransferHistory(t *testing.T) {
+	firstMember := createMember(t, "Member1")
+	secondMember := createMember(t, "Member2")
+
+	_, err := signedRequest(firstMember, "Transfer", 10, secondMember.ref)
+	require.NoError(t, err)
+	_, err = signedRequest(firstMember, "Transfer", 20, secondMember.ref)
+	require.NoError(t, err)
+
+	history := getTransferHistory(t, firstMember, firstMember.ref, "", 10)
+	require.Len(t, history.Transfers, 2)
+	require.Empty(t, history.Cursor)
+	require.Equal(t, 20, history.Transfers[0].Amount)
+	require.Equal(t, 10, history.Transfers[1].Amount)
+	for _, transfer := range history.Transfers {
+		require.Equal(t, "out", transfer.Direction)
+		require.NotEmpty(t, transfer.Counterparty)
+		require.NotEmpty(t, transfer.Request)
+		require.NotZero(t, transfer.Pulse)
+	}
+
+	// incoming transfers are accepted asynchronously
+	for i := 0; i < times; i++ {
+		history = getTransferHistory(t, secondMember, secondMember.ref, "", 10)
+		if len(history.Transfers) == 2 {
+			break
+		}
+		time.Sleep(time.Second)
+	}
+	require.Len(t, history.Transfers, 2)
+	require.Equal(t, "in", history.Transfers[0].Direction)
+}
+
+func TestGetTransferHistoryPagination(t *testing.T) {
+	firstMember := createMember(t, "Member1")
+	secondMember := createMember(t, "Member2")
+
+	for _, amount := range []int{1, 2, 3} {
+		_, err := signedRequest(firstMember, "Transfer", amount, secondMember.ref)
+		require.NoError(t, err)
+	}
+
+	page := getTransferHistory(t, firstMember, firstMember.ref, "", 2)
+	require.Len(t, page.Transfers, 2)
+	require.NotEmpty(t, page.Cursor)
+	require.Equal(t, 3, page.Transfers[0].Amount)
+	require.Equal(t, 2, page.Transfers[1].Amount)
+
+	page = getTransferHistory(t, firstMember, firstMember.ref, page.Cursor, 2)
+	require.Len(t, page.Transfers, 1)
+	require.Empty(t, page.Cursor)
+	require.Equal(t, 1, page.Transfers[0].Amount)
+}
+
+func TestGetTransferHistoryOfOtherMember(t *testing.T) {
+	firstMember := createMember(t, "Member1")
+	secondMember := createMember(t, "Member2")
+
+	_, err := signedRequest(firstMember, "GetTransferHistory", secondMember.ref, "", 10)
+	require.Contains(t, err.Error(), "You can get only your own history")
+
+	history := getTransferHistory(t, &root, secondMember.ref, "", 10)
+	require.Empty(t, history.Transfers)
+}
+
+func TestGetTransferHistoryWrongLimit(t *testing.T) {
+	member := createMember(t, "Member1")
+
+	_, err := signedRequest(member, "GetTransferHistory", member.ref, "", 0)
+	require.Contains(t, err.Error(), "Limit should be from 1 to")
+}
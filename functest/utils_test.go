@@ -86,6 +86,7 @@ type rpcInfoResponse struct {
 type statusResponse struct {
 	NetworkState    string `json:"NetworkState"`
 	WorkingListSize int    `json:"WorkingListSize"`
+	PulseNumber     uint32 `json:"PulseNumber"`
 }
 
 type rpcStatusResponse struct {
@@ -69,6 +69,8 @@ type ContractsBuilder struct {
 	ArtifactManager artifacts.Client
 	Prototypes      map[string]*insolar.Reference
 	Codes           map[string]*insolar.Reference
+	// MachineType of deployed code, builtin code record holds name of the contract
+	MachineType insolar.MachineType
 }
 
 // NewContractBuilder returns a new `ContractsBuilder`, takes in: path to tmp directory,
@@ -83,6 +85,7 @@ func NewContractBuilder(am artifacts.Client) *ContractsBuilder {
 		root:            tmpDir,
 		Prototypes:      make(map[string]*insolar.Reference),
 		Codes:           make(map[string]*insolar.Reference),
+		MachineType:     insolar.MachineTypeGoPlugin,
 		ArtifactManager: am}
 	return cb
 }
@@ -115,6 +118,10 @@ func (cb *ContractsBuilder) Build(ctx context.Context, contracts map[string]*pre
 	}
 
 	for name, code := range contracts {
+		if cb.MachineType == insolar.MachineTypeBuiltin {
+			// builtin contracts are compiled into insolard, there is nothing to build
+			break
+		}
 		code.ChangePackageToMain()
 
 		ctr, err := OpenFile(filepath.Join(cb.root, "src/contract", name), "main.go")
@@ -149,16 +156,9 @@ func (cb *ContractsBuilder) Build(ctx context.Context, contracts map[string]*pre
 	}
 
 	for name := range contracts {
-		log.Debugf("Building plugin for contract %q in %q", name, cb.root)
-		err := cb.plugin(name)
-		if err != nil {
-			return errors.Wrap(err, "[ Build ] Can't call plugin")
-		}
-		log.Debugf("Built plugin for contract %q", name)
-
-		pluginBinary, err := ioutil.ReadFile(filepath.Join(cb.root, "plugins", name+".so"))
+		code, err := cb.code(name)
 		if err != nil {
-			return errors.Wrap(err, "[ Build ] Can't ReadFile")
+			return err
 		}
 		codeReq, err := cb.ArtifactManager.RegisterRequest(
 			ctx, *domainRef, &message.Parcel{Msg: &message.GenesisRequest{Name: name + "_code"}},
@@ -171,7 +171,7 @@ func (cb *ContractsBuilder) Build(ctx context.Context, contracts map[string]*pre
 		codeID, err := cb.ArtifactManager.DeployCode(
 			ctx,
 			*domainRef, *insolar.NewReference(*domain, *codeReq),
-			pluginBinary, insolar.MachineTypeGoPlugin,
+			code, cb.MachineType,
 		)
 		codeRef := insolar.NewReference(*domain, *codeID)
 		if err != nil {
@@ -205,6 +205,26 @@ func (cb *ContractsBuilder) Build(ctx context.Context, contracts map[string]*pre
 	return nil
 }
 
+// code returns code of the contract to deploy
+func (cb *ContractsBuilder) code(name string) ([]byte, error) {
+	if cb.MachineType == insolar.MachineTypeBuiltin {
+		return []byte(name), nil
+	}
+
+	log.Debugf("Building plugin for contract %q in %q", name, cb.root)
+	err := cb.plugin(name)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Build ] Can't call plugin")
+	}
+	log.Debugf("Built plugin for contract %q", name)
+
+	pluginBinary, err := ioutil.ReadFile(filepath.Join(cb.root, "plugins", name+".so"))
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Build ] Can't ReadFile")
+	}
+	return pluginBinary, nil
+}
+
 // Plugin ...
 func (cb *ContractsBuilder) plugin(name string) error {
 	dstDir := filepath.Join(cb.root, "plugins")
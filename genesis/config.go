@@ -38,6 +38,8 @@ type Config struct {
 	ReuseKeys        bool   `mapstructure:"reuse_keys"`
 	RootBalance      uint   `mapstructure:"root_balance"`
 	MajorityRule     int    `mapstructure:"majority_rule"`
+	// BuiltinContracts deploys contracts with builtin machine type instead of go plugins
+	BuiltinContracts bool `mapstructure:"builtin_contracts"`
 	MinRoles         struct {
 		Virtual       uint `mapstructure:"virtual"`
 		HeavyMaterial uint `mapstructure:"heavy_material"`
@@ -27,6 +27,7 @@ import (
 	"path/filepath"
 	"strconv"
 
+	"github.com/insolar/insolar/application/contract/assetregistry"
 	"github.com/insolar/insolar/application/contract/member"
 	"github.com/insolar/insolar/application/contract/nodedomain"
 	"github.com/insolar/insolar/application/contract/noderecord"
@@ -49,10 +50,11 @@ const (
 	walletContract    = "wallet"
 	memberContract    = "member"
 	allowanceContract = "allowance"
+	assetRegistry     = "assetregistry"
 	nodeAmount        = 32
 )
 
-var contractNames = []string{walletContract, memberContract, allowanceContract, rootDomain, nodeDomain, nodeRecord}
+var contractNames = []string{walletContract, memberContract, allowanceContract, rootDomain, nodeDomain, nodeRecord, assetRegistry}
 
 type messageBusLocker interface {
 	Lock(ctx context.Context)
@@ -67,15 +69,16 @@ type nodeInfo struct {
 
 // Genesis is a component for precreation insolar contracts types and RootDomain instance
 type Genesis struct {
-	rootDomainRef   *insolar.Reference
-	nodeDomainRef   *insolar.Reference
-	rootMemberRef   *insolar.Reference
-	prototypeRefs   map[string]*insolar.Reference
-	isGenesis       bool
-	config          *Config
-	keyOut          string
-	ArtifactManager artifacts.Client `inject:""`
-	MBLock          messageBusLocker `inject:""`
+	rootDomainRef    *insolar.Reference
+	nodeDomainRef    *insolar.Reference
+	assetRegistryRef *insolar.Reference
+	rootMemberRef    *insolar.Reference
+	prototypeRefs    map[string]*insolar.Reference
+	isGenesis        bool
+	config           *Config
+	keyOut           string
+	ArtifactManager  artifacts.Client `inject:""`
+	MBLock           messageBusLocker `inject:""`
 }
 
 // NewGenesis creates new Genesis
@@ -186,6 +189,46 @@ func (g *Genesis) activateNodeDomain(
 	return desc, nil
 }
 
+func (g *Genesis) activateAssetRegistry(
+	ctx context.Context, domain *insolar.ID, cb *ContractsBuilder,
+) error {
+	ar, err := assetregistry.NewAssetRegistry()
+	if err != nil {
+		return errors.Wrap(err, "[ ActivateAssetRegistry ]")
+	}
+
+	instanceData, err := serializeInstance(ar)
+	if err != nil {
+		return errors.Wrap(err, "[ ActivateAssetRegistry ]")
+	}
+
+	contractID, err := g.ArtifactManager.RegisterRequest(ctx, *g.rootDomainRef, &message.Parcel{Msg: &message.GenesisRequest{Name: "AssetRegistry"}})
+
+	if err != nil {
+		return errors.Wrap(err, "[ ActivateAssetRegistry ] couldn't create asset registry instance")
+	}
+	contract := insolar.NewReference(*domain, *contractID)
+	_, err = g.ArtifactManager.ActivateObject(
+		ctx,
+		insolar.Reference{},
+		*contract,
+		*g.rootDomainRef,
+		*cb.Prototypes[assetRegistry],
+		false,
+		instanceData,
+	)
+	if err != nil {
+		return errors.Wrap(err, "[ ActivateAssetRegistry ] couldn't create asset registry instance")
+	}
+	_, err = g.ArtifactManager.RegisterResult(ctx, *g.rootDomainRef, *contract, nil)
+	if err != nil {
+		return errors.Wrap(err, "[ ActivateAssetRegistry ] couldn't create asset registry instance")
+	}
+
+	g.assetRegistryRef = contract
+	return nil
+}
+
 func (g *Genesis) activateRootMember(
 	ctx context.Context, domain *insolar.ID, cb *ContractsBuilder, rootPubKey string,
 ) error {
@@ -230,7 +273,11 @@ func (g *Genesis) activateRootMember(
 func (g *Genesis) updateRootDomain(
 	ctx context.Context, domainDesc artifacts.ObjectDescriptor,
 ) error {
-	updateData, err := serializeInstance(&rootdomain.RootDomain{RootMember: *g.rootMemberRef, NodeDomainRef: *g.nodeDomainRef})
+	updateData, err := serializeInstance(&rootdomain.RootDomain{
+		RootMember:       *g.rootMemberRef,
+		NodeDomainRef:    *g.nodeDomainRef,
+		AssetRegistryRef: *g.assetRegistryRef,
+	})
 	if err != nil {
 		return errors.Wrap(err, "[ updateRootDomain ]")
 	}
@@ -301,6 +348,10 @@ func (g *Genesis) activateSmartContracts(
 	if err != nil {
 		return nil, errors.Wrap(err, errMsg)
 	}
+	err = g.activateAssetRegistry(ctx, rootDomainID, cb)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg)
+	}
 	err = g.activateRootMember(ctx, rootDomainID, cb, rootPubKey)
 	if err != nil {
 		return nil, errors.Wrap(err, errMsg)
@@ -566,6 +617,9 @@ func (g *Genesis) Start(ctx context.Context) error {
 	}
 
 	cb := NewContractBuilder(g.ArtifactManager)
+	if g.config.BuiltinContracts {
+		cb.MachineType = insolar.MachineTypeBuiltin
+	}
 	g.prototypeRefs = cb.Prototypes
 	defer cb.Clean()
 
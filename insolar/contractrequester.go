@@ -28,6 +28,10 @@ type ContractRequester interface {
 	CallMethod(ctx context.Context, base Message, async bool,
 		ref *Reference, method string, argsIn Arguments,
 		mustPrototype *Reference) (Reply, error)
+	// CallImmutable calls immutable method of contract on the current node without registration in ledger
+	CallImmutable(ctx context.Context, base Message,
+		ref *Reference, method string, argsIn Arguments,
+		mustPrototype *Reference) (Reply, error)
 	CallConstructor(ctx context.Context, base Message, async bool,
 		prototype *Reference, to *Reference, method string, argsIn Arguments, saveType int) (*Reference, error)
 }
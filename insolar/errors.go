@@ -33,4 +33,8 @@ var (
 	ErrNotFound = errors.New("not found")
 	// ErrTooManyPendingRequests is returned when a limit of pending requests has been reached on a current LME
 	ErrTooManyPendingRequests = errors.New("the limit of pending requests count has been reached")
+	// ErrExecutionBudgetExceeded is returned when a contract call has exceeded its execution budget
+	ErrExecutionBudgetExceeded = errors.New("execution budget exceeded")
+	// ErrBusy is returned when receiver has too many messages of the same priority class to handle, retry later
+	ErrBusy = errors.New("receiver is busy, retry later")
 )
@@ -23,11 +23,13 @@ import (
 // HeavySync provides methods for sync on heavy node.
 //go:generate minimock -i github.com/insolar/insolar/insolar.HeavySync -o ../testutils -s _mock.go
 type HeavySync interface {
-	Start(ctx context.Context, jet ID, pn PulseNumber) error
+	Start(ctx context.Context, jet ID, pn PulseNumber, digest []byte) (uint32, error)
+	NextChunk(ctx context.Context, jet ID, pn PulseNumber) (uint32, error)
+	AckChunk(ctx context.Context, jet ID, pn PulseNumber, chunk uint32) (uint32, error)
 	StoreIndices(ctx context.Context, jet ID, pn PulseNumber, kvs []KV) error
 	StoreDrop(ctx context.Context, jetID JetID, rawDrop []byte) error
 	StoreBlobs(ctx context.Context, pn PulseNumber, rawBlobs [][]byte) error
 	StoreRecords(ctx context.Context, jet ID, pn PulseNumber, rawRecords [][]byte)
-	Stop(ctx context.Context, jet ID, pn PulseNumber) error
+	Stop(ctx context.Context, jet ID, pn PulseNumber, chunks uint32) error
 	Reset(ctx context.Context, jet ID, pn PulseNumber) error
 }
@@ -34,6 +34,7 @@ type Accessor interface {
 type Modifier interface {
 	Update(ctx context.Context, pulse insolar.PulseNumber, actual bool, ids ...insolar.JetID)
 	Split(ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID) (insolar.JetID, insolar.JetID, error)
+	Merge(ctx context.Context, pulse insolar.PulseNumber, left, right insolar.JetID) (insolar.JetID, error)
 	Clone(ctx context.Context, from, to insolar.PulseNumber)
 	Delete(ctx context.Context, pulse insolar.PulseNumber)
 }
@@ -56,6 +57,17 @@ func Parent(id insolar.JetID) insolar.JetID {
 	return *insolar.NewJetID(depth-1, resetBits(prefix, depth-1))
 }
 
+// Siblings returns left and right children of the jet.
+func Siblings(id insolar.JetID) (insolar.JetID, insolar.JetID) {
+	depth, prefix := id.Depth(), id.Prefix()
+
+	leftPrefix := resetBits(prefix, depth)
+	rightPrefix := resetBits(prefix, depth)
+	setBit(rightPrefix, depth)
+
+	return *insolar.NewJetID(depth+1, leftPrefix), *insolar.NewJetID(depth+1, rightPrefix)
+}
+
 // resetBits returns a new byte slice with all bits in 'value' reset,
 // starting from 'start' number of bit.
 //
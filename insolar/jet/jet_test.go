@@ -38,6 +38,16 @@ func TestJet_Parent(t *testing.T) {
 	require.Equal(t, emptyChild, emptyParent, "for empty jet ID, got the same parent")
 }
 
+func TestJet_Siblings(t *testing.T) {
+	left, right := Siblings(NewIDFromString("01010"))
+	require.Equal(t, NewIDFromString("010100"), left)
+	require.Equal(t, NewIDFromString("010101"), right)
+
+	left, right = Siblings(insolar.ZeroJetID)
+	require.Equal(t, NewIDFromString("0"), left)
+	require.Equal(t, NewIDFromString("1"), right)
+}
+
 func TestJet_ResetBits(t *testing.T) {
 	orig := []byte{0xFF}
 	got := resetBits(orig, 5)
@@ -63,6 +63,12 @@ func (lt *lockedTree) split(id insolar.JetID) (insolar.JetID, insolar.JetID, err
 	return lt.t.Split(id)
 }
 
+func (lt *lockedTree) join(left, right insolar.JetID) (insolar.JetID, error) {
+	lt.Lock()
+	defer lt.Unlock()
+	return lt.t.Join(left, right)
+}
+
 // Store stores jet trees per pulse.
 // It provides methods for querying and modification this trees.
 type Store struct {
@@ -113,6 +119,14 @@ func (s *Store) Split(
 	return left, right, nil
 }
 
+// Merge performs merge of sibling jets and returns resulting parent jet id.
+func (s *Store) Merge(
+	ctx context.Context, pulse insolar.PulseNumber, left, right insolar.JetID,
+) (insolar.JetID, error) {
+	ltree := s.ltreeForPulse(pulse)
+	return ltree.join(left, right)
+}
+
 // Clone copies tree from one pulse to another. Use it to copy past tree into new pulse.
 func (s *Store) Clone(
 	ctx context.Context, from, to insolar.PulseNumber,
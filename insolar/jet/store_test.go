@@ -70,6 +70,30 @@ func TestJetStorage_SplitJetTree(t *testing.T) {
 	require.Equal(t, "root (level=0 actual=false)\n 0 (level=1 actual=false)\n 1 (level=1 actual=false)\n", tree.String())
 }
 
+func TestJetStorage_MergeJetTree(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	s := NewStore()
+
+	left, right, err := s.Split(ctx, 100, *insolar.NewJetID(0, nil))
+	require.NoError(t, err)
+	_, _, err = s.Split(ctx, 100, right)
+	require.NoError(t, err)
+
+	_, err = s.Merge(ctx, 100, left, right)
+	require.Error(t, err, "right jet is not a leaf")
+
+	parent, err := s.Merge(ctx, 100, NewIDFromString("10"), NewIDFromString("11"))
+	require.NoError(t, err)
+	require.Equal(t, right, parent)
+
+	parent, err = s.Merge(ctx, 100, left, right)
+	require.NoError(t, err)
+	require.Equal(t, insolar.ZeroJetID, parent)
+
+	tree, _ := treeForPulse(s, 100)
+	require.Equal(t, "root (level=0 actual=false)\n", tree.String())
+}
+
 func TestJetStorage_CloneJetTree(t *testing.T) {
 	ctx := inslogger.TestContext(t)
 	s := NewStore()
@@ -52,7 +52,10 @@ func (j *jet) Find(val []byte, depth uint8) (*jet, uint8) {
 func (j *jet) Update(prefix []byte, setActual bool, maxDepth, depth uint8) {
 	if depth == maxDepth {
 		if setActual {
+			// Actual jet is always a leaf. Branches can remain here from previous pulse if jet was merged.
 			j.Actual = true
+			j.Left = nil
+			j.Right = nil
 		}
 		return
 	}
@@ -84,6 +87,10 @@ func (j *jet) Clone(keep bool) *jet {
 	return res
 }
 
+func (j *jet) isLeaf() bool {
+	return j != nil && j.Left == nil && j.Right == nil
+}
+
 func (j *jet) ExtractLeafIDs(ids *[]insolar.JetID, path []byte, depth uint8) {
 	if j == nil {
 		return
@@ -237,7 +244,7 @@ func (t *Tree) Find(recordID insolar.ID) (insolar.JetID, bool) {
 }
 
 // Update add missing tree branches for provided prefix.
-// If 'setActual' is set, all encountered nodes will be marked as actual.
+// If 'setActual' is set, the jet will be marked as actual and its branches will be removed.
 func (t *Tree) Update(id insolar.JetID, setActual bool) {
 	t.Head.Update(id.Prefix(), setActual, id.Depth(), 0)
 }
@@ -263,6 +270,33 @@ func (t *Tree) Split(id insolar.JetID) (insolar.JetID, insolar.JetID, error) {
 	return *left, *right, nil
 }
 
+// Join looks for provided sibling jets and removes them from the tree, so their parent becomes a leaf.
+// It's an inverse of Split. If provided jets are not sibling leaves, an error will be returned.
+func (t *Tree) Join(left, right insolar.JetID) (insolar.JetID, error) {
+	depth := left.Depth()
+	if depth == 0 || left == right || depth != right.Depth() || Parent(left) != Parent(right) {
+		return insolar.ZeroJetID, errors.New("failed to join: jets are not siblings")
+	}
+
+	parent := Parent(left)
+	prefix := parent.Prefix()
+	j := t.Head
+	for d := uint8(0); j != nil && d < parent.Depth(); d++ {
+		if getBit(prefix, d) {
+			j = j.Right
+		} else {
+			j = j.Left
+		}
+	}
+	if j == nil || !j.Left.isLeaf() || !j.Right.isLeaf() {
+		return insolar.ZeroJetID, errors.New("failed to join: jets are not leaves")
+	}
+
+	j.Left = nil
+	j.Right = nil
+	return parent, nil
+}
+
 func (t *Tree) LeafIDs() []insolar.JetID {
 	var ids []insolar.JetID
 	t.Head.ExtractLeafIDs(&ids, make([]byte, insolar.RecordHashSize), 0)
@@ -134,6 +134,60 @@ func TestTree_Split(t *testing.T) {
 	})
 }
 
+func TestTree_Join(t *testing.T) {
+	newTree := func() *Tree {
+		return &Tree{
+			Head: &jet{
+				Left: &jet{},
+				Right: &jet{
+					Left:  &jet{},
+					Right: &jet{},
+				},
+			},
+		}
+	}
+
+	t.Run("not siblings returns error", func(t *testing.T) {
+		tree := newTree()
+		_, err := tree.Join(NewIDFromString("0"), NewIDFromString("10"))
+		assert.Error(t, err)
+		_, err = tree.Join(NewIDFromString("10"), NewIDFromString("10"))
+		assert.Error(t, err)
+		_, err = tree.Join(insolar.ZeroJetID, insolar.ZeroJetID)
+		assert.Error(t, err)
+	})
+
+	t.Run("not leaves returns error", func(t *testing.T) {
+		tree := newTree()
+		_, err := tree.Join(NewIDFromString("0"), NewIDFromString("1"))
+		assert.Error(t, err)
+		_, err = tree.Join(NewIDFromString("00"), NewIDFromString("01"))
+		assert.Error(t, err)
+	})
+
+	t.Run("joins jets", func(t *testing.T) {
+		tree := newTree()
+		parent, err := tree.Join(NewIDFromString("11"), NewIDFromString("10"))
+		require.NoError(t, err)
+		assert.Equal(t, NewIDFromString("1"), parent)
+		assert.Equal(t, []insolar.JetID{NewIDFromString("0"), NewIDFromString("1")}, tree.LeafIDs())
+
+		parent, err = tree.Join(NewIDFromString("0"), NewIDFromString("1"))
+		require.NoError(t, err)
+		assert.Equal(t, insolar.ZeroJetID, parent)
+		assert.Equal(t, []insolar.JetID{insolar.ZeroJetID}, tree.LeafIDs())
+	})
+
+	t.Run("actual update removes branches", func(t *testing.T) {
+		tree := newTree()
+		tree.Update(NewIDFromString("1"), true)
+		assert.Equal(t, []insolar.JetID{NewIDFromString("0"), NewIDFromString("1")}, tree.LeafIDs())
+
+		tree.Update(NewIDFromString("0"), false)
+		assert.Equal(t, []insolar.JetID{NewIDFromString("0"), NewIDFromString("1")}, tree.LeafIDs())
+	})
+}
+
 func TestTree_String(t *testing.T) {
 	tree := Tree{
 		Head: &jet{
@@ -101,10 +101,33 @@ func KVSize(kvs []KV) (amount int64) {
 	return
 }
 
+// StorageExporter provides methods for fetching data view from storage.
+type StorageExporter interface {
+	// Export returns data view from storage.
+	Export(ctx context.Context, query StorageExportQuery) (*StorageExportResult, error)
+}
+
+// StorageExportQuery describes which part of storage should be exported.
+type StorageExportQuery struct {
+	// From is the first pulse to export.
+	From PulseNumber
+	// To is the last pulse to export (included). Zero means up to the last exportable pulse.
+	To PulseNumber
+	// Cursor continues export after the last record of the previous result.
+	Cursor string
+	// Size is a maximum number of records in result.
+	Size int
+	// JetID limits export to records of the jet and its children.
+	JetID *JetID
+	// Types limits export to records of provided types (e.g. "ActivateRecord").
+	Types []string
+}
+
 // StorageExportResult represents storage data view.
 type StorageExportResult struct {
 	Data     map[string]interface{}
 	NextFrom *PulseNumber
+	Cursor   string
 	Size     int
 }
 
@@ -17,11 +17,19 @@
 package message
 
 import (
+	"bytes"
+
+	"github.com/pkg/errors"
+	"github.com/ugorji/go/codec"
+
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/utils/compression"
 )
 
 // HeavyPayload carries Key/Value records and pulse number
 // that replicates to Heavy Material node.
+//
+// Payload of a pulse is split into chunks which are acknowledged by heavy one by one.
 type HeavyPayload struct {
 	JetID    insolar.JetID
 	PulseNum insolar.PulseNumber
@@ -29,6 +37,83 @@ type HeavyPayload struct {
 	Drop     []byte
 	Blobs    [][]byte
 	Records  [][]byte
+
+	// Chunk is a sequence number of the payload chunk within the pulse.
+	Chunk uint32
+	// Compression is a name of compression used for Data.
+	// If it is empty, payload is not compressed and Data is not used.
+	Compression string
+	// Data is compressed Indices, Drop, Blobs and Records.
+	Data []byte
+}
+
+type heavyPayloadContent struct {
+	Indices []insolar.KV
+	Drop    []byte
+	Blobs   [][]byte
+	Records [][]byte
+}
+
+// Compress packs payload content into Data with provided compression.
+// It returns size of the packed content before compression.
+func (hp *HeavyPayload) Compress(name string) (int, error) {
+	c, err := compression.Get(name)
+	if err != nil {
+		return 0, err
+	}
+	if c.Name() == compression.None {
+		return 0, nil
+	}
+
+	content := heavyPayloadContent{
+		Indices: hp.Indices,
+		Drop:    hp.Drop,
+		Blobs:   hp.Blobs,
+		Records: hp.Records,
+	}
+	buff := &bytes.Buffer{}
+	err = codec.NewEncoder(buff, &codec.CborHandle{}).Encode(content)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to encode heavy payload")
+	}
+	data, err := c.Compress(buff.Bytes())
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to compress heavy payload with %v", name)
+	}
+
+	hp.Compression = name
+	hp.Data = data
+	hp.Indices, hp.Drop, hp.Blobs, hp.Records = nil, nil, nil, nil
+	return buff.Len(), nil
+}
+
+// Decompress unpacks payload content from Data. It does nothing for not compressed payload.
+func (hp *HeavyPayload) Decompress() error {
+	if hp.Compression == "" || hp.Compression == compression.None {
+		return nil
+	}
+	c, err := compression.Get(hp.Compression)
+	if err != nil {
+		return err
+	}
+	raw, err := c.Decompress(hp.Data)
+	if err != nil {
+		return errors.Wrapf(err, "failed to decompress heavy payload with %v", hp.Compression)
+	}
+
+	var content heavyPayloadContent
+	err = codec.NewDecoder(bytes.NewReader(raw), &codec.CborHandle{}).Decode(&content)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode heavy payload")
+	}
+
+	hp.Indices = content.Indices
+	hp.Drop = content.Drop
+	hp.Blobs = content.Blobs
+	hp.Records = content.Records
+	hp.Compression = ""
+	hp.Data = nil
+	return nil
 }
 
 // AllowedSenderObjectAndRole implements interface method
@@ -61,6 +146,13 @@ type HeavyStartStop struct {
 	JetID    insolar.JetID
 	PulseNum insolar.PulseNumber
 	Finished bool
+
+	// Compressions are payload compressions supported by light in order of preference.
+	Compressions []string
+	// Chunks is a count of payload chunks. Heavy doesn't finish sync until all of them are stored.
+	Chunks uint32
+	// Digest identifies split of the payload into chunks. Heavy resumes sync only for the same digest.
+	Digest []byte
 }
 
 // AllowedSenderObjectAndRole implements interface method
@@ -0,0 +1,63 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package message
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/utils/compression"
+)
+
+func TestHeavyPayload_Compress(t *testing.T) {
+	newPayload := func() *HeavyPayload {
+		return &HeavyPayload{
+			PulseNum: insolar.FirstPulseNumber,
+			Chunk:    1,
+			Indices:  []insolar.KV{{K: []byte("key"), V: bytes.Repeat([]byte("value"), 50)}},
+			Drop:     []byte("drop"),
+			Blobs:    [][]byte{bytes.Repeat([]byte("blob"), 50)},
+			Records:  [][]byte{bytes.Repeat([]byte("record"), 50)},
+		}
+	}
+
+	for _, name := range []string{compression.Flate} {
+		hp := newPayload()
+		raw, err := hp.Compress(name)
+		require.NoError(t, err)
+		assert.Equal(t, name, hp.Compression)
+		assert.Nil(t, hp.Records)
+		assert.True(t, len(hp.Data) < raw)
+
+		err = hp.Decompress()
+		require.NoError(t, err)
+		assert.Equal(t, newPayload(), hp)
+	}
+
+	hp := newPayload()
+	raw, err := hp.Compress(compression.None)
+	require.NoError(t, err)
+	assert.Equal(t, 0, raw)
+	assert.Equal(t, newPayload(), hp)
+
+	_, err = hp.Compress("lzma")
+	assert.Error(t, err)
+}
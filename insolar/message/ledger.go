@@ -381,6 +381,10 @@ type HotData struct {
 	RecentObjects   map[insolar.ID]HotIndex
 	PendingRequests map[insolar.ID]recentstorage.PendingObjectContext
 	PulseNumber     insolar.PulseNumber
+
+	// SiblingDrop is set if Jet is a parent of two merged jets. Drop is the drop of the left child then,
+	// and SiblingDrop is the drop of the right one.
+	SiblingDrop *drop.Drop
 }
 
 // AllowedSenderObjectAndRole implements interface method
@@ -544,3 +548,117 @@ func (*GetPendingRequestID) DefaultRole() insolar.DynamicRole {
 func (m *GetPendingRequestID) DefaultTarget() *insolar.Reference {
 	return insolar.NewReference(insolar.DomainID, m.ObjectID)
 }
+
+// GetRequestResult fetches result of a request from ledger.
+type GetRequestResult struct {
+	ledgerMessage
+
+	Request insolar.ID
+}
+
+// Type implementation of Message interface.
+func (*GetRequestResult) Type() insolar.MessageType {
+	return insolar.TypeGetRequestResult
+}
+
+// AllowedSenderObjectAndRole implements interface method
+func (m *GetRequestResult) AllowedSenderObjectAndRole() (*insolar.Reference, insolar.DynamicRole) {
+	return nil, insolar.DynamicRoleUndefined
+}
+
+// DefaultRole returns role for this event
+func (*GetRequestResult) DefaultRole() insolar.DynamicRole {
+	return insolar.DynamicRoleLightExecutor
+}
+
+// DefaultTarget returns of target of this event.
+func (m *GetRequestResult) DefaultTarget() *insolar.Reference {
+	return insolar.NewReference(insolar.DomainID, m.Request)
+}
+
+// GetEvents fetches events emitted by an object in a pulse range. Events of all names are fetched if Name is empty.
+type GetEvents struct {
+	ledgerMessage
+
+	Object insolar.ID
+	Name   string
+	From   insolar.PulseNumber
+	To     insolar.PulseNumber
+}
+
+// Type implementation of Message interface.
+func (*GetEvents) Type() insolar.MessageType {
+	return insolar.TypeGetEvents
+}
+
+// AllowedSenderObjectAndRole implements interface method
+func (m *GetEvents) AllowedSenderObjectAndRole() (*insolar.Reference, insolar.DynamicRole) {
+	return nil, insolar.DynamicRoleUndefined
+}
+
+// DefaultRole returns role for this event
+func (*GetEvents) DefaultRole() insolar.DynamicRole {
+	return insolar.DynamicRoleLightExecutor
+}
+
+// DefaultTarget returns of target of this event.
+func (m *GetEvents) DefaultTarget() *insolar.Reference {
+	return insolar.NewReference(insolar.DomainID, m.Object)
+}
+
+// GetRecordProof fetches a proof that a record is included in its jet drop.
+type GetRecordProof struct {
+	ledgerMessage
+
+	Record insolar.ID
+}
+
+// Type implementation of Message interface.
+func (*GetRecordProof) Type() insolar.MessageType {
+	return insolar.TypeGetRecordProof
+}
+
+// AllowedSenderObjectAndRole implements interface method
+func (m *GetRecordProof) AllowedSenderObjectAndRole() (*insolar.Reference, insolar.DynamicRole) {
+	return nil, insolar.DynamicRoleUndefined
+}
+
+// DefaultRole returns role for this event
+func (*GetRecordProof) DefaultRole() insolar.DynamicRole {
+	return insolar.DynamicRoleLightExecutor
+}
+
+// DefaultTarget returns of target of this event.
+func (m *GetRecordProof) DefaultTarget() *insolar.Reference {
+	return insolar.NewReference(insolar.DomainID, m.Record)
+}
+
+// SetEvents indexes events emitted by an object. Result of the call is saved in the jet of its request, so events
+// are sent to the jet of the object separately to be found by GetEvents.
+type SetEvents struct {
+	ledgerMessage
+
+	Object insolar.Reference
+	Result insolar.ID
+	Events []insolar.Event
+}
+
+// Type implementation of Message interface.
+func (*SetEvents) Type() insolar.MessageType {
+	return insolar.TypeSetEvents
+}
+
+// AllowedSenderObjectAndRole implements interface method
+func (m *SetEvents) AllowedSenderObjectAndRole() (*insolar.Reference, insolar.DynamicRole) {
+	return &m.Object, insolar.DynamicRoleVirtualExecutor
+}
+
+// DefaultRole returns role for this event
+func (*SetEvents) DefaultRole() insolar.DynamicRole {
+	return insolar.DynamicRoleLightExecutor
+}
+
+// DefaultTarget returns of target of this event.
+func (m *SetEvents) DefaultTarget() *insolar.Reference {
+	return &m.Object
+}
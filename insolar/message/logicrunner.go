@@ -132,12 +132,15 @@ type CallMethod struct {
 	Method         string
 	Arguments      insolar.Arguments
 	ProxyPrototype insolar.Reference
+	// Immutable call is executed by a node which receives it, the call isn't registered in ledger
+	// and can't change state of objects
+	Immutable bool
 }
 
 // AllowedSenderObjectAndRole implements interface method
 func (cm *CallMethod) AllowedSenderObjectAndRole() (*insolar.Reference, insolar.DynamicRole) {
 	c := cm.GetCaller()
-	if c.IsEmpty() {
+	if c.IsEmpty() || cm.Immutable {
 		return nil, 0
 	}
 	return c, insolar.DynamicRoleVirtualExecutor
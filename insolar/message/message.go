@@ -81,6 +81,14 @@ func getEmptyMessage(mt insolar.MessageType) (insolar.Message, error) {
 		return &AbandonedRequestsNotification{}, nil
 	case insolar.TypeGetPendingRequestID:
 		return &GetPendingRequestID{}, nil
+	case insolar.TypeGetRequestResult:
+		return &GetRequestResult{}, nil
+	case insolar.TypeGetEvents:
+		return &GetEvents{}, nil
+	case insolar.TypeSetEvents:
+		return &SetEvents{}, nil
+	case insolar.TypeGetRecordProof:
+		return &GetRecordProof{}, nil
 	case insolar.TypeGetRequest:
 		return &GetRequest{}, nil
 
@@ -219,6 +227,10 @@ func init() {
 	gob.Register(&AbandonedRequestsNotification{})
 	gob.Register(&HotData{})
 	gob.Register(&GetPendingRequestID{})
+	gob.Register(&GetRequestResult{})
+	gob.Register(&GetEvents{})
+	gob.Register(&SetEvents{})
+	gob.Register(&GetRecordProof{})
 	gob.Register(&GetRequest{})
 
 	// heavy
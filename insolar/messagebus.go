@@ -259,6 +259,14 @@ const (
 	TypeGetRequest
 	// TypeGetPendingRequestID fetches a pending request id from ledger
 	TypeGetPendingRequestID
+	// TypeGetRequestResult fetches result of a request from ledger.
+	TypeGetRequestResult
+	// TypeGetEvents fetches events emitted by an object from ledger.
+	TypeGetEvents
+	// TypeSetEvents indexes events emitted by an object on the light node of the object's jet.
+	TypeSetEvents
+	// TypeGetRecordProof fetches a proof that a record is included in its jet drop.
+	TypeGetRecordProof
 
 	// TypeValidationCheck checks if validation of a particular record can be performed.
 	TypeValidationCheck
@@ -33,16 +33,20 @@ func _() {
 	_ = x[TypeAbandonedRequestsNotification-22]
 	_ = x[TypeGetRequest-23]
 	_ = x[TypeGetPendingRequestID-24]
-	_ = x[TypeValidationCheck-25]
-	_ = x[TypeHeavyStartStop-26]
-	_ = x[TypeHeavyPayload-27]
-	_ = x[TypeBootstrapRequest-28]
-	_ = x[TypeNodeSignRequest-29]
+	_ = x[TypeGetRequestResult-25]
+	_ = x[TypeGetEvents-26]
+	_ = x[TypeSetEvents-27]
+	_ = x[TypeGetRecordProof-28]
+	_ = x[TypeValidationCheck-29]
+	_ = x[TypeHeavyStartStop-30]
+	_ = x[TypeHeavyPayload-31]
+	_ = x[TypeBootstrapRequest-32]
+	_ = x[TypeNodeSignRequest-33]
 }
 
-const _MessageType_name = "TypeCallMethodTypeCallConstructorTypeReturnResultsTypeExecutorResultsTypeValidateCaseBindTypeValidationResultsTypePendingFinishedTypeStillExecutingTypeGetCodeTypeGetObjectTypeGetDelegateTypeGetChildrenTypeUpdateObjectTypeRegisterChildTypeJetDropTypeSetRecordTypeValidateRecordTypeSetBlobTypeGetObjectIndexTypeGetPendingRequestsTypeHotRecordsTypeGetJetTypeAbandonedRequestsNotificationTypeGetRequestTypeGetPendingRequestIDTypeValidationCheckTypeHeavyStartStopTypeHeavyPayloadTypeBootstrapRequestTypeNodeSignRequest"
+const _MessageType_name = "TypeCallMethodTypeCallConstructorTypeReturnResultsTypeExecutorResultsTypeValidateCaseBindTypeValidationResultsTypePendingFinishedTypeStillExecutingTypeGetCodeTypeGetObjectTypeGetDelegateTypeGetChildrenTypeUpdateObjectTypeRegisterChildTypeJetDropTypeSetRecordTypeValidateRecordTypeSetBlobTypeGetObjectIndexTypeGetPendingRequestsTypeHotRecordsTypeGetJetTypeAbandonedRequestsNotificationTypeGetRequestTypeGetPendingRequestIDTypeGetRequestResultTypeGetEventsTypeSetEventsTypeGetRecordProofTypeValidationCheckTypeHeavyStartStopTypeHeavyPayloadTypeBootstrapRequestTypeNodeSignRequest"
 
-var _MessageType_index = [...]uint16{0, 14, 33, 50, 69, 89, 110, 129, 147, 158, 171, 186, 201, 217, 234, 245, 258, 276, 287, 305, 327, 341, 351, 384, 398, 421, 440, 458, 474, 494, 513}
+var _MessageType_index = [...]uint16{0, 14, 33, 50, 69, 89, 110, 129, 147, 158, 171, 186, 201, 217, 234, 245, 258, 276, 287, 305, 327, 341, 351, 384, 398, 421, 441, 454, 467, 485, 504, 522, 538, 558, 577}
 
 func (i MessageType) String() string {
 	if i >= MessageType(len(_MessageType_index)-1) {
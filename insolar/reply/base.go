@@ -74,8 +74,16 @@ const (
 	TypeJet
 	// TypeRequest contains request.
 	TypeRequest
+	// TypeRequestResult contains result of a request.
+	TypeRequestResult
+	// TypeEvents contains events emitted by an object.
+	TypeEvents
+	// TypeRecordProof contains a proof that a record is included in its jet drop.
+	TypeRecordProof
 	// TypeHeavyError carries heavy record sync
 	TypeHeavyError
+	// TypeHeavyAck acknowledges heavy sync start or a received payload chunk.
+	TypeHeavyAck
 
 	TypeNodeSign
 )
@@ -94,6 +102,14 @@ const (
 	ErrNoPendingRequests
 	// ErrTooManyPendingRequests is returned when a limit of pending requests has been reached
 	ErrTooManyPendingRequests
+	// ErrRequestNotFound is returned when request is unknown to ledger
+	ErrRequestNotFound
+	// ErrExecutionBudgetExceeded is returned when a contract call has exceeded its execution budget
+	ErrExecutionBudgetExceeded
+	// ErrBusy is returned when the delivery queue of the message priority class is full
+	ErrBusy
+	// ErrRecordNotFound is returned when record or its jet drop is unknown to ledger
+	ErrRecordNotFound
 )
 
 func getEmptyReply(t insolar.ReplyType) (insolar.Reply, error) {
@@ -118,6 +134,8 @@ func getEmptyReply(t insolar.ReplyType) (insolar.Reply, error) {
 		return &Error{}, nil
 	case TypeHeavyError:
 		return &HeavyError{}, nil
+	case TypeHeavyAck:
+		return &HeavyAck{}, nil
 	case TypeOK:
 		return &OK{}, nil
 	case TypeObjectIndex:
@@ -136,6 +154,12 @@ func getEmptyReply(t insolar.ReplyType) (insolar.Reply, error) {
 		return &Jet{}, nil
 	case TypeRequest:
 		return &Request{}, nil
+	case TypeRequestResult:
+		return &RequestResult{}, nil
+	case TypeEvents:
+		return &Events{}, nil
+	case TypeRecordProof:
+		return &RecordProof{}, nil
 
 	case TypeNodeSign:
 		return &NodeSign{}, nil
@@ -200,8 +224,12 @@ func init() {
 	gob.Register(&GetObjectRedirectReply{})
 	gob.Register(&GetChildrenRedirectReply{})
 	gob.Register(&HeavyError{})
+	gob.Register(&HeavyAck{})
 	gob.Register(&JetMiss{})
 	gob.Register(&NodeSign{})
 	gob.Register(&HasPendingRequests{})
 	gob.Register(&Request{})
+	gob.Register(&RequestResult{})
+	gob.Register(&Events{})
+	gob.Register(&RecordProof{})
 }
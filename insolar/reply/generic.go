@@ -59,6 +59,14 @@ func (e *Error) Error() error {
 		return insolar.ErrNoPendingRequest
 	case ErrTooManyPendingRequests:
 		return insolar.ErrTooManyPendingRequests
+	case ErrRequestNotFound:
+		return insolar.ErrNotFound
+	case ErrExecutionBudgetExceeded:
+		return insolar.ErrExecutionBudgetExceeded
+	case ErrBusy:
+		return insolar.ErrBusy
+	case ErrRecordNotFound:
+		return insolar.ErrNotFound
 	}
 
 	return insolar.ErrUnknown
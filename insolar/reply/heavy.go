@@ -22,9 +22,13 @@ import (
 	"github.com/insolar/insolar/insolar"
 )
 
-// ErrHeavySyncInProgress returned when heavy sync in progress.
 const (
+	// ErrHeavySyncInProgress returned when heavy sync in progress.
 	ErrHeavySyncInProgress ErrType = iota + 1
+	// ErrHeavySyncNotStarted returned when heavy receives payload for jet which is not in sync mode.
+	ErrHeavySyncNotStarted
+	// ErrHeavySyncIncomplete returned when heavy is asked to finish sync before all payload chunks are stored.
+	ErrHeavySyncIncomplete
 )
 
 // HeavyError carries heavy sync error information.
@@ -52,5 +56,22 @@ func (e *HeavyError) Error() string {
 
 // IsRetryable returns true if retry could be performed.
 func (e *HeavyError) IsRetryable() bool {
-	return e.SubType == ErrHeavySyncInProgress
+	switch e.SubType {
+	case ErrHeavySyncInProgress, ErrHeavySyncNotStarted, ErrHeavySyncIncomplete:
+		return true
+	}
+	return false
+}
+
+// HeavyAck acknowledges heavy sync start or a received payload chunk.
+type HeavyAck struct {
+	// Compression is a payload compression chosen by heavy from ones offered by light.
+	Compression string
+	// NextChunk is a sequence number of the next payload chunk expected by heavy.
+	NextChunk uint32
+}
+
+// Type implementation of Reply interface.
+func (*HeavyAck) Type() insolar.ReplyType {
+	return TypeHeavyAck
 }
@@ -40,6 +40,8 @@ type Object struct {
 	ChildPointer *insolar.ID
 	Memory       []byte
 	Parent       insolar.Reference
+	PrevState    *insolar.ID
+	Request      *insolar.Reference
 }
 
 // Type implementation of Reply interface.
@@ -129,3 +131,40 @@ type Request struct {
 func (r *Request) Type() insolar.ReplyType {
 	return TypeRequest
 }
+
+// RequestResult contains result of a request. Result is nil while request is pending.
+type RequestResult struct {
+	Request insolar.ID
+	Result  *insolar.ID
+	Payload []byte
+}
+
+// Type implementation of Reply interface.
+func (r *RequestResult) Type() insolar.ReplyType {
+	return TypeRequestResult
+}
+
+// Events contains events emitted by an object.
+type Events struct {
+	Events []insolar.EmittedEvent
+}
+
+// Type implementation of Reply interface.
+func (r *Events) Type() insolar.ReplyType {
+	return TypeEvents
+}
+
+// RecordProof contains a proof that a record is included in its jet drop. Index, Leaves and Siblings are fields of
+// merkle.InclusionProof.
+type RecordProof struct {
+	// Drop is the serialized jet drop of the record.
+	Drop     []byte
+	Index    uint64
+	Leaves   uint64
+	Siblings [][]byte
+}
+
+// Type implementation of Reply interface.
+func (r *RecordProof) Type() insolar.ReplyType {
+	return TypeRecordProof
+}
@@ -29,6 +29,7 @@ const (
 	MachineTypeNotExist             = 0
 	MachineTypeBuiltin  MachineType = iota + 1
 	MachineTypeGoPlugin
+	MachineTypeWASM
 
 	MachineTypesLastID
 )
@@ -56,6 +57,24 @@ type MachineLogicExecutor interface {
 	Stop() error
 }
 
+// ContractMethod is a generated wrapper of a contract method, it takes serialized object state
+// and arguments and returns new serialized state and results
+type ContractMethod func(object []byte, args []byte) (newState []byte, result []byte, err error)
+
+// ContractConstructor is a generated wrapper of a contract constructor, it takes serialized arguments
+// and returns serialized state of the new object
+type ContractConstructor func(args []byte) (state []byte, err error)
+
+// ContractWrapper is a set of generated wrappers that allows to run a contract with builtin machine type
+type ContractWrapper struct {
+	Methods      map[string]ContractMethod
+	Constructors map[string]ContractConstructor
+	// API is a set of methods that can be called from outside, without a calling contract
+	API map[string]bool
+	// Immutable is a set of methods that don't change state and can be called without registration in ledger
+	Immutable map[string]bool
+}
+
 // LogicRunner is an interface that should satisfy logic executor
 //go:generate minimock -i github.com/insolar/insolar/insolar.LogicRunner -o ../testutils -s _mock.go
 type LogicRunner interface {
@@ -66,9 +85,12 @@ type LogicRunner interface {
 	OnPulse(context.Context, Pulse) error
 }
 
+// ImmutableMode is a mode of calls of immutable methods, such calls aren't registered in ledger
+const ImmutableMode = "immutable"
+
 // LogicCallContext is a context of contract execution
 type LogicCallContext struct {
-	Mode            string     // either "execution" or "validation"
+	Mode            string     // either "execution", "validation" or ImmutableMode
 	Callee          *Reference // Contract that was called
 	Request         *Reference // ref of request
 	Prototype       *Reference // Image of the callee
@@ -79,4 +101,32 @@ type LogicCallContext struct {
 	Time            time.Time  // Time when call was made
 	Pulse           Pulse      // Number of the pulse
 	TraceID         string
+	Budget          ExecutionBudget // Resources the call is allowed to consume
+}
+
+// ExecutionBudget limits resources a single contract call can consume, zero value of a field means no limit
+type ExecutionBudget struct {
+	// MaxDuration is a maximum wall time of the call
+	MaxDuration time.Duration
+	// MaxUpcalls is a maximum number of outgoing RouteCall and SaveAsChild calls
+	MaxUpcalls int
+	// MaxStateSize is a maximum size of serialized object state in bytes
+	MaxStateSize int
+}
+
+// Event is a structured event emitted by a contract during a call
+type Event struct {
+	Name    string
+	Payload []byte
+}
+
+// EmittedEvent is an event stored in ledger alongside the result of the call that emitted it
+type EmittedEvent struct {
+	Event
+	// Object is the object which emitted the event
+	Object ID
+	// Result is the result record of the call, pulse of the event is pulse of the result
+	Result ID
+	// Index is position of the event among events of the call
+	Index int
 }
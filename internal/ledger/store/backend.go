@@ -0,0 +1,126 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package store
+
+import (
+	"context"
+	"fmt"
+)
+
+// Backend is an embedded key-value engine. All persistent ledger storages access disk through it.
+type Backend interface {
+	// View runs fn in a read-only transaction.
+	View(fn func(txn Txn) error) error
+	// Update runs fn in a read-write transaction. Changes are committed only if fn returns nil.
+	// ErrConflict is returned if the transaction conflicts with a concurrent one.
+	Update(fn func(txn Txn) error) error
+	// Close flushes pending writes and releases engine resources.
+	Close() error
+}
+
+// Txn is a backend transaction. Txn is valid only inside View or Update function.
+type Txn interface {
+	// Get returns a copy of the value for the key or ErrNotFound.
+	Get(key []byte) ([]byte, error)
+	// Set stores value for the key.
+	Set(key, value []byte) error
+	// Delete removes the key.
+	Delete(key []byte) error
+	// Iterate calls handler in key order for every key with provided prefix, starting from the start key
+	// (or from the prefix if start is nil). Iteration stops when handler returns false or an error.
+	//
+	// Keys and values passed to handler are copies and can be retained. Handler must not modify
+	// the transaction.
+	Iterate(prefix, start []byte, handler func(k, v []byte) (bool, error)) error
+}
+
+// PersistentDB is a DB stored on disk by one of supported engines.
+type PersistentDB interface {
+	DB
+	Backend
+	Stop(ctx context.Context) error
+}
+
+const (
+	// EngineBadger is the name of badger storage engine.
+	EngineBadger = "badger"
+	// EngineBolt is the name of bbolt storage engine.
+	EngineBolt = "bolt"
+)
+
+// Engines lists all supported storage engines.
+var Engines = []string{EngineBadger, EngineBolt}
+
+// Open opens database in provided dir with engine selected by name. Badger is used if engine is empty.
+func Open(engine, dir string) (PersistentDB, error) {
+	switch engine {
+	case "", EngineBadger:
+		return NewBadgerDB(dir)
+	case EngineBolt:
+		return NewBoltDB(dir)
+	}
+	return nil, fmt.Errorf("unknown storage engine %q", engine)
+}
+
+func fullKey(key Key) []byte {
+	return append(key.Scope().Bytes(), key.ID()...)
+}
+
+// TxnDB adapts transaction for storages working with DB. The result is valid only while txn is valid.
+func TxnDB(txn Txn) DB {
+	return txnDB{txn: txn}
+}
+
+type txnDB struct {
+	txn Txn
+}
+
+func (t txnDB) Get(key Key) ([]byte, error) {
+	return t.txn.Get(fullKey(key))
+}
+
+func (t txnDB) Set(key Key, value []byte) error {
+	return t.txn.Set(fullKey(key), value)
+}
+
+// Iterate calls handler in key order for every key of the scope which id starts with prefix, beginning from the start
+// id (or from the prefix if start is nil). Handler gets ids without the scope. Iteration stops when handler returns
+// false or an error.
+//
+// Iteration is supported by persistent DBs and DBs returned by TxnDB, ErrNotSupported is returned for other ones.
+func Iterate(db DB, scope Scope, prefix, start []byte, handler func(id, value []byte) (bool, error)) error {
+	switch d := db.(type) {
+	case txnDB:
+		return iterateScope(d.txn, scope, prefix, start, handler)
+	case Backend:
+		return d.View(func(txn Txn) error {
+			return iterateScope(txn, scope, prefix, start, handler)
+		})
+	}
+	return ErrNotSupported
+}
+
+func iterateScope(txn Txn, scope Scope, prefix, start []byte, handler func(id, value []byte) (bool, error)) error {
+	var startKey []byte
+	if start != nil {
+		startKey = append(scope.Bytes(), start...)
+	}
+	scopeLen := len(scope.Bytes())
+	return txn.Iterate(append(scope.Bytes(), prefix...), startKey, func(k, v []byte) (bool, error) {
+		return handler(k[scopeLen:], v)
+	})
+}
@@ -0,0 +1,169 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package store
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func forEachEngine(t *testing.T, test func(t *testing.T, db PersistentDB)) {
+	for _, engine := range Engines {
+		engine := engine
+		t.Run(engine, func(t *testing.T) {
+			t.Parallel()
+
+			tmpdir, err := ioutil.TempDir("", "backend-test-")
+			defer os.RemoveAll(tmpdir)
+			require.NoError(t, err)
+
+			db, err := Open(engine, tmpdir)
+			require.NoError(t, err)
+			defer db.Close()
+
+			test(t, db)
+		})
+	}
+}
+
+func TestOpen_UnknownEngine(t *testing.T) {
+	t.Parallel()
+
+	_, err := Open("leveldb", "")
+	assert.Error(t, err)
+}
+
+func TestBackend_Iterate(t *testing.T) {
+	t.Parallel()
+
+	forEachEngine(t, func(t *testing.T, db PersistentDB) {
+		err := db.Update(func(txn Txn) error {
+			for _, k := range []string{"a1", "b1", "b2", "b3", "c1"} {
+				if err := txn.Set([]byte(k), []byte("v"+k)); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		require.NoError(t, err)
+
+		collect := func(prefix, start []byte, limit int) (keys, values []string) {
+			err := db.View(func(txn Txn) error {
+				return txn.Iterate(prefix, start, func(k, v []byte) (bool, error) {
+					keys = append(keys, string(k))
+					values = append(values, string(v))
+					return len(keys) < limit, nil
+				})
+			})
+			require.NoError(t, err)
+			return
+		}
+
+		keys, values := collect([]byte("b"), nil, 10)
+		assert.Equal(t, []string{"b1", "b2", "b3"}, keys)
+		assert.Equal(t, []string{"vb1", "vb2", "vb3"}, values)
+
+		keys, _ = collect([]byte("b"), []byte("b2"), 10)
+		assert.Equal(t, []string{"b2", "b3"}, keys)
+
+		keys, _ = collect([]byte("b"), nil, 2)
+		assert.Equal(t, []string{"b1", "b2"}, keys)
+
+		keys, _ = collect(nil, nil, 10)
+		assert.Equal(t, []string{"a1", "b1", "b2", "b3", "c1"}, keys)
+	})
+}
+
+func TestIterate(t *testing.T) {
+	t.Parallel()
+
+	forEachEngine(t, func(t *testing.T, db PersistentDB) {
+		for _, k := range []string{"a1", "b1", "b2", "b3"} {
+			require.NoError(t, db.Set(testBadgerKey{id: []byte(k), scope: ScopeEvent}, []byte("v"+k)))
+		}
+		require.NoError(t, db.Set(testBadgerKey{id: []byte("b4"), scope: ScopeRecord}, nil))
+
+		collect := func(db DB, prefix, start []byte) (ids, values []string) {
+			err := Iterate(db, ScopeEvent, prefix, start, func(id, v []byte) (bool, error) {
+				ids = append(ids, string(id))
+				values = append(values, string(v))
+				return true, nil
+			})
+			require.NoError(t, err)
+			return
+		}
+
+		ids, values := collect(db, []byte("b"), nil)
+		assert.Equal(t, []string{"b1", "b2", "b3"}, ids)
+		assert.Equal(t, []string{"vb1", "vb2", "vb3"}, values)
+
+		err := db.View(func(txn Txn) error {
+			ids, _ := collect(TxnDB(txn), []byte("b"), []byte("b2"))
+			assert.Equal(t, []string{"b2", "b3"}, ids)
+			return nil
+		})
+		require.NoError(t, err)
+	})
+
+	err := Iterate(NewMemoryMockDB(), ScopeEvent, nil, nil, func(id, v []byte) (bool, error) {
+		return true, nil
+	})
+	assert.Equal(t, ErrNotSupported, err)
+}
+
+func TestBackend_Update(t *testing.T) {
+	t.Parallel()
+
+	forEachEngine(t, func(t *testing.T, db PersistentDB) {
+		key := []byte("key")
+		err := db.Update(func(txn Txn) error {
+			return txn.Set(key, []byte("value"))
+		})
+		require.NoError(t, err)
+
+		failure := errors.New("failure")
+		err = db.Update(func(txn Txn) error {
+			require.NoError(t, txn.Delete(key))
+			return failure
+		})
+		assert.Equal(t, failure, err)
+
+		err = db.View(func(txn Txn) error {
+			value, err := txn.Get(key)
+			require.NoError(t, err)
+			assert.Equal(t, []byte("value"), value)
+			return nil
+		})
+		require.NoError(t, err)
+
+		err = db.Update(func(txn Txn) error {
+			return txn.Delete(key)
+		})
+		require.NoError(t, err)
+
+		err = db.View(func(txn Txn) error {
+			_, err := txn.Get(key)
+			return err
+		})
+		assert.Equal(t, ErrNotFound, err)
+	})
+}
@@ -51,7 +51,7 @@ func NewBadgerDB(dir string) (*BadgerDB, error) {
 // Get returns value for specified key or an error. A copy of a value will be returned (i.e. getting large value can be
 // long).
 func (b *BadgerDB) Get(key Key) (value []byte, err error) {
-	fullKey := append(key.Scope().Bytes(), key.ID()...)
+	fullKey := fullKey(key)
 
 	err = b.backend.View(func(txn *badger.Txn) error {
 		item, err := txn.Get(fullKey)
@@ -78,7 +78,7 @@ func (b *BadgerDB) Get(key Key) (value []byte, err error) {
 
 // Set stores value for a key.
 func (b *BadgerDB) Set(key Key, value []byte) error {
-	fullKey := append(key.Scope().Bytes(), key.ID()...)
+	fullKey := fullKey(key)
 
 	err := b.backend.Update(func(txn *badger.Txn) error {
 		err := txn.Set(fullKey, value)
@@ -94,7 +94,77 @@ func (b *BadgerDB) Set(key Key, value []byte) error {
 	return nil
 }
 
+// View runs fn in a read-only badger transaction.
+func (b *BadgerDB) View(fn func(txn Txn) error) error {
+	return b.backend.View(func(txn *badger.Txn) error {
+		return fn(&badgerTxn{txn: txn})
+	})
+}
+
+// Update runs fn in a read-write badger transaction and commits it.
+func (b *BadgerDB) Update(fn func(txn Txn) error) error {
+	err := b.backend.Update(func(txn *badger.Txn) error {
+		return fn(&badgerTxn{txn: txn})
+	})
+	if err == badger.ErrConflict {
+		return ErrConflict
+	}
+	return err
+}
+
+// Close closes badger. It's crucial to call it to ensure all the pending updates make their way to disk.
+func (b *BadgerDB) Close() error {
+	return b.backend.Close()
+}
+
 // Stop gracefully stops all disk writes. After calling this, it's safe to kill the process without losing data.
 func (b *BadgerDB) Stop(ctx context.Context) error {
-	return b.backend.Close()
+	return b.Close()
+}
+
+type badgerTxn struct {
+	txn *badger.Txn
+}
+
+func (t *badgerTxn) Get(key []byte) ([]byte, error) {
+	item, err := t.txn.Get(key)
+	if err != nil {
+		if err == badger.ErrKeyNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return item.ValueCopy(nil)
+}
+
+func (t *badgerTxn) Set(key, value []byte) error {
+	return t.txn.Set(key, value)
+}
+
+func (t *badgerTxn) Delete(key []byte) error {
+	return t.txn.Delete(key)
+}
+
+func (t *badgerTxn) Iterate(prefix, start []byte, handler func(k, v []byte) (bool, error)) error {
+	if start == nil {
+		start = prefix
+	}
+	it := t.txn.NewIterator(badger.DefaultIteratorOptions)
+	defer it.Close()
+
+	for it.Seek(start); it.ValidForPrefix(prefix); it.Next() {
+		key := it.Item().KeyCopy(nil)
+		value, err := it.Item().ValueCopy(nil)
+		if err != nil {
+			return err
+		}
+		next, err := handler(key, value)
+		if err != nil {
+			return err
+		}
+		if !next {
+			break
+		}
+	}
+	return nil
 }
@@ -0,0 +1,154 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package store
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	bolt "go.etcd.io/bbolt"
+)
+
+const boltFileName = "ledger.bolt"
+
+// boltBucket is the only bucket where all keys live. Scopes are already a part of keys.
+var boltBucket = []byte("ledger")
+
+// BoltDB is a bbolt DB implementation.
+type BoltDB struct {
+	backend *bolt.DB
+}
+
+// NewBoltDB creates new BoltDB instance.
+// Creates bbolt database file in provided working dir and use it as backend for BoltDB.
+func NewBoltDB(dir string) (*BoltDB, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	err = os.MkdirAll(dir, 0700)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create bolt directory")
+	}
+
+	bdb, err := bolt.Open(filepath.Join(dir, boltFileName), 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open bolt")
+	}
+	err = bdb.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(boltBucket)
+		return err
+	})
+	if err != nil {
+		_ = bdb.Close()
+		return nil, errors.Wrap(err, "failed to create bolt bucket")
+	}
+
+	return &BoltDB{backend: bdb}, nil
+}
+
+// Get returns value for specified key or an error. A copy of a value will be returned.
+func (b *BoltDB) Get(key Key) (value []byte, err error) {
+	err = b.View(func(txn Txn) error {
+		value, err = txn.Get(fullKey(key))
+		return err
+	})
+	return
+}
+
+// Set stores value for a key.
+func (b *BoltDB) Set(key Key, value []byte) error {
+	return b.Update(func(txn Txn) error {
+		return txn.Set(fullKey(key), value)
+	})
+}
+
+// View runs fn in a read-only bolt transaction.
+func (b *BoltDB) View(fn func(txn Txn) error) error {
+	return b.backend.View(func(tx *bolt.Tx) error {
+		return fn(&boltTxn{bucket: tx.Bucket(boltBucket)})
+	})
+}
+
+// Update runs fn in a read-write bolt transaction and commits it. Bolt serializes writers, so Update never
+// returns ErrConflict.
+func (b *BoltDB) Update(fn func(txn Txn) error) error {
+	return b.backend.Update(func(tx *bolt.Tx) error {
+		return fn(&boltTxn{bucket: tx.Bucket(boltBucket)})
+	})
+}
+
+// Close closes bolt database file.
+func (b *BoltDB) Close() error {
+	return b.backend.Close()
+}
+
+// Stop gracefully stops all disk writes. After calling this, it's safe to kill the process without losing data.
+func (b *BoltDB) Stop(ctx context.Context) error {
+	return b.Close()
+}
+
+type boltTxn struct {
+	bucket *bolt.Bucket
+}
+
+func (t *boltTxn) Get(key []byte) ([]byte, error) {
+	k, v := t.bucket.Cursor().Seek(key)
+	if k == nil || !bytes.Equal(k, key) {
+		return nil, ErrNotFound
+	}
+	return copyBytes(v), nil
+}
+
+func (t *boltTxn) Set(key, value []byte) error {
+	return t.bucket.Put(key, value)
+}
+
+func (t *boltTxn) Delete(key []byte) error {
+	return t.bucket.Delete(key)
+}
+
+func (t *boltTxn) Iterate(prefix, start []byte, handler func(k, v []byte) (bool, error)) error {
+	if start == nil {
+		start = prefix
+	}
+	c := t.bucket.Cursor()
+
+	k, v := c.First()
+	if len(start) > 0 {
+		k, v = c.Seek(start)
+	}
+	for ; k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+		next, err := handler(copyBytes(k), copyBytes(v))
+		if err != nil {
+			return err
+		}
+		if !next {
+			break
+		}
+	}
+	return nil
+}
+
+// copyBytes copies bolt memory which is valid only inside transaction.
+func copyBytes(b []byte) []byte {
+	return append([]byte{}, b...)
+}
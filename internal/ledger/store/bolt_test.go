@@ -0,0 +1,88 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package store
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	fuzz "github.com/google/gofuzz"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestBoltDB_Get(t *testing.T) {
+	t.Parallel()
+
+	tmpdir, err := ioutil.TempDir("", "bolt-test-")
+	defer os.RemoveAll(tmpdir)
+	assert.NoError(t, err)
+
+	db, err := NewBoltDB(tmpdir)
+	require.NoError(t, err)
+	defer db.Close()
+
+	var (
+		key           testBadgerKey
+		expectedValue []byte
+	)
+	f := fuzz.New().NilChance(0)
+	f.Fuzz(&key)
+	f.Fuzz(&expectedValue)
+	err = db.backend.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(boltBucket).Put(append(key.Scope().Bytes(), key.ID()...), expectedValue)
+	})
+	require.NoError(t, err)
+	value, err := db.Get(key)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedValue, value)
+
+	_, err = db.Get(testBadgerKey{id: append(key.ID(), 0), scope: key.Scope()})
+	assert.Equal(t, ErrNotFound, err)
+}
+
+func TestBoltDB_Set(t *testing.T) {
+	t.Parallel()
+
+	tmpdir, err := ioutil.TempDir("", "bolt-test-")
+	defer os.RemoveAll(tmpdir)
+	assert.NoError(t, err)
+
+	db, err := NewBoltDB(tmpdir)
+	require.NoError(t, err)
+	defer db.Close()
+
+	var (
+		key           testBadgerKey
+		expectedValue []byte
+		value         []byte
+	)
+	f := fuzz.New().NilChance(0)
+	f.Fuzz(&key)
+	f.Fuzz(&expectedValue)
+	err = db.Set(key, expectedValue)
+	assert.NoError(t, err)
+
+	err = db.backend.View(func(tx *bolt.Tx) error {
+		value = copyBytes(tx.Bucket(boltBucket).Get(append(key.Scope().Bytes(), key.ID()...)))
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, expectedValue, value)
+}
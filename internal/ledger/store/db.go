@@ -52,4 +52,10 @@ const (
 	ScopeIndex Scope = 4
 	// ScopeBlob is the scope for a blobs records.
 	ScopeBlob Scope = 7
+	// ScopeRequestResult is the scope for a request to result index.
+	ScopeRequestResult Scope = 8
+	// ScopeEvent is the scope for an object events index.
+	ScopeEvent Scope = 9
+	// ScopeDropRecords is the scope for a jet drop records index.
+	ScopeDropRecords Scope = 10
 )
@@ -44,12 +44,24 @@ func (k testKey) ID() []byte {
 func TestDB_Components(t *testing.T) {
 	t.Parallel()
 
-	tmpdir, err := ioutil.TempDir("", "bdb-test-")
-	defer os.RemoveAll(tmpdir)
-	assert.NoError(t, err)
-	badger, err := NewBadgerDB(tmpdir)
-	require.NoError(t, err)
+	for _, engine := range Engines {
+		engine := engine
+		t.Run(engine, func(t *testing.T) {
+			t.Parallel()
 
+			tmpdir, err := ioutil.TempDir("", "bdb-test-")
+			defer os.RemoveAll(tmpdir)
+			assert.NoError(t, err)
+			db, err := Open(engine, tmpdir)
+			require.NoError(t, err)
+			defer db.Close()
+
+			compareWithMock(t, db)
+		})
+	}
+}
+
+func compareWithMock(t *testing.T, db DB) {
 	mock := NewMemoryMockDB()
 
 	type data struct {
@@ -73,7 +85,7 @@ func TestDB_Components(t *testing.T) {
 
 	for _, d := range datas {
 		{
-			err := badger.Set(d.key, d.value)
+			err := db.Set(d.key, d.value)
 			assert.NoError(t, err)
 		}
 		{
@@ -83,7 +95,7 @@ func TestDB_Components(t *testing.T) {
 	}
 	for _, d := range datas {
 		{
-			val, err := badger.Get(d.key)
+			val, err := db.Get(d.key)
 			assert.NoError(t, err)
 			assert.Equal(t, d.value, val)
 		}
@@ -23,4 +23,10 @@ import (
 var (
 	// ErrNotFound is returned when value was not found.
 	ErrNotFound = errors.New("value not found")
+
+	// ErrConflict is returned when a transaction conflicts with a concurrent one and should be retried.
+	ErrConflict = errors.New("transaction conflict")
+
+	// ErrNotSupported is returned when DB doesn't support requested operation.
+	ErrNotSupported = errors.New("operation is not supported by db")
 )
@@ -0,0 +1,78 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package storetest
+
+import (
+	"io/ioutil"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/internal/ledger/store"
+)
+
+// TmpDB returns database opened by provided engine and cleanup function.
+//
+// Creates database in temporary directory and uses t for errors reporting.
+func TmpDB(t testing.TB, engine string) (store.PersistentDB, func()) {
+	tmpdir, err := ioutil.TempDir("", "store-test-")
+	require.NoError(t, err)
+
+	db, err := store.Open(engine, tmpdir)
+	if err != nil {
+		os.RemoveAll(tmpdir)
+	}
+	require.NoError(t, err)
+
+	return db, func() {
+		db.Close()
+		os.RemoveAll(tmpdir)
+	}
+}
+
+// ForEachEngine runs test as a subtest for every supported engine. Test gets newDB function which opens a fresh
+// temporary database with the engine.
+//
+// Databases are closed after test and all its subtests (including parallel ones) are finished, so test itself
+// must not call t.Parallel.
+func ForEachEngine(t *testing.T, test func(t *testing.T, newDB func() store.DB)) {
+	for _, engine := range store.Engines {
+		engine := engine
+
+		var (
+			lock     sync.Mutex
+			cleanups []func()
+		)
+		newDB := func() store.DB {
+			db, cleanup := TmpDB(t, engine)
+			lock.Lock()
+			cleanups = append(cleanups, cleanup)
+			lock.Unlock()
+			return db
+		}
+
+		t.Run(engine, func(t *testing.T) {
+			test(t, newDB)
+		})
+
+		for _, cleanup := range cleanups {
+			cleanup()
+		}
+	}
+}
@@ -82,6 +82,19 @@ func (ks *cachedKeyStore) Start(ctx context.Context) error {
 	return nil
 }
 
+type inPlaceKeyStore struct {
+	privateKey crypto.PrivateKey
+}
+
+func (ipks *inPlaceKeyStore) GetPrivateKey(string) (crypto.PrivateKey, error) {
+	return ipks.privateKey, nil
+}
+
+// NewInplaceKeyStore creates KeyStore bound to the passed private key.
+func NewInplaceKeyStore(privateKey crypto.PrivateKey) insolar.KeyStore {
+	return &inPlaceKeyStore{privateKey: privateKey}
+}
+
 func NewKeyStore(path string) (insolar.KeyStore, error) {
 	keyStore := &keyStore{
 		path: path,
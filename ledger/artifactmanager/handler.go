@@ -38,6 +38,7 @@ import (
 	"github.com/insolar/insolar/instrumentation/hack"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/instrumentation/insmetrics"
+	"github.com/insolar/insolar/internal/ledger/store"
 	"github.com/insolar/insolar/ledger/recentstorage"
 	"github.com/insolar/insolar/ledger/storage"
 	"github.com/insolar/insolar/ledger/storage/drop"
@@ -55,7 +56,9 @@ type MessageHandler struct {
 	DelegationTokenFactory     insolar.DelegationTokenFactory     `inject:""`
 	JetStorage                 jet.Storage                        `inject:""`
 
-	DropModifier drop.Modifier `inject:""`
+	DropModifier drop.Modifier              `inject:""`
+	DropAccessor drop.Accessor              `inject:""`
+	DropRecords  object.DropRecordsAccessor `inject:""`
 
 	BlobModifier blob.Modifier `inject:""`
 	BlobAccessor blob.Accessor `inject:""`
@@ -67,6 +70,9 @@ type MessageHandler struct {
 
 	RecordModifier object.RecordModifier `inject:""`
 	RecordAccessor object.RecordAccessor `inject:""`
+	ResultAccessor object.ResultAccessor `inject:""`
+	EventAccessor  object.EventAccessor  `inject:""`
+	EventModifier  object.EventModifier  `inject:""`
 	Nodes          node.Accessor         `inject:""`
 
 	DBContext     storage.DBContext `inject:""`
@@ -164,6 +170,13 @@ func (h *MessageHandler) setHandlersForLight(m *middleware) {
 			m.checkJet,
 			m.waitForHotData))
 
+	h.Bus.MustRegister(insolar.TypeSetEvents,
+		BuildMiddleware(h.handleSetEvents,
+			instrumentHandler("handleSetEvents"),
+			m.addFieldsToLogger,
+			m.checkJet,
+			m.waitForHotData))
+
 	h.Bus.MustRegister(insolar.TypeUpdateObject,
 		BuildMiddleware(h.handleUpdateObject,
 			instrumentHandler("handleUpdateObject"),
@@ -226,6 +239,33 @@ func (h *MessageHandler) setHandlersForLight(m *middleware) {
 		),
 	)
 
+	h.Bus.MustRegister(
+		insolar.TypeGetRequestResult,
+		BuildMiddleware(
+			h.handleGetRequestResult,
+			instrumentHandler("handleGetRequestResult"),
+			m.checkJet,
+		),
+	)
+
+	h.Bus.MustRegister(
+		insolar.TypeGetEvents,
+		BuildMiddleware(
+			h.handleGetEvents,
+			instrumentHandler("handleGetEvents"),
+			m.checkJet,
+		),
+	)
+
+	h.Bus.MustRegister(
+		insolar.TypeGetRecordProof,
+		BuildMiddleware(
+			h.handleGetRecordProof,
+			instrumentHandler("handleGetRecordProof"),
+			m.checkJet,
+		),
+	)
+
 	// Validation.
 	h.Bus.MustRegister(insolar.TypeValidateRecord,
 		BuildMiddleware(h.handleValidateRecord,
@@ -302,6 +342,17 @@ func (h *MessageHandler) handleSetRecord(ctx context.Context, parcel insolar.Par
 	return &reply.ID{ID: *id}, nil
 }
 
+func (h *MessageHandler) handleSetEvents(ctx context.Context, parcel insolar.Parcel) (insolar.Reply, error) {
+	msg := parcel.Message().(*message.SetEvents)
+
+	err := h.EventModifier.SetEvents(ctx, *msg.Object.Record(), msg.Result, msg.Events)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to index events")
+	}
+
+	return &reply.OK{}, nil
+}
+
 func (h *MessageHandler) handleSetBlob(ctx context.Context, parcel insolar.Parcel) (insolar.Reply, error) {
 	msg := parcel.Message().(*message.SetBlob)
 	jetID := jetFromContext(ctx)
@@ -437,6 +488,8 @@ func (h *MessageHandler) handleGetObject(
 			ChildPointer: idx.ChildPointer,
 			Parent:       idx.Parent,
 			Memory:       obj.Memory,
+			PrevState:    obj.PrevState,
+			Request:      obj.Request,
 		}, nil
 	}
 
@@ -482,6 +535,8 @@ func (h *MessageHandler) handleGetObject(
 			ChildPointer: idx.ChildPointer,
 			Parent:       idx.Parent,
 			Memory:       obj.Memory,
+			PrevState:    obj.PrevState,
+			Request:      obj.Request,
 		}, nil
 	}
 	if err != nil {
@@ -509,6 +564,8 @@ func (h *MessageHandler) handleGetObject(
 		IsPrototype:  state.GetIsPrototype(),
 		ChildPointer: childPointer,
 		Parent:       idx.Parent,
+		PrevState:    state.PrevStateID(),
+		Request:      state.GetRequest(),
 	}
 
 	if state.GetMemory() != nil {
@@ -748,6 +805,94 @@ func (h *MessageHandler) handleGetPendingRequestID(ctx context.Context, parcel i
 	return &rep, nil
 }
 
+func (h *MessageHandler) handleGetRequestResult(ctx context.Context, parcel insolar.Parcel) (insolar.Reply, error) {
+	msg := parcel.Message().(*message.GetRequestResult)
+
+	resultID, err := h.ResultAccessor.ForRequest(ctx, msg.Request)
+	if err == object.ErrNotFound {
+		// Request without result is pending if it's registered.
+		_, err = h.RecordAccessor.ForID(ctx, msg.Request)
+		if err == object.ErrNotFound {
+			return &reply.Error{ErrType: reply.ErrRequestNotFound}, nil
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to fetch request")
+		}
+		return &reply.RequestResult{Request: msg.Request}, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch result id")
+	}
+
+	rec, err := h.RecordAccessor.ForID(ctx, resultID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch result")
+	}
+	res, ok := rec.Record.(*object.ResultRecord)
+	if !ok {
+		return nil, errors.New("failed to decode result")
+	}
+
+	rep := reply.RequestResult{
+		Request: msg.Request,
+		Result:  &resultID,
+		Payload: res.Payload,
+	}
+
+	return &rep, nil
+}
+
+func (h *MessageHandler) handleGetEvents(ctx context.Context, parcel insolar.Parcel) (insolar.Reply, error) {
+	msg := parcel.Message().(*message.GetEvents)
+
+	events, err := h.EventAccessor.ForObject(ctx, msg.Object, msg.Name, msg.From, msg.To)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch events")
+	}
+
+	return &reply.Events{Events: events}, nil
+}
+
+func (h *MessageHandler) handleGetRecordProof(ctx context.Context, parcel insolar.Parcel) (insolar.Reply, error) {
+	msg := parcel.Message().(*message.GetRecordProof)
+
+	rec, err := h.RecordAccessor.ForID(ctx, msg.Record)
+	if err == object.ErrNotFound {
+		return &reply.Error{ErrType: reply.ErrRecordNotFound}, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch record")
+	}
+
+	// Drop of the record's pulse is not created until the pulse ends.
+	dr, err := h.DropAccessor.ForPulse(ctx, rec.JetID, msg.Record.Pulse())
+	if err == drop.ErrNotFound || err == store.ErrNotFound {
+		return &reply.Error{ErrType: reply.ErrRecordNotFound}, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch drop")
+	}
+
+	ids, err := h.DropRecords.ForDrop(ctx, rec.JetID, msg.Record.Pulse())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch drop records")
+	}
+	root, proof, err := drop.RecordProof(h.PlatformCryptographyScheme, ids, msg.Record)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build proof")
+	}
+	if !bytes.Equal(root, dr.MerkleRoot) {
+		return nil, errors.New("drop records don't match drop merkle root")
+	}
+
+	return &reply.RecordProof{
+		Drop:     drop.MustEncode(&dr),
+		Index:    proof.Index,
+		Leaves:   proof.Leaves,
+		Siblings: proof.Siblings,
+	}, nil
+}
+
 func (h *MessageHandler) handleUpdateObject(ctx context.Context, parcel insolar.Parcel) (insolar.Reply, error) {
 	msg := parcel.Message().(*message.UpdateObject)
 	jetID := jetFromContext(ctx)
@@ -854,6 +999,8 @@ func (h *MessageHandler) handleUpdateObject(ctx context.Context, parcel insolar.
 		IsPrototype:  state.GetIsPrototype(),
 		ChildPointer: idx.ChildPointer,
 		Parent:       idx.Parent,
+		PrevState:    state.PrevStateID(),
+		Request:      state.GetRequest(),
 	}
 	return &rep, nil
 }
@@ -1174,12 +1321,18 @@ func (h *MessageHandler) handleHotRecords(ctx context.Context, parcel insolar.Pa
 		"jet": jetID.DebugString(),
 	}).Info("received hot data")
 
-	err := h.DropModifier.Set(ctx, msg.Drop)
-	if err == storage.ErrOverride {
-		err = nil
+	drops := []drop.Drop{msg.Drop}
+	if msg.SiblingDrop != nil {
+		drops = append(drops, *msg.SiblingDrop)
 	}
-	if err != nil {
-		return nil, errors.Wrapf(err, "[jet]: drop error (pulse: %v)", msg.Drop.Pulse)
+	for _, d := range drops {
+		err := h.DropModifier.Set(ctx, d)
+		if err == storage.ErrOverride {
+			err = nil
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "[jet]: drop error (pulse: %v)", d.Pulse)
+		}
 	}
 
 	pendingStorage := h.RecentStorageProvider.GetPendingStorage(ctx, jetID)
@@ -1217,7 +1370,7 @@ func (h *MessageHandler) handleHotRecords(ctx context.Context, parcel insolar.Pa
 	for id, meta := range msg.RecentObjects {
 		decodedIndex := object.DecodeIndex(meta.Index)
 
-		err = h.ObjectStorage.SetObjectIndex(ctx, jetID, &id, &decodedIndex)
+		err := h.ObjectStorage.SetObjectIndex(ctx, jetID, &id, &decodedIndex)
 		if err != nil {
 			logger.Error(err)
 			continue
@@ -45,6 +45,7 @@ import (
 	"github.com/insolar/insolar/ledger/storage/node"
 	"github.com/insolar/insolar/ledger/storage/object"
 	"github.com/insolar/insolar/ledger/storage/storagetest"
+	"github.com/insolar/insolar/network/merkle"
 	"github.com/insolar/insolar/testutils"
 	"github.com/insolar/insolar/testutils/network"
 )
@@ -989,6 +990,7 @@ func (s *handlerSuite) TestMessageHandler_HandleRegisterChild_IndexStateUpdated(
 func (s *handlerSuite) TestMessageHandler_HandleHotRecords() {
 	mc := minimock.NewController(s.T())
 	jetID := gen.JetID()
+	siblingJetID := gen.JetID()
 
 	jc := testutils.NewJetCoordinatorMock(mc)
 
@@ -1025,7 +1027,8 @@ func (s *handlerSuite) TestMessageHandler_HandleHotRecords() {
 			*secondID: {},
 			*thirdID:  {Active: true},
 		},
-		Drop: drop.Drop{Pulse: insolar.FirstPulseNumber, Hash: []byte{88}, JetID: jetID},
+		Drop:        drop.Drop{Pulse: insolar.FirstPulseNumber, Hash: []byte{88}, JetID: jetID},
+		SiblingDrop: &drop.Drop{Pulse: insolar.FirstPulseNumber, Hash: []byte{89}, JetID: siblingJetID},
 	}
 
 	indexMock := recentstorage.NewRecentIndexStorageMock(s.T())
@@ -1073,6 +1076,10 @@ func (s *handlerSuite) TestMessageHandler_HandleHotRecords() {
 	require.NoError(s.T(), err)
 	require.Equal(s.T(), drop.Drop{Pulse: insolar.FirstPulseNumber, Hash: []byte{88}, JetID: jetID}, savedDrop)
 
+	savedSiblingDrop, err := s.dropAccessor.ForPulse(s.ctx, siblingJetID, insolar.FirstPulseNumber)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), drop.Drop{Pulse: insolar.FirstPulseNumber, Hash: []byte{89}, JetID: siblingJetID}, savedSiblingDrop)
+
 	indexMock.MinimockFinish()
 	pendingMock.MinimockFinish()
 }
@@ -1203,3 +1210,122 @@ func (s *handlerSuite) TestMessageHandler_HandleGetRequest() {
 	vrec, _ := object.DecodeVirtual(reqReply.Record)
 	assert.Equal(s.T(), req, *vrec.(*object.RequestRecord))
 }
+
+func (s *handlerSuite) TestMessageHandler_HandleGetRequestResult() {
+	jetID := insolar.ID(*insolar.NewJetID(0, nil))
+	records := object.NewRecordMemory()
+
+	h := NewMessageHandler(&configuration.Ledger{})
+	h.RecordAccessor = records
+	h.ResultAccessor = records
+
+	getResult := func(request insolar.ID) (insolar.Reply, error) {
+		return h.handleGetRequestResult(contextWithJet(s.ctx, jetID), &message.Parcel{
+			Msg:         &message.GetRequestResult{Request: request},
+			PulseNumber: insolar.FirstPulseNumber + 1,
+		})
+	}
+
+	req := object.RequestRecord{
+		MessageHash: []byte{1, 2, 3},
+		Object:      *genRandomID(0),
+	}
+	reqID := object.NewRecordIDFromRecord(s.scheme, insolar.FirstPulseNumber, &req)
+
+	// Unknown request.
+	rep, err := getResult(*reqID)
+	require.NoError(s.T(), err)
+	errReply, ok := rep.(*reply.Error)
+	require.True(s.T(), ok)
+	assert.Equal(s.T(), reply.ErrType(reply.ErrRequestNotFound), errReply.ErrType)
+
+	// Pending request.
+	err = records.Set(s.ctx, *reqID, record.MaterialRecord{Record: &req, JetID: insolar.JetID(jetID)})
+	require.NoError(s.T(), err)
+	rep, err = getResult(*reqID)
+	require.NoError(s.T(), err)
+	resReply, ok := rep.(*reply.RequestResult)
+	require.True(s.T(), ok)
+	assert.Equal(s.T(), *reqID, resReply.Request)
+	assert.Nil(s.T(), resReply.Result)
+
+	// Executed request.
+	res := object.ResultRecord{
+		Object:  req.Object,
+		Request: *genRefWithID(reqID),
+		Payload: []byte{4, 5, 6},
+	}
+	resID := object.NewRecordIDFromRecord(s.scheme, insolar.FirstPulseNumber, &res)
+	err = records.Set(s.ctx, *resID, record.MaterialRecord{Record: &res, JetID: insolar.JetID(jetID)})
+	require.NoError(s.T(), err)
+	rep, err = getResult(*reqID)
+	require.NoError(s.T(), err)
+	resReply, ok = rep.(*reply.RequestResult)
+	require.True(s.T(), ok)
+	require.NotNil(s.T(), resReply.Result)
+	assert.Equal(s.T(), *resID, *resReply.Result)
+	assert.Equal(s.T(), res.Payload, resReply.Payload)
+}
+
+func (s *handlerSuite) TestMessageHandler_HandleGetRecordProof() {
+	jetID := insolar.ID(*insolar.NewJetID(0, nil))
+	records := object.NewRecordMemory()
+	drops := drop.NewStorageMemory()
+
+	h := NewMessageHandler(&configuration.Ledger{})
+	h.PlatformCryptographyScheme = s.scheme
+	h.RecordAccessor = records
+	h.DropAccessor = drops
+	h.DropRecords = records
+
+	getProof := func(id insolar.ID) (insolar.Reply, error) {
+		return h.handleGetRecordProof(contextWithJet(s.ctx, jetID), &message.Parcel{
+			Msg:         &message.GetRecordProof{Record: id},
+			PulseNumber: insolar.FirstPulseNumber + 1,
+		})
+	}
+
+	var ids []insolar.ID
+	for i := 0; i < 5; i++ {
+		rec := object.RequestRecord{MessageHash: []byte{byte(i)}, Object: *genRandomID(0)}
+		id := object.NewRecordIDFromRecord(s.scheme, insolar.FirstPulseNumber, &rec)
+		err := records.Set(s.ctx, *id, record.MaterialRecord{Record: &rec, JetID: insolar.JetID(jetID)})
+		require.NoError(s.T(), err)
+		ids = append(ids, *id)
+	}
+
+	// Unknown record.
+	rep, err := getProof(*genRandomID(insolar.FirstPulseNumber))
+	require.NoError(s.T(), err)
+	errReply, ok := rep.(*reply.Error)
+	require.True(s.T(), ok)
+	assert.Equal(s.T(), reply.ErrType(reply.ErrRecordNotFound), errReply.ErrType)
+
+	// Drop is not created yet.
+	rep, err = getProof(ids[2])
+	require.NoError(s.T(), err)
+	errReply, ok = rep.(*reply.Error)
+	require.True(s.T(), ok)
+	assert.Equal(s.T(), reply.ErrType(reply.ErrRecordNotFound), errReply.ErrType)
+
+	// Drop is created.
+	dr := drop.Drop{
+		Pulse:      insolar.FirstPulseNumber,
+		JetID:      insolar.JetID(jetID),
+		MerkleRoot: drop.RecordsRoot(s.scheme, ids),
+	}
+	err = drops.Set(s.ctx, dr)
+	require.NoError(s.T(), err)
+	for i, id := range ids {
+		rep, err = getProof(id)
+		require.NoError(s.T(), err)
+		proofReply, ok := rep.(*reply.RecordProof)
+		require.True(s.T(), ok)
+		gotDrop, err := drop.Decode(proofReply.Drop)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), dr.MerkleRoot, gotDrop.MerkleRoot)
+		proof := merkle.InclusionProof{Index: proofReply.Index, Leaves: proofReply.Leaves, Siblings: proofReply.Siblings}
+		assert.True(s.T(), drop.VerifyRecord(s.scheme, *gotDrop, id, proof))
+		assert.False(s.T(), drop.VerifyRecord(s.scheme, *gotDrop, ids[(i+1)%len(ids)], proof))
+	}
+}
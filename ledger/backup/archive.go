@@ -0,0 +1,346 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package backup
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+)
+
+// Version is the current archive format version.
+const Version uint32 = 1
+
+const (
+	magic = "INSLEDGR"
+
+	tagSection    byte = 1
+	tagEntry      byte = 2
+	tagSectionEnd byte = 3
+	tagEnd        byte = 4
+
+	// maxEntrySize limits key and value sizes to protect reader from corrupted length prefixes.
+	maxEntrySize = 1 << 28
+)
+
+// Section is a kind of ledger data stored in archive.
+type Section byte
+
+const (
+	// SectionRecords holds records.
+	SectionRecords Section = iota + 1
+	// SectionBlobs holds blobs.
+	SectionBlobs
+	// SectionDrops holds jet drops.
+	SectionDrops
+	// SectionIndexes holds object indexes.
+	SectionIndexes
+	// SectionPulses holds pulses in ascending order.
+	SectionPulses
+	// SectionJets holds jet tree leaves for every pulse with drops.
+	SectionJets
+	// SectionLegacy holds key-values of legacy storage (lifelines and system records).
+	SectionLegacy
+)
+
+func (s Section) String() string {
+	switch s {
+	case SectionRecords:
+		return "records"
+	case SectionBlobs:
+		return "blobs"
+	case SectionDrops:
+		return "drops"
+	case SectionIndexes:
+		return "indexes"
+	case SectionPulses:
+		return "pulses"
+	case SectionJets:
+		return "jets"
+	case SectionLegacy:
+		return "legacy"
+	}
+	return fmt.Sprintf("section(%d)", byte(s))
+}
+
+// Writer writes archive to underlying stream.
+type Writer struct {
+	buf  *bufio.Writer
+	out  io.Writer
+	hash hash.Hash
+
+	section     Section
+	inSection   bool
+	count       uint64
+	sectionHash hash.Hash
+}
+
+// NewWriter writes archive header with snapshot pulse and returns Writer.
+func NewWriter(w io.Writer, pulse insolar.PulseNumber) (*Writer, error) {
+	aw := &Writer{
+		buf:  bufio.NewWriter(w),
+		hash: sha256.New(),
+	}
+	aw.out = io.MultiWriter(aw.buf, aw.hash)
+
+	header := make([]byte, 0, len(magic)+8)
+	header = append(header, magic...)
+	header = append(header, uint32Bytes(Version)...)
+	header = append(header, pulse.Bytes()...)
+	_, err := aw.out.Write(header)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to write archive header")
+	}
+	return aw, nil
+}
+
+// Begin starts new section. Previous section should be finished with End.
+func (w *Writer) Begin(s Section) error {
+	if w.inSection {
+		return errors.Errorf("section %v is not finished", w.section)
+	}
+	w.section = s
+	w.inSection = true
+	w.count = 0
+	w.sectionHash = sha256.New()
+	_, err := w.out.Write([]byte{tagSection, byte(s)})
+	return err
+}
+
+// Write writes key-value entry to current section.
+func (w *Writer) Write(key, value []byte) error {
+	if !w.inSection {
+		return errors.New("entry is written outside of section")
+	}
+	entry := make([]byte, 0, len(key)+len(value)+2*binary.MaxVarintLen64)
+	entry = appendUvarint(entry, uint64(len(key)))
+	entry = append(entry, key...)
+	entry = appendUvarint(entry, uint64(len(value)))
+	entry = append(entry, value...)
+
+	w.count++
+	w.sectionHash.Write(entry)
+	_, err := w.out.Write(append([]byte{tagEntry}, entry...))
+	return err
+}
+
+// End finishes current section and writes its entries count and checksum.
+func (w *Writer) End() error {
+	if !w.inSection {
+		return errors.New("no section to finish")
+	}
+	w.inSection = false
+	trailer := []byte{tagSectionEnd}
+	trailer = append(trailer, uint64Bytes(w.count)...)
+	trailer = append(trailer, w.sectionHash.Sum(nil)...)
+	_, err := w.out.Write(trailer)
+	return err
+}
+
+// Close writes archive checksum and flushes buffered data. It doesn't close underlying stream.
+func (w *Writer) Close() error {
+	if w.inSection {
+		return errors.Errorf("section %v is not finished", w.section)
+	}
+	_, err := w.out.Write([]byte{tagEnd})
+	if err != nil {
+		return err
+	}
+	_, err = w.buf.Write(w.hash.Sum(nil))
+	if err != nil {
+		return err
+	}
+	return w.buf.Flush()
+}
+
+// Reader reads archive and verifies its checksums.
+type Reader struct {
+	in    *hashReader
+	pulse insolar.PulseNumber
+
+	section     Section
+	inSection   bool
+	count       uint64
+	sectionHash hash.Hash
+}
+
+// NewReader reads archive header and returns Reader. ErrBadMagic or ErrVersion is returned if stream is not
+// an archive of supported version.
+func NewReader(r io.Reader) (*Reader, error) {
+	in := &hashReader{r: bufio.NewReader(r), hash: sha256.New()}
+
+	header := make([]byte, len(magic)+8)
+	_, err := io.ReadFull(in, header)
+	if err != nil {
+		return nil, ErrBadMagic
+	}
+	if string(header[:len(magic)]) != magic {
+		return nil, ErrBadMagic
+	}
+	if binary.BigEndian.Uint32(header[len(magic):]) != Version {
+		return nil, ErrVersion
+	}
+
+	return &Reader{
+		in:    in,
+		pulse: insolar.NewPulseNumber(header[len(magic)+4:]),
+	}, nil
+}
+
+// Pulse returns snapshot pulse from archive header.
+func (r *Reader) Pulse() insolar.PulseNumber {
+	return r.pulse
+}
+
+// Next starts reading of next section. Current section should be read till the end. After the last section
+// io.EOF is returned if archive checksum is valid.
+func (r *Reader) Next() (Section, error) {
+	if r.inSection {
+		return 0, errors.Errorf("section %v is not read", r.section)
+	}
+	tag, err := r.in.ReadByte()
+	if err != nil {
+		return 0, ErrCorrupted
+	}
+	switch tag {
+	case tagSection:
+		s, err := r.in.ReadByte()
+		if err != nil {
+			return 0, ErrCorrupted
+		}
+		r.section = Section(s)
+		r.inSection = true
+		r.count = 0
+		r.sectionHash = sha256.New()
+		return r.section, nil
+	case tagEnd:
+		expected := r.in.hash.Sum(nil)
+		sum := make([]byte, sha256.Size)
+		_, err := io.ReadFull(r.in.r, sum)
+		if err != nil {
+			return 0, ErrCorrupted
+		}
+		if !bytes.Equal(sum, expected) {
+			return 0, ErrChecksum
+		}
+		return 0, io.EOF
+	}
+	return 0, ErrCorrupted
+}
+
+// Read returns next entry of current section. At the end of section io.EOF is returned if section checksum is
+// valid.
+func (r *Reader) Read() (key, value []byte, err error) {
+	if !r.inSection {
+		return nil, nil, io.EOF
+	}
+	tag, err := r.in.ReadByte()
+	if err != nil {
+		return nil, nil, ErrCorrupted
+	}
+	switch tag {
+	case tagEntry:
+		key, err = r.readBytes()
+		if err != nil {
+			return nil, nil, err
+		}
+		value, err = r.readBytes()
+		if err != nil {
+			return nil, nil, err
+		}
+		r.count++
+		return key, value, nil
+	case tagSectionEnd:
+		r.inSection = false
+		trailer := make([]byte, 8+sha256.Size)
+		_, err := io.ReadFull(r.in, trailer)
+		if err != nil {
+			return nil, nil, ErrCorrupted
+		}
+		if binary.BigEndian.Uint64(trailer) != r.count {
+			return nil, nil, ErrChecksum
+		}
+		if !bytes.Equal(trailer[8:], r.sectionHash.Sum(nil)) {
+			return nil, nil, ErrChecksum
+		}
+		return nil, nil, io.EOF
+	}
+	return nil, nil, ErrCorrupted
+}
+
+func (r *Reader) readBytes() ([]byte, error) {
+	size, err := binary.ReadUvarint(r.in)
+	if err != nil || size > maxEntrySize {
+		return nil, ErrCorrupted
+	}
+	buf := make([]byte, size)
+	_, err = io.ReadFull(r.in, buf)
+	if err != nil {
+		return nil, ErrCorrupted
+	}
+
+	r.sectionHash.Write(appendUvarint(nil, size))
+	r.sectionHash.Write(buf)
+	return buf, nil
+}
+
+// hashReader calculates checksum of all bytes read.
+type hashReader struct {
+	r    *bufio.Reader
+	hash hash.Hash
+}
+
+func (h *hashReader) Read(p []byte) (int, error) {
+	n, err := h.r.Read(p)
+	h.hash.Write(p[:n])
+	return n, err
+}
+
+func (h *hashReader) ReadByte() (byte, error) {
+	b, err := h.r.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+	h.hash.Write([]byte{b})
+	return b, nil
+}
+
+func appendUvarint(buf []byte, v uint64) []byte {
+	tmp := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(tmp, v)
+	return append(buf, tmp[:n]...)
+}
+
+func uint32Bytes(v uint32) []byte {
+	buf := make([]byte, 4)
+	binary.BigEndian.PutUint32(buf, v)
+	return buf
+}
+
+func uint64Bytes(v uint64) []byte {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, v)
+	return buf
+}
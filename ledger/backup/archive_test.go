@@ -0,0 +1,133 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package backup
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+)
+
+type testEntry struct {
+	k, v []byte
+}
+
+var testSections = map[Section][]testEntry{
+	SectionRecords: {{[]byte("k1"), []byte("v1")}, {[]byte("k2"), []byte{}}},
+	SectionPulses:  {{[]byte("k3"), bytes.Repeat([]byte{1}, 1000)}},
+}
+
+func writeTestArchive(t *testing.T) []byte {
+	buf := bytes.NewBuffer(nil)
+	w, err := NewWriter(buf, insolar.FirstPulseNumber)
+	require.NoError(t, err)
+	for _, s := range []Section{SectionRecords, SectionPulses} {
+		require.NoError(t, w.Begin(s))
+		for _, e := range testSections[s] {
+			require.NoError(t, w.Write(e.k, e.v))
+		}
+		require.NoError(t, w.End())
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func readTestArchive(data []byte) (map[Section][]testEntry, error) {
+	r, err := NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	res := map[Section][]testEntry{}
+	for {
+		s, err := r.Next()
+		if err == io.EOF {
+			return res, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		for {
+			k, v, err := r.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			res[s] = append(res[s], testEntry{k, v})
+		}
+	}
+}
+
+func TestArchive_WriteRead(t *testing.T) {
+	t.Parallel()
+
+	data := writeTestArchive(t)
+
+	r, err := NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, insolar.PulseNumber(insolar.FirstPulseNumber), r.Pulse())
+
+	res, err := readTestArchive(data)
+	require.NoError(t, err)
+	assert.Equal(t, testSections, res)
+}
+
+func TestArchive_Corrupted(t *testing.T) {
+	t.Parallel()
+
+	data := writeTestArchive(t)
+
+	t.Run("bad magic", func(t *testing.T) {
+		broken := append([]byte{}, data...)
+		broken[0] = 'X'
+		_, err := readTestArchive(broken)
+		assert.Equal(t, ErrBadMagic, err)
+	})
+
+	t.Run("unsupported version", func(t *testing.T) {
+		broken := append([]byte{}, data...)
+		broken[len(magic)+3]++
+		_, err := readTestArchive(broken)
+		assert.Equal(t, ErrVersion, err)
+	})
+
+	t.Run("changed value", func(t *testing.T) {
+		broken := append([]byte{}, data...)
+		i := bytes.Index(broken, []byte("v1"))
+		broken[i] = 'x'
+		_, err := readTestArchive(broken)
+		assert.Equal(t, ErrChecksum, err)
+	})
+
+	t.Run("changed pulse", func(t *testing.T) {
+		broken := append([]byte{}, data...)
+		broken[len(magic)+4]++
+		_, err := readTestArchive(broken)
+		assert.Equal(t, ErrChecksum, err)
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		_, err := readTestArchive(data[:len(data)-10])
+		assert.Equal(t, ErrCorrupted, err)
+	})
+}
@@ -0,0 +1,615 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/ugorji/go/codec"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/storage"
+	"github.com/insolar/insolar/ledger/storage/drop"
+	"github.com/insolar/insolar/ledger/storage/object"
+	"github.com/insolar/insolar/ledger/storage/pulse"
+)
+
+// scopeSections maps sections with raw key-values to db scopes.
+var scopeSections = []struct {
+	section Section
+	scope   store.Scope
+}{
+	{SectionRecords, store.ScopeRecord},
+	{SectionBlobs, store.ScopeBlob},
+	{SectionDrops, store.ScopeJetDrop},
+	{SectionIndexes, store.ScopeIndex},
+}
+
+// Backup writes consistent snapshot of heavy node storages up to provided pulse (inclusive) to w. Latest pulse is
+// used if pn is zero. Snapshot pulse is returned.
+//
+// Legacy is a storage opened by storage.NewDB, db is a storage for records, blobs, drops, indexes and pulses.
+// Indexes are rewound to their state on pn, so they don't point to records created after the snapshot.
+func Backup(
+	ctx context.Context,
+	legacy store.Backend,
+	db store.Backend,
+	pn insolar.PulseNumber,
+	w io.Writer,
+) (insolar.PulseNumber, error) {
+	err := db.View(func(txn store.Txn) error {
+		return legacy.View(func(legacyTxn store.Txn) error {
+			var err error
+			pn, err = backupSnapshot(ctx, txn, legacyTxn, pn, w)
+			return err
+		})
+	})
+	if err != nil {
+		return 0, err
+	}
+	return pn, nil
+}
+
+func backupSnapshot(
+	ctx context.Context,
+	txn store.Txn,
+	legacyTxn store.Txn,
+	pn insolar.PulseNumber,
+	w io.Writer,
+) (insolar.PulseNumber, error) {
+	pulses, err := snapshotPulses(ctx, txn, pn)
+	if err != nil {
+		return 0, err
+	}
+	pn = pulses[len(pulses)-1].PulseNumber
+
+	aw, err := NewWriter(w, pn)
+	if err != nil {
+		return 0, err
+	}
+
+	records := object.NewRecordDB(store.TxnDB(txn))
+	jets := map[insolar.PulseNumber][]insolar.JetID{}
+	for _, ss := range scopeSections {
+		section := ss.section
+		err := writeSection(aw, section, func() error {
+			return txn.Iterate(ss.scope.Bytes(), nil, func(k, v []byte) (bool, error) {
+				keyPulse, ok := scopeKeyPulse(ss.scope, k)
+				if !ok {
+					return false, errors.Errorf("unexpected %v key %x", section, k)
+				}
+				if keyPulse > pn {
+					return true, nil
+				}
+				if section == SectionDrops {
+					dr, err := drop.Decode(v)
+					if err != nil {
+						return false, errors.Wrap(err, "failed to decode drop")
+					}
+					jets[dr.Pulse] = append(jets[dr.Pulse], dr.JetID)
+				}
+				if section == SectionIndexes {
+					idx, ok, err := lifelineAt(ctx, records, object.DecodeIndex(v), pn)
+					if err != nil {
+						return false, errors.Wrapf(err, "failed to rewind index %x", k)
+					}
+					if !ok {
+						return true, nil
+					}
+					v = object.EncodeIndex(idx)
+				}
+				return true, aw.Write(k, v)
+			})
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	err = writeSection(aw, SectionPulses, func() error {
+		for _, p := range pulses {
+			if err := aw.Write(p.PulseNumber.Bytes(), encodePulse(p)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	err = writeSection(aw, SectionJets, func() error {
+		for _, p := range pulses {
+			if len(jets[p.PulseNumber]) == 0 {
+				continue
+			}
+			if err := aw.Write(p.PulseNumber.Bytes(), encodeJets(jets[p.PulseNumber])); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	err = writeSection(aw, SectionLegacy, func() error {
+		return legacyTxn.Iterate(nil, nil, func(k, v []byte) (bool, error) {
+			if legacyKeyPulse(k) > pn {
+				return true, nil
+			}
+			return true, aw.Write(k, v)
+		})
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return pn, aw.Close()
+}
+
+// writeSection writes archive section with entries written by write.
+func writeSection(aw *Writer, section Section, write func() error) error {
+	err := aw.Begin(section)
+	if err != nil {
+		return err
+	}
+	err = write()
+	if err != nil {
+		return err
+	}
+	return aw.End()
+}
+
+// lifelineAt rewinds lifeline to its state on pn. False is returned if the object has no state on pn.
+func lifelineAt(
+	ctx context.Context,
+	records *object.RecordDB,
+	idx object.Lifeline,
+	pn insolar.PulseNumber,
+) (object.Lifeline, bool, error) {
+	after := func(id *insolar.ID) bool {
+		return id != nil && id.Pulse() > pn
+	}
+	if idx.LatestUpdate <= pn && !after(idx.LatestState) && !after(idx.LatestStateApproved) && !after(idx.ChildPointer) {
+		return idx, true, nil
+	}
+
+	if after(idx.LatestState) {
+		state, stateRec, err := stateAt(ctx, records, idx.LatestState, pn)
+		if err != nil {
+			return idx, false, err
+		}
+		if state == nil {
+			return idx, false, nil
+		}
+		idx.LatestState = state
+		idx.State = stateRec.ID()
+	}
+
+	if after(idx.LatestStateApproved) {
+		approved, _, err := stateAt(ctx, records, idx.LatestStateApproved, pn)
+		if err != nil {
+			return idx, false, err
+		}
+		idx.LatestStateApproved = approved
+	}
+
+	for after(idx.ChildPointer) {
+		rec, err := records.ForID(ctx, *idx.ChildPointer)
+		if err != nil {
+			return idx, false, errors.Wrapf(err, "failed to get child %v", idx.ChildPointer)
+		}
+		child, ok := rec.Record.(*object.ChildRecord)
+		if !ok {
+			return idx, false, errors.Errorf("record %v is not a child record", idx.ChildPointer)
+		}
+		idx.ChildPointer = child.PrevChild
+	}
+	if idx.LatestUpdate > pn {
+		idx.LatestUpdate = 0
+		if idx.LatestState != nil {
+			idx.LatestUpdate = idx.LatestState.Pulse()
+		}
+		if idx.ChildPointer != nil && idx.ChildPointer.Pulse() > idx.LatestUpdate {
+			idx.LatestUpdate = idx.ChildPointer.Pulse()
+		}
+	}
+
+	return idx, true, nil
+}
+
+// stateAt walks object states back from id to the latest one created not later than pn.
+func stateAt(
+	ctx context.Context,
+	records *object.RecordDB,
+	id *insolar.ID,
+	pn insolar.PulseNumber,
+) (*insolar.ID, object.State, error) {
+	for id != nil {
+		rec, err := records.ForID(ctx, *id)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to get state %v", id)
+		}
+		state, ok := rec.Record.(object.State)
+		if !ok {
+			return nil, nil, errors.Errorf("record %v is not a state record", id)
+		}
+		if id.Pulse() <= pn {
+			return id, state, nil
+		}
+		id = state.PrevStateID()
+	}
+	return nil, nil, nil
+}
+
+// snapshotPulses returns pulses from the first one up to pn in ascending order.
+func snapshotPulses(ctx context.Context, txn store.Txn, pn insolar.PulseNumber) ([]insolar.Pulse, error) {
+	ps := pulse.NewStorageDB(store.TxnDB(txn))
+	if pn == 0 {
+		latest, err := ps.Latest(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get latest pulse")
+		}
+		pn = latest.PulseNumber
+	}
+	current, err := ps.ForPulseNumber(ctx, pn)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get pulse %v", pn)
+	}
+
+	pulses := []insolar.Pulse{current}
+	for {
+		prev, err := ps.Backwards(ctx, current.PulseNumber, 1)
+		if err == pulse.ErrNotFound {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get previous pulse")
+		}
+		pulses = append(pulses, prev)
+		current = prev
+	}
+
+	for i, j := 0, len(pulses)-1; i < j; i, j = i+1, j-1 {
+		pulses[i], pulses[j] = pulses[j], pulses[i]
+	}
+	return pulses, nil
+}
+
+// Restore reads archive from r and writes its content to empty storages. Restore fails if archive is corrupted,
+// if its snapshot pulse differs from expected (when expected is not zero) or if its content is inconsistent.
+// Storages should be dropped if an error is returned. Snapshot pulse is returned.
+func Restore(
+	ctx context.Context,
+	r io.Reader,
+	legacy store.Backend,
+	db store.Backend,
+	expected insolar.PulseNumber,
+) (insolar.PulseNumber, error) {
+	err := checkEmpty(legacy, db)
+	if err != nil {
+		return 0, err
+	}
+
+	ar, err := NewReader(r)
+	if err != nil {
+		return 0, err
+	}
+	pn := ar.Pulse()
+	if expected != 0 && expected != pn {
+		return 0, errors.Wrapf(ErrMismatch, "archive pulse is %v, expected %v", pn, expected)
+	}
+
+	rs := &restorer{
+		ctx:       ctx,
+		pulse:     pn,
+		legacy:    legacy,
+		db:        db,
+		seen:      map[Section]bool{},
+		dropJets:  map[insolar.PulseNumber][]insolar.JetID{},
+		savedJets: map[insolar.PulseNumber][]insolar.JetID{},
+	}
+	for {
+		section, err := ar.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+		if rs.seen[section] {
+			return 0, errors.Wrapf(ErrCorrupted, "duplicate section %v", section)
+		}
+		rs.seen[section] = true
+
+		err = rs.restoreSection(ar, section)
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to restore %v", section)
+		}
+	}
+
+	err = rs.verify()
+	if err != nil {
+		return 0, err
+	}
+	return pn, nil
+}
+
+// restoreBatchSize limits entries count written in a single transaction.
+const restoreBatchSize = 1000
+
+type restorer struct {
+	ctx    context.Context
+	pulse  insolar.PulseNumber
+	legacy store.Backend
+	db     store.Backend
+
+	seen       map[Section]bool
+	lastPulse  insolar.PulseNumber
+	dropJets   map[insolar.PulseNumber][]insolar.JetID
+	savedJets  map[insolar.PulseNumber][]insolar.JetID
+	savedPulse map[insolar.PulseNumber]bool
+}
+
+func (rs *restorer) restoreSection(ar *Reader, section Section) error {
+	var (
+		target store.Backend
+		check  func(k, v []byte) error
+		save   func(txn store.Txn, k, v []byte) error
+	)
+	set := func(txn store.Txn, k, v []byte) error {
+		return txn.Set(k, v)
+	}
+
+	switch section {
+	case SectionRecords, SectionBlobs, SectionDrops, SectionIndexes:
+		var scope store.Scope
+		for _, ss := range scopeSections {
+			if ss.section == section {
+				scope = ss.scope
+			}
+		}
+		target, save = rs.db, set
+		if section == SectionRecords {
+			// Request to result, drop records and event indexes are not archived, they are rebuilt from records.
+			save = func(txn store.Txn, k, v []byte) error {
+				if len(v) < insolar.RecordIDSize {
+					return errors.Wrap(ErrCorrupted, "failed to decode record")
+				}
+				rec, err := object.DecodeMaterial(v)
+				if err != nil {
+					return errors.Wrap(ErrCorrupted, "failed to decode record")
+				}
+				var id insolar.ID
+				copy(id[:], k[1:])
+				err = txn.Set(k, v)
+				if err != nil {
+					return err
+				}
+				err = object.SetResultIndex(store.TxnDB(txn), id, rec)
+				if err != nil {
+					return err
+				}
+				err = object.SetDropIndex(store.TxnDB(txn), id, rec)
+				if err != nil {
+					return err
+				}
+				return object.SetEventIndex(store.TxnDB(txn), id, rec)
+			}
+		}
+		check = func(k, v []byte) error {
+			keyPulse, ok := scopeKeyPulse(scope, k)
+			if !ok || keyPulse > rs.pulse {
+				return errors.Wrapf(ErrMismatch, "unexpected key %x", k)
+			}
+			if section == SectionDrops {
+				dr, err := drop.Decode(v)
+				if err != nil {
+					return errors.Wrap(ErrCorrupted, "failed to decode drop")
+				}
+				rs.dropJets[dr.Pulse] = append(rs.dropJets[dr.Pulse], dr.JetID)
+			}
+			return nil
+		}
+	case SectionPulses:
+		rs.savedPulse = map[insolar.PulseNumber]bool{}
+		target = rs.db
+		check = func(k, v []byte) error {
+			pn := insolar.NewPulseNumber(k)
+			if len(k) != insolar.PulseNumberSize || pn <= rs.lastPulse || pn > rs.pulse {
+				return errors.Wrapf(ErrMismatch, "unexpected pulse %x", k)
+			}
+			rs.lastPulse = pn
+			rs.savedPulse[pn] = true
+			return nil
+		}
+		save = func(txn store.Txn, k, v []byte) error {
+			p, err := decodePulse(v)
+			if err != nil {
+				return errors.Wrap(ErrCorrupted, "failed to decode pulse")
+			}
+			return pulse.NewStorageDB(store.TxnDB(txn)).Append(rs.ctx, p)
+		}
+	case SectionJets:
+		check = func(k, v []byte) error {
+			if len(k) != insolar.PulseNumberSize || len(v)%insolar.RecordIDSize != 0 {
+				return errors.Wrapf(ErrCorrupted, "unexpected jets entry %x", k)
+			}
+			rs.savedJets[insolar.NewPulseNumber(k)] = decodeJets(v)
+			return nil
+		}
+	case SectionLegacy:
+		target, save = rs.legacy, set
+		check = func(k, v []byte) error {
+			if legacyKeyPulse(k) > rs.pulse {
+				return errors.Wrapf(ErrMismatch, "unexpected key %x", k)
+			}
+			return nil
+		}
+	default:
+		return errors.Wrapf(ErrCorrupted, "unknown section %v", section)
+	}
+
+	var batch []insolar.KV
+	flush := func() error {
+		if len(batch) == 0 || target == nil {
+			batch = batch[:0]
+			return nil
+		}
+		err := target.Update(func(txn store.Txn) error {
+			for _, kv := range batch {
+				if err := save(txn, kv.K, kv.V); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		batch = batch[:0]
+		return err
+	}
+
+	for {
+		k, v, err := ar.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		err = check(k, v)
+		if err != nil {
+			return err
+		}
+		batch = append(batch, insolar.KV{K: k, V: v})
+		if len(batch) >= restoreBatchSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	return flush()
+}
+
+// verify checks that all sections are present and consistent with each other.
+func (rs *restorer) verify() error {
+	for s := SectionRecords; s <= SectionLegacy; s++ {
+		if !rs.seen[s] {
+			return errors.Wrapf(ErrCorrupted, "section %v is missing", s)
+		}
+	}
+	if rs.lastPulse != rs.pulse {
+		return errors.Wrapf(ErrMismatch, "last pulse is %v, snapshot pulse is %v", rs.lastPulse, rs.pulse)
+	}
+	if len(rs.dropJets) != len(rs.savedJets) {
+		return errors.Wrap(ErrMismatch, "jets don't match drops")
+	}
+	for pn, jets := range rs.dropJets {
+		if !rs.savedPulse[pn] {
+			return errors.Wrapf(ErrMismatch, "drop for unknown pulse %v", pn)
+		}
+		if !bytes.Equal(encodeJets(jets), encodeJets(rs.savedJets[pn])) {
+			return errors.Wrapf(ErrMismatch, "jets don't match drops on pulse %v", pn)
+		}
+	}
+	return nil
+}
+
+func checkEmpty(backends ...store.Backend) error {
+	for _, b := range backends {
+		empty := true
+		err := b.View(func(txn store.Txn) error {
+			return txn.Iterate(nil, nil, func(k, v []byte) (bool, error) {
+				empty = false
+				return false, nil
+			})
+		})
+		if err != nil {
+			return err
+		}
+		if !empty {
+			return ErrNotEmpty
+		}
+	}
+	return nil
+}
+
+// scopeKeyPulse extracts pulse number from full key of provided scope.
+func scopeKeyPulse(scope store.Scope, k []byte) (insolar.PulseNumber, bool) {
+	switch scope {
+	case store.ScopeRecord, store.ScopeBlob, store.ScopeIndex:
+		if len(k) != 1+insolar.RecordIDSize {
+			return 0, false
+		}
+		return insolar.NewPulseNumber(k[1:]), true
+	case store.ScopeJetDrop:
+		if len(k) < 1+insolar.PulseNumberSize {
+			return 0, false
+		}
+		return insolar.NewPulseNumber(k[len(k)-insolar.PulseNumberSize:]), true
+	}
+	return 0, false
+}
+
+// legacyKeyPulse returns pulse number for legacy storage key or zero if key doesn't depend on pulse.
+func legacyKeyPulse(k []byte) insolar.PulseNumber {
+	if len(k) < insolar.RecordHashSize+insolar.PulseNumberSize {
+		return 0
+	}
+	return storage.Key(k).PulseNumber()
+}
+
+func encodePulse(p insolar.Pulse) []byte {
+	buff := bytes.NewBuffer(nil)
+	enc := codec.NewEncoder(buff, &codec.CborHandle{})
+	enc.MustEncode(p)
+	return buff.Bytes()
+}
+
+func decodePulse(buf []byte) (p insolar.Pulse, err error) {
+	dec := codec.NewDecoderBytes(buf, &codec.CborHandle{})
+	err = dec.Decode(&p)
+	return
+}
+
+// encodeJets sorts jets and joins them.
+func encodeJets(jets []insolar.JetID) []byte {
+	sorted := make([][]byte, 0, len(jets))
+	for _, jet := range jets {
+		jet := jet
+		sorted = append(sorted, jet[:])
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i], sorted[j]) < 0
+	})
+	return bytes.Join(sorted, nil)
+}
+
+func decodeJets(buf []byte) []insolar.JetID {
+	jets := make([]insolar.JetID, 0, len(buf)/insolar.RecordIDSize)
+	for i := 0; i < len(buf); i += insolar.RecordIDSize {
+		var jet insolar.JetID
+		copy(jet[:], buf[i:])
+		jets = append(jets, jet)
+	}
+	return jets
+}
@@ -0,0 +1,247 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package backup_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/internal/ledger/store/storetest"
+	"github.com/insolar/insolar/ledger/backup"
+	"github.com/insolar/insolar/ledger/storage"
+	"github.com/insolar/insolar/ledger/storage/drop"
+	"github.com/insolar/insolar/ledger/storage/object"
+	"github.com/insolar/insolar/ledger/storage/pulse"
+)
+
+type testLedger struct {
+	pulses   []insolar.PulseNumber
+	records  map[insolar.PulseNumber]insolar.ID
+	requests map[insolar.PulseNumber]insolar.ID
+	drops    map[insolar.PulseNumber]drop.Drop
+}
+
+func fillLedger(ctx context.Context, t *testing.T, legacy, db store.PersistentDB) testLedger {
+	tl := testLedger{
+		records:  map[insolar.PulseNumber]insolar.ID{},
+		requests: map[insolar.PulseNumber]insolar.ID{},
+		drops:    map[insolar.PulseNumber]drop.Drop{},
+	}
+	pulses := pulse.NewStorageDB(db)
+	records := object.NewRecordDB(db)
+	drops := drop.NewStorageDB(db)
+
+	for i := 0; i < 3; i++ {
+		pn := insolar.FirstPulseNumber + insolar.PulseNumber(i*10)
+		tl.pulses = append(tl.pulses, pn)
+		require.NoError(t, pulses.Append(ctx, insolar.Pulse{PulseNumber: pn}))
+
+		id := gen.ID()
+		id = *insolar.NewID(pn, id.Hash())
+		tl.records[pn] = id
+		request := gen.Reference()
+		tl.requests[pn] = *request.Record()
+		require.NoError(t, records.Set(ctx, id, record.MaterialRecord{
+			Record: &object.ResultRecord{Request: request},
+			JetID:  insolar.ZeroJetID,
+		}))
+
+		dr := drop.Drop{Pulse: pn, JetID: insolar.ZeroJetID, Hash: []byte{byte(i)}}
+		tl.drops[pn] = dr
+		require.NoError(t, drops.Set(ctx, dr))
+	}
+
+	err := legacy.Update(func(txn store.Txn) error {
+		return txn.Set(storage.GenesisPrefixKey(), []byte("genesis"))
+	})
+	require.NoError(t, err)
+	return tl
+}
+
+func TestBackupRestore(t *testing.T) {
+	t.Parallel()
+
+	for _, engine := range store.Engines {
+		engine := engine
+		t.Run(engine, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := inslogger.TestContext(t)
+			legacy, cleanLegacy := storetest.TmpDB(t, engine)
+			defer cleanLegacy()
+			db, cleanDB := storetest.TmpDB(t, engine)
+			defer cleanDB()
+			tl := fillLedger(ctx, t, legacy, db)
+			snapshot := tl.pulses[1]
+
+			archive := bytes.NewBuffer(nil)
+			pn, err := backup.Backup(ctx, legacy, db, snapshot, archive)
+			require.NoError(t, err)
+			require.Equal(t, snapshot, pn)
+
+			t.Run("restores snapshot", func(t *testing.T) {
+				restoredLegacy, cleanLegacy := storetest.TmpDB(t, engine)
+				defer cleanLegacy()
+				restored, cleanDB := storetest.TmpDB(t, engine)
+				defer cleanDB()
+
+				pn, err := backup.Restore(ctx, bytes.NewReader(archive.Bytes()), restoredLegacy, restored, snapshot)
+				require.NoError(t, err)
+				assert.Equal(t, snapshot, pn)
+
+				latest, err := pulse.NewStorageDB(restored).Latest(ctx)
+				require.NoError(t, err)
+				assert.Equal(t, snapshot, latest.PulseNumber)
+
+				records := object.NewRecordDB(restored)
+				drops := drop.NewStorageDB(restored)
+				for _, pn := range tl.pulses {
+					_, recErr := records.ForID(ctx, tl.records[pn])
+					result, resErr := records.ForRequest(ctx, tl.requests[pn])
+					dr, dropErr := drops.ForPulse(ctx, insolar.ZeroJetID, pn)
+					if pn > snapshot {
+						assert.Error(t, recErr)
+						assert.Error(t, resErr)
+						assert.Error(t, dropErr)
+						continue
+					}
+					assert.NoError(t, recErr)
+					assert.NoError(t, resErr)
+					assert.NoError(t, dropErr)
+					assert.Equal(t, tl.records[pn], result)
+					assert.Equal(t, tl.drops[pn], dr)
+				}
+
+				err = restoredLegacy.View(func(txn store.Txn) error {
+					value, err := txn.Get(storage.GenesisPrefixKey())
+					require.NoError(t, err)
+					assert.Equal(t, []byte("genesis"), value)
+					return nil
+				})
+				require.NoError(t, err)
+			})
+
+			t.Run("refuses mismatched pulse", func(t *testing.T) {
+				restoredLegacy, cleanLegacy := storetest.TmpDB(t, engine)
+				defer cleanLegacy()
+				restored, cleanDB := storetest.TmpDB(t, engine)
+				defer cleanDB()
+
+				_, err := backup.Restore(ctx, bytes.NewReader(archive.Bytes()), restoredLegacy, restored, tl.pulses[2])
+				require.Error(t, err)
+			})
+
+			t.Run("refuses corrupted archive", func(t *testing.T) {
+				restoredLegacy, cleanLegacy := storetest.TmpDB(t, engine)
+				defer cleanLegacy()
+				restored, cleanDB := storetest.TmpDB(t, engine)
+				defer cleanDB()
+
+				broken := append([]byte{}, archive.Bytes()...)
+				broken[len(broken)/2]++
+				_, err := backup.Restore(ctx, bytes.NewReader(broken), restoredLegacy, restored, 0)
+				require.Error(t, err)
+			})
+
+			t.Run("refuses non-empty storage", func(t *testing.T) {
+				_, err := backup.Restore(ctx, bytes.NewReader(archive.Bytes()), legacy, db, 0)
+				assert.Equal(t, backup.ErrNotEmpty, err)
+			})
+		})
+	}
+}
+
+func TestBackupRestore_IndexAsOfSnapshot(t *testing.T) {
+	t.Parallel()
+
+	for _, engine := range store.Engines {
+		engine := engine
+		t.Run(engine, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := inslogger.TestContext(t)
+			legacy, cleanLegacy := storetest.TmpDB(t, engine)
+			defer cleanLegacy()
+			db, cleanDB := storetest.TmpDB(t, engine)
+			defer cleanDB()
+			tl := fillLedger(ctx, t, legacy, db)
+			snapshot := tl.pulses[1]
+
+			records := object.NewRecordDB(db)
+			newID := func(pn insolar.PulseNumber) insolar.ID {
+				id := gen.ID()
+				return *insolar.NewID(pn, id.Hash())
+			}
+			setRecord := func(id insolar.ID, rec record.VirtualRecord) {
+				require.NoError(t, records.Set(ctx, id, record.MaterialRecord{Record: rec, JetID: insolar.ZeroJetID}))
+			}
+
+			objID := newID(tl.pulses[0])
+			activateID := newID(tl.pulses[0])
+			setRecord(activateID, &object.ActivateRecord{})
+			childID := newID(tl.pulses[0])
+			setRecord(childID, &object.ChildRecord{Ref: gen.Reference()})
+			// State and child are written after the snapshot.
+			amendID := newID(tl.pulses[2])
+			setRecord(amendID, &object.AmendRecord{PrevState: activateID})
+			lateChildID := newID(tl.pulses[2])
+			setRecord(lateChildID, &object.ChildRecord{PrevChild: &childID, Ref: gen.Reference()})
+
+			err := object.NewIndexDB(db).Set(ctx, objID, object.Lifeline{
+				LatestState:  &amendID,
+				ChildPointer: &lateChildID,
+				State:        object.StateAmend,
+				LatestUpdate: tl.pulses[2],
+				JetID:        insolar.ZeroJetID,
+			})
+			require.NoError(t, err)
+
+			archive := bytes.NewBuffer(nil)
+			_, err = backup.Backup(ctx, legacy, db, snapshot, archive)
+			require.NoError(t, err)
+
+			restoredLegacy, cleanRestoredLegacy := storetest.TmpDB(t, engine)
+			defer cleanRestoredLegacy()
+			restored, cleanRestored := storetest.TmpDB(t, engine)
+			defer cleanRestored()
+			_, err = backup.Restore(ctx, bytes.NewReader(archive.Bytes()), restoredLegacy, restored, snapshot)
+			require.NoError(t, err)
+
+			idx, err := object.NewIndexDB(restored).ForID(ctx, objID)
+			require.NoError(t, err)
+			assert.Equal(t, &activateID, idx.LatestState)
+			assert.Equal(t, object.StateActivation, idx.State)
+			assert.Equal(t, &childID, idx.ChildPointer)
+			assert.Equal(t, tl.pulses[0], idx.LatestUpdate)
+
+			restoredRecords := object.NewRecordDB(restored)
+			_, err = restoredRecords.ForID(ctx, *idx.LatestState)
+			assert.NoError(t, err)
+			_, err = restoredRecords.ForID(ctx, *idx.ChildPointer)
+			assert.NoError(t, err)
+		})
+	}
+}
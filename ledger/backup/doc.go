@@ -0,0 +1,23 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package backup provides versioned archives with consistent snapshots of heavy material node storages.
+//
+// Archive consists of a header (magic, format version and snapshot pulse), sections and a trailer. Every section
+// holds key-value entries of one kind of ledger data and ends with entries count and SHA-256 of its entries.
+// Trailer holds SHA-256 of the whole archive. Archives are written and read as streams, so they can be piped
+// without temporary files.
+package backup
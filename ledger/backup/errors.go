@@ -0,0 +1,41 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package backup
+
+import (
+	"errors"
+)
+
+var (
+	// ErrBadMagic is returned when stream is not a ledger backup archive.
+	ErrBadMagic = errors.New("not a ledger backup archive")
+
+	// ErrVersion is returned when archive format version is not supported.
+	ErrVersion = errors.New("unsupported backup archive version")
+
+	// ErrChecksum is returned when archive or section checksum doesn't match its content.
+	ErrChecksum = errors.New("backup archive checksum mismatch")
+
+	// ErrCorrupted is returned when archive has unexpected structure.
+	ErrCorrupted = errors.New("backup archive is corrupted")
+
+	// ErrMismatch is returned when archive content doesn't match expected snapshot.
+	ErrMismatch = errors.New("backup archive doesn't match expected snapshot")
+
+	// ErrNotEmpty is returned when restore target storage already has data.
+	ErrNotEmpty = errors.New("restore target storage is not empty")
+)
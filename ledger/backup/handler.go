@@ -0,0 +1,97 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/storage"
+)
+
+// Handler is a heavy node admin endpoint which streams backup archive of running node. It accepts requests only
+// from loopback addresses. Snapshot pulse can be set with "pulse" query parameter, latest pulse is used otherwise.
+type Handler struct {
+	LegacyDB storage.DBContext `inject:""`
+	DB       store.Backend     `inject:""`
+
+	path string
+}
+
+// NewHandler creates backup endpoint served on provided path of API server.
+func NewHandler(path string) *Handler {
+	return &Handler{path: path}
+}
+
+// Start registers endpoint.
+func (h *Handler) Start(ctx context.Context) error {
+	http.Handle(h.path, h)
+	return nil
+}
+
+// ServeHTTP streams backup archive.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := inslogger.ContextWithTrace(r.Context(), "backup")
+	logger := inslogger.FromContext(ctx)
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil || !net.ParseIP(host).IsLoopback() {
+		http.Error(w, "backup is available only from localhost", http.StatusForbidden)
+		return
+	}
+
+	var pn insolar.PulseNumber
+	if p := r.URL.Query().Get("pulse"); p != "" {
+		n, err := strconv.ParseUint(p, 10, 32)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("bad pulse: %s", err), http.StatusBadRequest)
+			return
+		}
+		pn = insolar.PulseNumber(n)
+	}
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	out := &countingWriter{w: w}
+	pn, err = Backup(ctx, h.LegacyDB.GetBackend(), h.DB, pn, out)
+	if err != nil {
+		logger.Error("backup failed: ", err)
+		// If archive is partially sent, client detects failure by broken archive.
+		if out.n == 0 {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+	logger.Infof("backup on pulse %v is done", pn)
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += n
+	return n, err
+}
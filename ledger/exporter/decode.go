@@ -0,0 +1,113 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package exporter
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/ugorji/go/codec"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/record"
+)
+
+var (
+	idType  = reflect.TypeOf(insolar.ID{})
+	refType = reflect.TypeOf(insolar.Reference{})
+	jetType = reflect.TypeOf(insolar.JetID{})
+)
+
+// decodeRecord returns record fields by name. Fields of embedded structs are inlined.
+func decodeRecord(rec record.VirtualRecord) map[string]interface{} {
+	fields := map[string]interface{}{}
+	decodeFields(reflect.Indirect(reflect.ValueOf(rec)), fields)
+	return fields
+}
+
+func decodeFields(v reflect.Value, fields map[string]interface{}) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+		if f.Anonymous && f.Type.Kind() == reflect.Struct {
+			decodeFields(v.Field(i), fields)
+			continue
+		}
+		fields[f.Name] = decodeValue(v.Field(i))
+	}
+}
+
+func decodeValue(v reflect.Value) interface{} {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		return decodeValue(v.Elem())
+	}
+
+	switch v.Type() {
+	case idType:
+		id := v.Interface().(insolar.ID)
+		return id.String()
+	case refType:
+		return v.Interface().(insolar.Reference).String()
+	case jetType:
+		return v.Interface().(insolar.JetID).DebugString()
+	}
+
+	if v.Kind() == reflect.Struct {
+		fields := map[string]interface{}{}
+		decodeFields(v, fields)
+		return fields
+	}
+	return v.Interface()
+}
+
+// decodeMemory decodes CBOR object memory. Memory which is not a valid CBOR is returned as is.
+func decodeMemory(data []byte) interface{} {
+	if len(data) == 0 {
+		return nil
+	}
+
+	var v interface{}
+	err := codec.NewDecoderBytes(data, &codec.CborHandle{}).Decode(&v)
+	if err != nil {
+		return data
+	}
+	return jsonValue(v)
+}
+
+// jsonValue converts decoded CBOR maps to maps with string keys, so value can be marshaled to JSON.
+func jsonValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			m[fmt.Sprint(key)] = jsonValue(val)
+		}
+		return m
+	case []interface{}:
+		for i := range v {
+			v[i] = jsonValue(v[i])
+		}
+		return v
+	}
+	return v
+}
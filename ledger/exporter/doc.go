@@ -0,0 +1,23 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package exporter provides read-only view of heavy material node storage for external consumers like block
+// explorers.
+//
+// Records are exported in storage order (by pulse, then by hash) and are decoded to plain JSON-friendly values:
+// identifiers and references become base58 strings, jets become readable prefixes and object memory is decoded
+// from CBOR. Export is paged by opaque cursors, so consumers can stream the whole ledger with bounded requests.
+package exporter
@@ -0,0 +1,32 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package exporter
+
+import (
+	"errors"
+)
+
+var (
+	// ErrUnavailable is returned when node doesn't keep permanent ledger data.
+	ErrUnavailable = errors.New("export is available only on heavy material nodes")
+
+	// ErrBadCursor is returned when provided cursor can't be parsed.
+	ErrBadCursor = errors.New("invalid export cursor")
+
+	// ErrUnknownType is returned when record type filter contains unknown type name.
+	ErrUnknownType = errors.New("unknown record type")
+)
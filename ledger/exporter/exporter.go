@@ -0,0 +1,342 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package exporter
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/storage/blob"
+	"github.com/insolar/insolar/ledger/storage/object"
+	"github.com/insolar/insolar/ledger/storage/pulse"
+)
+
+const (
+	// DefaultSize is a number of records exported when query doesn't limit it.
+	DefaultSize = 100
+	// MaxSize is a maximum number of records exported at once.
+	MaxSize = 1000
+)
+
+// PulseData is an exported pulse with its records.
+type PulseData struct {
+	Pulse     insolar.PulseNumber
+	Timestamp int64
+	Records   []Record
+}
+
+// Record is an exported record in readable form.
+type Record struct {
+	ID    string
+	Type  string
+	JetID string
+	// Object is the object record belongs to, if record is a request, a result or an object state.
+	Object string `json:",omitempty"`
+	// Data holds record fields.
+	Data map[string]interface{}
+	// Memory holds decoded object memory of activation and amend records.
+	Memory interface{} `json:",omitempty"`
+}
+
+// Exporter exports records of heavy material node storage.
+type Exporter struct {
+	db  store.Backend
+	lag time.Duration
+	now func() time.Time
+}
+
+// NewExporter creates new Exporter. If db is nil, node doesn't keep permanent ledger data and Export always fails
+// with ErrUnavailable.
+func NewExporter(db store.Backend, conf configuration.Exporter) *Exporter {
+	return &Exporter{
+		db:  db,
+		lag: time.Duration(conf.ExportLag) * time.Second,
+		now: time.Now,
+	}
+}
+
+// Export returns records for query pulse range. Result data maps pulse numbers to *PulseData. Pulses younger than
+// configured export lag are not exported. If there are more records in the range, result holds cursor for the next
+// export and the pulse it starts from.
+func (e *Exporter) Export(ctx context.Context, query insolar.StorageExportQuery) (*insolar.StorageExportResult, error) {
+	if e.db == nil {
+		return nil, ErrUnavailable
+	}
+
+	types, err := parseTypes(query.Types)
+	if err != nil {
+		return nil, err
+	}
+
+	size := query.Size
+	if size <= 0 {
+		size = DefaultSize
+	}
+	if size > MaxSize {
+		size = MaxSize
+	}
+
+	start := append(store.ScopeRecord.Bytes(), insolar.NewID(query.From, nil).Bytes()...)
+	if query.Cursor != "" {
+		cursor, err := insolar.NewIDFromBase58(query.Cursor)
+		if err != nil {
+			return nil, ErrBadCursor
+		}
+		// Appended byte makes key follow cursor record but precede any next record.
+		start = append(append(store.ScopeRecord.Bytes(), cursor.Bytes()...), 0)
+	}
+
+	result := &insolar.StorageExportResult{Data: map[string]interface{}{}}
+	err = e.db.View(func(txn store.Txn) error {
+		db := store.TxnDB(txn)
+		pulses := pulse.NewStorageDB(db)
+		last, err := e.lastExportable(ctx, pulses)
+		if err == pulse.ErrNotFound {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		to := query.To
+		if to == 0 || to > last {
+			to = last
+		}
+
+		x := export{
+			ctx:     ctx,
+			query:   query,
+			types:   types,
+			size:    size,
+			to:      to,
+			result:  result,
+			pulses:  pulses,
+			records: object.NewRecordDB(db),
+			blobs:   blob.NewStorageDB(db),
+			objects: map[insolar.ID]insolar.ID{},
+		}
+		return txn.Iterate(store.ScopeRecord.Bytes(), start, x.handle)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to export records")
+	}
+
+	return result, nil
+}
+
+// lastExportable returns the latest pulse which is older than export lag.
+func (e *Exporter) lastExportable(ctx context.Context, pulses *pulse.StorageDB) (insolar.PulseNumber, error) {
+	p, err := pulses.Latest(ctx)
+	if err != nil {
+		return 0, err
+	}
+	border := e.now().Add(-e.lag)
+	for time.Unix(p.PulseTimestamp, 0).After(border) {
+		p, err = pulses.Backwards(ctx, p.PulseNumber, 1)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return p.PulseNumber, nil
+}
+
+// export holds state of a single export.
+type export struct {
+	ctx   context.Context
+	query insolar.StorageExportQuery
+	types map[object.TypeID]struct{}
+	size  int
+	to    insolar.PulseNumber
+
+	result *insolar.StorageExportResult
+	last   *insolar.ID
+
+	pulses  *pulse.StorageDB
+	records *object.RecordDB
+	blobs   *blob.StorageDB
+	// objects caches objects of resolved states.
+	objects map[insolar.ID]insolar.ID
+}
+
+func (x *export) handle(k, v []byte) (bool, error) {
+	var id insolar.ID
+	copy(id[:], k[len(store.ScopeRecord.Bytes()):])
+
+	pn := id.Pulse()
+	if pn > x.to {
+		return false, nil
+	}
+	if x.result.Size == x.size {
+		x.result.NextFrom = &pn
+		x.result.Cursor = x.last.String()
+		return false, nil
+	}
+	x.last = &id
+
+	rec, err := object.DecodeMaterial(v)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to decode record %s", id.String())
+	}
+	if !x.match(rec) {
+		return true, nil
+	}
+
+	out, err := x.exportRecord(id, rec)
+	if err != nil {
+		return false, err
+	}
+
+	key := strconv.FormatUint(uint64(pn), 10)
+	pd, ok := x.result.Data[key].(*PulseData)
+	if !ok {
+		pd = &PulseData{Pulse: pn}
+		p, err := x.pulses.ForPulseNumber(x.ctx, pn)
+		if err != nil && err != pulse.ErrNotFound {
+			return false, err
+		}
+		pd.Timestamp = p.PulseTimestamp
+		x.result.Data[key] = pd
+	}
+	pd.Records = append(pd.Records, out)
+	x.result.Size++
+
+	return true, nil
+}
+
+func (x *export) match(rec record.MaterialRecord) bool {
+	if x.types != nil {
+		if _, ok := x.types[object.TypeFromRecord(rec.Record)]; !ok {
+			return false
+		}
+	}
+	if x.query.JetID != nil {
+		return inJet(rec.JetID, *x.query.JetID)
+	}
+	return true
+}
+
+func (x *export) exportRecord(id insolar.ID, rec record.MaterialRecord) (Record, error) {
+	out := Record{
+		ID:    id.String(),
+		Type:  object.TypeFromRecord(rec.Record).String(),
+		JetID: rec.JetID.DebugString(),
+		Data:  decodeRecord(rec.Record),
+	}
+
+	switch r := rec.Record.(type) {
+	case *object.RequestRecord:
+		out.Object = r.Object.String()
+	case *object.ResultRecord:
+		out.Object = r.Object.String()
+	case object.State:
+		obj, err := x.objectOf(id, r)
+		if err != nil {
+			return out, errors.Wrapf(err, "failed to find object of state %s", id.String())
+		}
+		out.Object = obj.String()
+
+		if r.GetMemory() != nil {
+			b, err := x.blobs.ForID(x.ctx, *r.GetMemory())
+			if err != nil {
+				return out, errors.Wrapf(err, "failed to fetch memory of state %s", id.String())
+			}
+			out.Memory = decodeMemory(b.Value)
+		}
+	}
+
+	return out, nil
+}
+
+// objectOf walks states chain back to object activation record.
+func (x *export) objectOf(id insolar.ID, state object.State) (insolar.ID, error) {
+	var chain []insolar.ID
+	for {
+		if obj, ok := x.objects[id]; ok {
+			x.remember(chain, obj)
+			return obj, nil
+		}
+		chain = append(chain, id)
+		if state.ID() == object.StateActivation {
+			x.remember(chain, id)
+			return id, nil
+		}
+
+		prev := state.PrevStateID()
+		if prev == nil {
+			return id, errors.New("state has no previous state")
+		}
+		rec, err := x.records.ForID(x.ctx, *prev)
+		if err != nil {
+			return id, err
+		}
+		s, ok := rec.Record.(object.State)
+		if !ok {
+			return id, errors.Errorf("previous state %s is not a state record", prev.String())
+		}
+		id, state = *prev, s
+	}
+}
+
+func (x *export) remember(chain []insolar.ID, obj insolar.ID) {
+	for _, id := range chain {
+		x.objects[id] = obj
+	}
+}
+
+// inJet checks if record jet is the jet or one of its children.
+func inJet(recJet, jetID insolar.JetID) bool {
+	recID := insolar.ID(recJet)
+	if recID.Pulse() != insolar.PulseNumberJet {
+		return false
+	}
+	if recJet.Depth() < jetID.Depth() {
+		return false
+	}
+	for recJet.Depth() > jetID.Depth() {
+		recJet = jet.Parent(recJet)
+	}
+	return recJet == jetID
+}
+
+func parseTypes(names []string) (map[object.TypeID]struct{}, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	known := map[string]object.TypeID{}
+	for id := range object.Registered() {
+		known[id.String()] = id
+	}
+
+	types := map[object.TypeID]struct{}{}
+	for _, name := range names {
+		id, ok := known[name]
+		if !ok {
+			return nil, errors.Wrap(ErrUnknownType, name)
+		}
+		types[id] = struct{}{}
+	}
+	return types, nil
+}
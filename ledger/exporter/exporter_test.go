@@ -0,0 +1,233 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package exporter
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/internal/ledger/store/storetest"
+	"github.com/insolar/insolar/ledger/storage/blob"
+	"github.com/insolar/insolar/ledger/storage/object"
+	"github.com/insolar/insolar/ledger/storage/pulse"
+)
+
+type testLedger struct {
+	now    time.Time
+	pulses []insolar.PulseNumber
+
+	activate insolar.ID
+	amend    insolar.ID
+	request  insolar.ID
+	result   insolar.ID
+}
+
+func fillLedger(ctx context.Context, t *testing.T, db store.DB) testLedger {
+	tl := testLedger{now: time.Now()}
+	pulses := pulse.NewStorageDB(db)
+	records := object.NewRecordDB(db)
+	blobs := blob.NewStorageDB(db)
+
+	for i, age := range []time.Duration{100 * time.Second, 90 * time.Second, 0} {
+		pn := insolar.FirstPulseNumber + insolar.PulseNumber(i*10)
+		tl.pulses = append(tl.pulses, pn)
+		require.NoError(t, pulses.Append(ctx, insolar.Pulse{
+			PulseNumber:    pn,
+			PulseTimestamp: tl.now.Add(-age).Unix(),
+		}))
+	}
+
+	newID := func(pn insolar.PulseNumber) insolar.ID {
+		id := gen.ID()
+		return *insolar.NewID(pn, id.Hash())
+	}
+	setRecord := func(id insolar.ID, rec record.VirtualRecord, jetID insolar.JetID) {
+		require.NoError(t, records.Set(ctx, id, record.MaterialRecord{Record: rec, JetID: jetID}))
+	}
+	setMemory := func(pn insolar.PulseNumber, balance int) *insolar.ID {
+		memory, err := insolar.Serialize(struct{ Balance int }{Balance: balance})
+		require.NoError(t, err)
+		id := newID(pn)
+		require.NoError(t, blobs.Set(ctx, id, blob.Blob{Value: memory, JetID: insolar.ZeroJetID}))
+		return &id
+	}
+
+	tl.activate = newID(tl.pulses[0])
+	setRecord(tl.activate, &object.ActivateRecord{
+		StateRecord: object.StateRecord{Memory: setMemory(tl.pulses[0], 100)},
+		Parent:      gen.Reference(),
+	}, jet.NewIDFromString("0"))
+
+	tl.amend = newID(tl.pulses[1])
+	setRecord(tl.amend, &object.AmendRecord{
+		StateRecord: object.StateRecord{Memory: setMemory(tl.pulses[1], 90)},
+		PrevState:   tl.activate,
+	}, jet.NewIDFromString("1"))
+
+	tl.request = newID(tl.pulses[1])
+	setRecord(tl.request, &object.RequestRecord{Object: tl.activate}, jet.NewIDFromString("01"))
+
+	tl.result = newID(tl.pulses[1])
+	setRecord(tl.result, &object.ResultRecord{Object: tl.activate}, jet.NewIDFromString("1"))
+
+	// Too young to be exported.
+	setRecord(newID(tl.pulses[2]), &object.ResultRecord{}, jet.NewIDFromString("1"))
+
+	return tl
+}
+
+func newTestExporter(t *testing.T) (*Exporter, testLedger, func()) {
+	ctx := inslogger.TestContext(t)
+	db, clean := storetest.TmpDB(t, store.EngineBadger)
+	tl := fillLedger(ctx, t, db)
+
+	e := NewExporter(db, configuration.Exporter{ExportLag: 40})
+	e.now = func() time.Time { return tl.now }
+	return e, tl, clean
+}
+
+func exported(result *insolar.StorageExportResult) map[string]Record {
+	res := map[string]Record{}
+	for _, data := range result.Data {
+		for _, rec := range data.(*PulseData).Records {
+			res[rec.ID] = rec
+		}
+	}
+	return res
+}
+
+func TestExporter_Export(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+	e, tl, clean := newTestExporter(t)
+	defer clean()
+
+	result, err := e.Export(ctx, insolar.StorageExportQuery{From: tl.pulses[0]})
+	require.NoError(t, err)
+	assert.Equal(t, 4, result.Size)
+	assert.Nil(t, result.NextFrom)
+	assert.Empty(t, result.Cursor)
+	require.Len(t, result.Data, 2)
+
+	pd := result.Data[strconv.Itoa(int(tl.pulses[1]))].(*PulseData)
+	assert.Equal(t, tl.pulses[1], pd.Pulse)
+	assert.Equal(t, tl.now.Add(-90*time.Second).Unix(), pd.Timestamp)
+
+	records := exported(result)
+	require.Len(t, records, 4)
+
+	activate := records[tl.activate.String()]
+	assert.Equal(t, "ActivateRecord", activate.Type)
+	assert.Equal(t, "[JET 1 0]", activate.JetID)
+	assert.Equal(t, tl.activate.String(), activate.Object)
+	assert.Equal(t, map[string]interface{}{"Balance": uint64(100)}, activate.Memory)
+	assert.Contains(t, activate.Data, "Parent")
+	assert.Contains(t, activate.Data, "Memory")
+
+	amend := records[tl.amend.String()]
+	assert.Equal(t, "AmendRecord", amend.Type)
+	assert.Equal(t, tl.activate.String(), amend.Object)
+	assert.Equal(t, tl.activate.String(), amend.Data["PrevState"])
+	assert.Equal(t, map[string]interface{}{"Balance": uint64(90)}, amend.Memory)
+
+	assert.Equal(t, tl.activate.String(), records[tl.request.String()].Object)
+	assert.Equal(t, tl.activate.String(), records[tl.result.String()].Object)
+}
+
+func TestExporter_Export_Cursor(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+	e, tl, clean := newTestExporter(t)
+	defer clean()
+
+	records := map[string]Record{}
+	query := insolar.StorageExportQuery{From: tl.pulses[0], Size: 1}
+	for i := 0; i < 4; i++ {
+		result, err := e.Export(ctx, query)
+		require.NoError(t, err)
+		require.Equal(t, 1, result.Size)
+		for id, rec := range exported(result) {
+			records[id] = rec
+		}
+
+		if i < 3 {
+			require.NotNil(t, result.NextFrom)
+			require.NotEmpty(t, result.Cursor)
+		} else {
+			assert.Nil(t, result.NextFrom)
+			assert.Empty(t, result.Cursor)
+		}
+		query.Cursor = result.Cursor
+	}
+	assert.Len(t, records, 4)
+
+	_, err := e.Export(ctx, insolar.StorageExportQuery{Cursor: "not a cursor"})
+	assert.Equal(t, ErrBadCursor, err)
+}
+
+func TestExporter_Export_Filters(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+	e, tl, clean := newTestExporter(t)
+	defer clean()
+
+	jetID := jet.NewIDFromString("0")
+	result, err := e.Export(ctx, insolar.StorageExportQuery{JetID: &jetID})
+	require.NoError(t, err)
+	records := exported(result)
+	assert.Len(t, records, 2)
+	assert.Contains(t, records, tl.activate.String())
+	assert.Contains(t, records, tl.request.String())
+
+	result, err = e.Export(ctx, insolar.StorageExportQuery{Types: []string{"AmendRecord", "ResultRecord"}})
+	require.NoError(t, err)
+	records = exported(result)
+	assert.Len(t, records, 2)
+	assert.Contains(t, records, tl.amend.String())
+	assert.Contains(t, records, tl.result.String())
+
+	result, err = e.Export(ctx, insolar.StorageExportQuery{From: tl.pulses[1], To: tl.pulses[1]})
+	require.NoError(t, err)
+	assert.Len(t, exported(result), 3)
+
+	_, err = e.Export(ctx, insolar.StorageExportQuery{Types: []string{"UnknownRecord"}})
+	assert.Equal(t, ErrUnknownType, errors.Cause(err))
+}
+
+func TestExporter_Export_Unavailable(t *testing.T) {
+	t.Parallel()
+
+	e := NewExporter(nil, configuration.Exporter{})
+	_, err := e.Export(inslogger.TestContext(t), insolar.StorageExportQuery{})
+	assert.Equal(t, ErrUnavailable, err)
+}
@@ -0,0 +1,24 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package fsck verifies consistency of heavy material node storage.
+//
+// Check walks storage in a single read-only transaction, so it can be run on a stopped node storage as well as on
+// a running node. It verifies that drops of every jet form an unbroken hash chain, that drops of every pulse cover
+// the whole id space with jets, that drop Merkle roots match drop records, that lifelines point at existing state
+// records and that blobs referenced by state records exist. Every found inconsistency is reported with its pulse,
+// jet and id.
+package fsck
@@ -0,0 +1,453 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package fsck
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/ugorji/go/codec"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/storage/drop"
+	"github.com/insolar/insolar/ledger/storage/object"
+)
+
+// Kind is a kind of storage inconsistency.
+type Kind string
+
+const (
+	// KindCorrupted is reported for values which can't be decoded.
+	KindCorrupted Kind = "corrupted"
+	// KindDropChain is reported when drop has no previous drop, has no hashes or its PrevHash doesn't match previous
+	// drop Hash.
+	KindDropChain Kind = "drop_chain"
+	// KindMerkleRoot is reported when drop Merkle root doesn't match drop records.
+	KindMerkleRoot Kind = "merkle_root"
+	// KindJetNotCovered is reported for a jet which has no drop while its siblings have.
+	KindJetNotCovered Kind = "jet_not_covered"
+	// KindJetOverlap is reported for a drop whose jet overlaps with jets of other drops of the same pulse.
+	KindJetOverlap Kind = "jet_overlap"
+	// KindMissingState is reported when lifeline points at missing record or at record which is not a state.
+	KindMissingState Kind = "missing_state"
+	// KindMissingChild is reported when lifeline child pointer points at missing record.
+	KindMissingChild Kind = "missing_child"
+	// KindMissingBlob is reported when state record memory is missing.
+	KindMissingBlob Kind = "missing_blob"
+)
+
+// Problem is a found storage inconsistency.
+type Problem struct {
+	Kind  Kind
+	Pulse insolar.PulseNumber
+	// JetID is empty if jet of inconsistent value is unknown.
+	JetID insolar.JetID
+	// ID is an id of inconsistent record or lifeline, it's nil for drop and jet problems.
+	ID      *insolar.ID
+	Details string
+}
+
+// String returns human readable problem description.
+func (p Problem) String() string {
+	jetID, id := "-", "-"
+	if p.JetID != (insolar.JetID{}) {
+		jetID = p.JetID.DebugString()
+	}
+	if p.ID != nil {
+		id = p.ID.String()
+	}
+	return fmt.Sprintf("%s: pulse %v jet %s id %s: %s", p.Kind, p.Pulse, jetID, id, p.Details)
+}
+
+// Report is a result of storage check.
+type Report struct {
+	Pulses    int
+	Drops     int
+	Records   int
+	Lifelines int
+	Problems  []Problem
+}
+
+// OK returns true if no problems are found.
+func (r *Report) OK() bool {
+	return len(r.Problems) == 0
+}
+
+// Check verifies consistency of heavy node storage db in a single read-only transaction. Error is returned only if
+// storage can't be read, inconsistencies are returned in report.
+func Check(ctx context.Context, db store.Backend, scheme insolar.PlatformCryptographyScheme) (*Report, error) {
+	report := &Report{}
+	err := db.View(func(txn store.Txn) error {
+		c := &checker{
+			ctx:    ctx,
+			txn:    txn,
+			scheme: scheme,
+			report: report,
+		}
+		return c.check()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+type checker struct {
+	ctx    context.Context
+	txn    store.Txn
+	scheme insolar.PlatformCryptographyScheme
+	report *Report
+}
+
+func (c *checker) problem(p Problem) {
+	c.report.Problems = append(c.report.Problems, p)
+}
+
+func (c *checker) check() error {
+	if err := c.checkDrops(); err != nil {
+		return errors.Wrap(err, "failed to check drops")
+	}
+	if err := c.checkRecords(); err != nil {
+		return errors.Wrap(err, "failed to check records")
+	}
+	if err := c.checkLifelines(); err != nil {
+		return errors.Wrap(err, "failed to check lifelines")
+	}
+	return nil
+}
+
+func (c *checker) checkDrops() error {
+	drops := map[insolar.PulseNumber][]drop.Drop{}
+	err := c.txn.Iterate(store.ScopeJetDrop.Bytes(), nil, func(k, v []byte) (bool, error) {
+		c.report.Drops++
+		dr, err := drop.Decode(v)
+		if err != nil || !validJet(dr.JetID) {
+			var pn insolar.PulseNumber
+			if len(k) >= insolar.PulseNumberSize {
+				pn = insolar.NewPulseNumber(k[len(k)-insolar.PulseNumberSize:])
+			}
+			c.problem(Problem{Kind: KindCorrupted, Pulse: pn, Details: fmt.Sprintf("failed to decode drop %x", k)})
+			return true, nil
+		}
+		drops[dr.Pulse] = append(drops[dr.Pulse], *dr)
+		return true, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	pulses := make([]insolar.PulseNumber, 0, len(drops))
+	for pn := range drops {
+		pulses = append(pulses, pn)
+	}
+	sort.Slice(pulses, func(i, j int) bool { return pulses[i] < pulses[j] })
+	c.report.Pulses = len(pulses)
+
+	for i, pn := range pulses {
+		c.checkJets(pn, drops[pn])
+		for _, dr := range drops[pn] {
+			c.checkMerkleRoot(dr)
+			// Chain of the first stored pulse starts before the storage.
+			if i > 0 {
+				c.checkChain(dr, pulses[i-1], drops[pulses[i-1]])
+			}
+		}
+	}
+	return nil
+}
+
+// checkJets verifies that jets of pulse drops are leaves of a single jet tree.
+func (c *checker) checkJets(pn insolar.PulseNumber, drops []drop.Drop) {
+	tree := jet.NewTree(false)
+	jets := map[insolar.JetID]bool{}
+	for _, dr := range drops {
+		jets[dr.JetID] = true
+		tree.Update(dr.JetID, false)
+	}
+
+	leaves := map[insolar.JetID]bool{}
+	for _, id := range tree.LeafIDs() {
+		leaves[id] = true
+		if !jets[id] {
+			c.problem(Problem{Kind: KindJetNotCovered, Pulse: pn, JetID: id, Details: "jet has no drop"})
+		}
+	}
+	for _, dr := range drops {
+		if !leaves[dr.JetID] {
+			c.problem(Problem{
+				Kind:    KindJetOverlap,
+				Pulse:   pn,
+				JetID:   dr.JetID,
+				Details: "drop jet is a parent of other drop jets",
+			})
+		}
+	}
+}
+
+// checkChain verifies that drop continues the chain of the drop of the same jet (or of its parent jet if the jet
+// was split) from the previous pulse.
+func (c *checker) checkChain(dr drop.Drop, prevPulse insolar.PulseNumber, prevDrops []drop.Drop) {
+	var prev []drop.Drop
+	for _, p := range prevDrops {
+		if isAncestor(p.JetID, dr.JetID) || isAncestor(dr.JetID, p.JetID) {
+			prev = append(prev, p)
+		}
+	}
+
+	if len(dr.Hash) == 0 || len(dr.PrevHash) == 0 {
+		c.problem(Problem{
+			Kind:    KindDropChain,
+			Pulse:   dr.Pulse,
+			JetID:   dr.JetID,
+			Details: "drop has no hash or previous hash",
+		})
+		return
+	}
+
+	switch len(prev) {
+	case 0:
+		c.problem(Problem{
+			Kind:    KindDropChain,
+			Pulse:   dr.Pulse,
+			JetID:   dr.JetID,
+			Details: fmt.Sprintf("no drop of the jet in previous pulse %v", prevPulse),
+		})
+	case 1:
+		if !bytes.Equal(dr.PrevHash, prev[0].Hash) {
+			c.problem(Problem{
+				Kind:    KindDropChain,
+				Pulse:   dr.Pulse,
+				JetID:   dr.JetID,
+				Details: fmt.Sprintf("previous hash doesn't match hash of drop %s", prev[0].JetID.DebugString()),
+			})
+		}
+	default:
+		c.checkMergedChain(dr, prev)
+	}
+}
+
+// checkMergedChain verifies that drop of merged jet continues chains of drops of both its children. Previous hash of
+// such drop is a chain hash of hashes of the left and the right child drops.
+func (c *checker) checkMergedChain(dr drop.Drop, prev []drop.Drop) {
+	leftJet, rightJet := jet.Siblings(dr.JetID)
+	var left, right *drop.Drop
+	for i := range prev {
+		switch prev[i].JetID {
+		case leftJet:
+			left = &prev[i]
+		case rightJet:
+			right = &prev[i]
+		}
+	}
+
+	if len(prev) != 2 || left == nil || right == nil {
+		c.problem(Problem{
+			Kind:    KindDropChain,
+			Pulse:   dr.Pulse,
+			JetID:   dr.JetID,
+			Details: fmt.Sprintf("drop continues %d drops instead of drops of two child jets", len(prev)),
+		})
+		return
+	}
+	if !bytes.Equal(dr.PrevHash, drop.ChainHash(c.scheme, left.Hash, right.Hash)) {
+		c.problem(Problem{
+			Kind:    KindDropChain,
+			Pulse:   dr.Pulse,
+			JetID:   dr.JetID,
+			Details: "previous hash doesn't match hashes of drops of merged jets",
+		})
+	}
+}
+
+func (c *checker) checkMerkleRoot(dr drop.Drop) {
+	// Drops created before Merkle roots were introduced have no root.
+	if len(dr.MerkleRoot) == 0 {
+		return
+	}
+	ids, err := object.NewRecordDB(store.TxnDB(c.txn)).ForDrop(c.ctx, dr.JetID, dr.Pulse)
+	if err != nil {
+		c.problem(Problem{
+			Kind:    KindCorrupted,
+			Pulse:   dr.Pulse,
+			JetID:   dr.JetID,
+			Details: fmt.Sprintf("failed to read drop records: %s", err),
+		})
+		return
+	}
+	if !bytes.Equal(drop.RecordsRoot(c.scheme, ids), dr.MerkleRoot) {
+		c.problem(Problem{
+			Kind:    KindMerkleRoot,
+			Pulse:   dr.Pulse,
+			JetID:   dr.JetID,
+			Details: fmt.Sprintf("merkle root doesn't match %d drop records", len(ids)),
+		})
+	}
+}
+
+func (c *checker) checkRecords() error {
+	return c.txn.Iterate(store.ScopeRecord.Bytes(), nil, func(k, v []byte) (bool, error) {
+		c.report.Records++
+		id, ok := keyID(k)
+		if !ok {
+			c.problem(Problem{Kind: KindCorrupted, Details: fmt.Sprintf("unexpected record key %x", k)})
+			return true, nil
+		}
+		rec, err := decodeRecord(v)
+		if err != nil {
+			c.problem(Problem{Kind: KindCorrupted, Pulse: id.Pulse(), ID: &id, Details: "failed to decode record"})
+			return true, nil
+		}
+
+		state, ok := rec.Record.(object.State)
+		if !ok || state.GetMemory() == nil {
+			return true, nil
+		}
+		_, err = c.txn.Get(append(store.ScopeBlob.Bytes(), state.GetMemory()[:]...))
+		if err == store.ErrNotFound {
+			c.problem(Problem{
+				Kind:    KindMissingBlob,
+				Pulse:   id.Pulse(),
+				JetID:   rec.JetID,
+				ID:      &id,
+				Details: fmt.Sprintf("state memory %s is missing", state.GetMemory()),
+			})
+			return true, nil
+		}
+		return err == nil, err
+	})
+}
+
+func (c *checker) checkLifelines() error {
+	return c.txn.Iterate(store.ScopeIndex.Bytes(), nil, func(k, v []byte) (bool, error) {
+		c.report.Lifelines++
+		id, ok := keyID(k)
+		if !ok {
+			c.problem(Problem{Kind: KindCorrupted, Details: fmt.Sprintf("unexpected lifeline key %x", k)})
+			return true, nil
+		}
+		var idx object.Lifeline
+		err := codec.NewDecoderBytes(v, &codec.CborHandle{}).Decode(&idx)
+		if err != nil {
+			c.problem(Problem{Kind: KindCorrupted, Pulse: id.Pulse(), ID: &id, Details: "failed to decode lifeline"})
+			return true, nil
+		}
+
+		report := func(kind Kind, format string, args ...interface{}) {
+			c.problem(Problem{
+				Kind:    kind,
+				Pulse:   idx.LatestUpdate,
+				JetID:   idx.JetID,
+				ID:      &id,
+				Details: fmt.Sprintf(format, args...),
+			})
+		}
+		for _, s := range []struct {
+			name string
+			id   *insolar.ID
+		}{
+			{"latest state", idx.LatestState},
+			{"latest approved state", idx.LatestStateApproved},
+		} {
+			if s.id == nil {
+				continue
+			}
+			rec, found, err := c.record(*s.id)
+			if err != nil {
+				return false, err
+			}
+			if !found {
+				report(KindMissingState, "%s %s is missing", s.name, s.id)
+				continue
+			}
+			if _, ok := rec.(object.State); rec != nil && !ok {
+				report(KindMissingState, "%s %s is not a state record", s.name, s.id)
+			}
+		}
+		if idx.ChildPointer != nil {
+			_, found, err := c.record(*idx.ChildPointer)
+			if err != nil {
+				return false, err
+			}
+			if !found {
+				report(KindMissingChild, "child pointer %s is missing", idx.ChildPointer)
+			}
+		}
+		return true, nil
+	})
+}
+
+// record returns virtual record stored with provided id. Returned record is nil if it can't be decoded, such records
+// are reported by checkRecords.
+func (c *checker) record(id insolar.ID) (record.VirtualRecord, bool, error) {
+	v, err := c.txn.Get(append(store.ScopeRecord.Bytes(), id[:]...))
+	if err == store.ErrNotFound {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	rec, err := decodeRecord(v)
+	if err != nil {
+		return nil, true, nil
+	}
+	return rec.Record, true, nil
+}
+
+// decodeRecord decodes stored material record. Unknown record types make decoder panic, it's reported as an error.
+func decodeRecord(v []byte) (rec record.MaterialRecord, err error) {
+	if len(v) < insolar.RecordIDSize+object.TypeIDSize {
+		return rec, errors.New("record is too short")
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to decode record: %v", r)
+		}
+	}()
+	return object.DecodeMaterial(v)
+}
+
+func keyID(k []byte) (insolar.ID, bool) {
+	var id insolar.ID
+	if len(k) != 1+insolar.RecordIDSize {
+		return id, false
+	}
+	copy(id[:], k[1:])
+	return id, true
+}
+
+// validJet returns true if id is a jet id, other ids make jet methods panic.
+func validJet(id insolar.JetID) bool {
+	recordID := insolar.ID(id)
+	return recordID.Pulse() == insolar.PulseNumberJet
+}
+
+// isAncestor returns true if jet a is jet b or one of its parents.
+func isAncestor(a, b insolar.JetID) bool {
+	if a.Depth() > b.Depth() {
+		return false
+	}
+	for b.Depth() > a.Depth() {
+		b = jet.Parent(b)
+	}
+	return a == b
+}
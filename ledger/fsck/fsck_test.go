@@ -0,0 +1,285 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package fsck_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/internal/ledger/store/storetest"
+	"github.com/insolar/insolar/ledger/fsck"
+	"github.com/insolar/insolar/ledger/storage/blob"
+	"github.com/insolar/insolar/ledger/storage/drop"
+	"github.com/insolar/insolar/ledger/storage/object"
+	"github.com/insolar/insolar/platformpolicy"
+)
+
+var (
+	firstPulse  = insolar.PulseNumber(insolar.FirstPulseNumber)
+	secondPulse = firstPulse + 10
+	thirdPulse  = secondPulse + 10
+	leftJet     = jet.NewIDFromString("0")
+	rightJet    = jet.NewIDFromString("1")
+)
+
+type testLedger struct {
+	records *object.RecordDB
+	blobs   *blob.StorageDB
+	indexes *object.IndexDB
+	drops   drop.Modifier
+}
+
+func newTestLedger(db store.DB) testLedger {
+	return testLedger{
+		records: object.NewRecordDB(db),
+		blobs:   blob.NewStorageDB(db),
+		indexes: object.NewIndexDB(db),
+		drops:   drop.NewStorageDB(db),
+	}
+}
+
+// addState saves activation record with memory in provided jet and returns its id. Blob is not saved if withBlob
+// is false.
+func (tl testLedger) addState(
+	ctx context.Context, t *testing.T, pn insolar.PulseNumber, jetID insolar.JetID, withBlob bool,
+) insolar.ID {
+	memory := gen.ID()
+	memory = *insolar.NewID(pn, memory.Hash())
+	if withBlob {
+		require.NoError(t, tl.blobs.Set(ctx, memory, blob.Blob{Value: []byte{1, 2, 3}, JetID: jetID}))
+	}
+
+	id := gen.ID()
+	id = *insolar.NewID(pn, id.Hash())
+	rec := &object.ActivateRecord{StateRecord: object.StateRecord{Memory: &memory}}
+	require.NoError(t, tl.records.Set(ctx, id, record.MaterialRecord{Record: rec, JetID: jetID}))
+	return id
+}
+
+// fill saves a consistent ledger: zero jet in the first pulse is split in the second pulse, the left jet has
+// an object.
+func (tl testLedger) fill(ctx context.Context, t *testing.T, scheme insolar.PlatformCryptographyScheme) {
+	require.NoError(t, tl.drops.Set(ctx, drop.Drop{Pulse: firstPulse, JetID: insolar.ZeroJetID, Hash: []byte{1}}))
+
+	state := tl.addState(ctx, t, secondPulse, leftJet, true)
+	obj := gen.ID()
+	require.NoError(t, tl.indexes.Set(ctx, obj, object.Lifeline{
+		LatestState:  &state,
+		LatestUpdate: secondPulse,
+		JetID:        leftJet,
+	}))
+
+	require.NoError(t, tl.drops.Set(ctx, drop.Drop{
+		Pulse:      secondPulse,
+		JetID:      leftJet,
+		PrevHash:   []byte{1},
+		Hash:       []byte{2},
+		MerkleRoot: drop.RecordsRoot(scheme, []insolar.ID{state}),
+	}))
+	require.NoError(t, tl.drops.Set(ctx, drop.Drop{
+		Pulse:    secondPulse,
+		JetID:    rightJet,
+		PrevHash: []byte{1},
+		Hash:     []byte{3},
+	}))
+}
+
+func problemKeys(problems []fsck.Problem) []string {
+	keys := make([]string, 0, len(problems))
+	for _, p := range problems {
+		keys = append(keys, fmt.Sprintf("%s %v %s", p.Kind, p.Pulse, p.JetID.DebugString()))
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	scheme := platformpolicy.NewPlatformCryptographyScheme()
+	for _, engine := range store.Engines {
+		engine := engine
+		t.Run(engine, func(t *testing.T) {
+			t.Parallel()
+
+			t.Run("consistent ledger", func(t *testing.T) {
+				ctx := inslogger.TestContext(t)
+				db, clean := storetest.TmpDB(t, engine)
+				defer clean()
+				newTestLedger(db).fill(ctx, t, scheme)
+
+				report, err := fsck.Check(ctx, db, scheme)
+				require.NoError(t, err)
+				assert.Empty(t, report.Problems)
+				assert.True(t, report.OK())
+				assert.Equal(t, 2, report.Pulses)
+				assert.Equal(t, 3, report.Drops)
+				assert.Equal(t, 1, report.Records)
+				assert.Equal(t, 1, report.Lifelines)
+			})
+
+			t.Run("reports drops without hashes", func(t *testing.T) {
+				ctx := inslogger.TestContext(t)
+				db, clean := storetest.TmpDB(t, engine)
+				defer clean()
+				tl := newTestLedger(db)
+				tl.fill(ctx, t, scheme)
+
+				require.NoError(t, tl.drops.Set(ctx, drop.Drop{Pulse: thirdPulse, JetID: leftJet}))
+				require.NoError(t, tl.drops.Set(ctx, drop.Drop{Pulse: thirdPulse, JetID: rightJet, PrevHash: []byte{3}}))
+
+				report, err := fsck.Check(ctx, db, scheme)
+				require.NoError(t, err)
+				assert.Equal(t, []string{
+					fmt.Sprintf("drop_chain %v %s", thirdPulse, leftJet.DebugString()),
+					fmt.Sprintf("drop_chain %v %s", thirdPulse, rightJet.DebugString()),
+				}, problemKeys(report.Problems))
+			})
+
+			t.Run("reports inconsistencies", func(t *testing.T) {
+				ctx := inslogger.TestContext(t)
+				db, clean := storetest.TmpDB(t, engine)
+				defer clean()
+				tl := newTestLedger(db)
+				tl.fill(ctx, t, scheme)
+
+				// Record without blob is added after its drop is created.
+				tl.addState(ctx, t, secondPulse, leftJet, false)
+				// Lifeline points at missing state.
+				missing := gen.ID()
+				obj := gen.ID()
+				require.NoError(t, tl.indexes.Set(ctx, obj, object.Lifeline{
+					LatestState:  &missing,
+					ChildPointer: &missing,
+					LatestUpdate: thirdPulse,
+					JetID:        rightJet,
+				}))
+				// Third pulse has a drop only for the left jet and its chain is broken.
+				require.NoError(t, tl.drops.Set(ctx, drop.Drop{
+					Pulse:    thirdPulse,
+					JetID:    leftJet,
+					PrevHash: []byte{42},
+					Hash:     []byte{4},
+				}))
+
+				report, err := fsck.Check(ctx, db, scheme)
+				require.NoError(t, err)
+				assert.False(t, report.OK())
+				assert.Equal(t, []string{
+					fmt.Sprintf("drop_chain %v %s", thirdPulse, leftJet.DebugString()),
+					fmt.Sprintf("jet_not_covered %v %s", thirdPulse, rightJet.DebugString()),
+					fmt.Sprintf("merkle_root %v %s", secondPulse, leftJet.DebugString()),
+					fmt.Sprintf("missing_blob %v %s", secondPulse, leftJet.DebugString()),
+					fmt.Sprintf("missing_child %v %s", thirdPulse, rightJet.DebugString()),
+					fmt.Sprintf("missing_state %v %s", thirdPulse, rightJet.DebugString()),
+				}, problemKeys(report.Problems))
+
+				buf := bytes.NewBuffer(nil)
+				require.NoError(t, report.WriteJSON(buf))
+				var decoded struct {
+					Problems []struct {
+						Kind  string `json:"kind"`
+						Pulse uint32 `json:"pulse"`
+						ID    string `json:"id"`
+					} `json:"problems"`
+				}
+				require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+				require.Len(t, decoded.Problems, len(report.Problems))
+				for _, p := range decoded.Problems {
+					if p.Kind == string(fsck.KindMissingState) {
+						assert.Equal(t, obj.String(), p.ID)
+						assert.Equal(t, uint32(thirdPulse), p.Pulse)
+					}
+				}
+			})
+
+			t.Run("checks chain of merged jets", func(t *testing.T) {
+				ctx := inslogger.TestContext(t)
+				db, clean := storetest.TmpDB(t, engine)
+				defer clean()
+				tl := newTestLedger(db)
+				tl.fill(ctx, t, scheme)
+
+				require.NoError(t, tl.drops.Set(ctx, drop.Drop{
+					Pulse:    thirdPulse,
+					JetID:    insolar.ZeroJetID,
+					PrevHash: drop.ChainHash(scheme, []byte{2}, []byte{3}),
+					Hash:     []byte{4},
+				}))
+				report, err := fsck.Check(ctx, db, scheme)
+				require.NoError(t, err)
+				assert.Empty(t, report.Problems)
+
+				fourthPulse := thirdPulse + 10
+				require.NoError(t, tl.drops.Set(ctx, drop.Drop{
+					Pulse:    fourthPulse,
+					JetID:    leftJet,
+					PrevHash: []byte{4},
+					Hash:     []byte{5},
+				}))
+				require.NoError(t, tl.drops.Set(ctx, drop.Drop{
+					Pulse:    fourthPulse,
+					JetID:    rightJet,
+					PrevHash: []byte{4},
+					Hash:     []byte{6},
+				}))
+				// Hashes of merged drops are chained in wrong order.
+				require.NoError(t, tl.drops.Set(ctx, drop.Drop{
+					Pulse:    fourthPulse + 10,
+					JetID:    insolar.ZeroJetID,
+					PrevHash: drop.ChainHash(scheme, []byte{6}, []byte{5}),
+					Hash:     []byte{7},
+				}))
+				report, err = fsck.Check(ctx, db, scheme)
+				require.NoError(t, err)
+				assert.Equal(t, []string{
+					fmt.Sprintf("drop_chain %v %s", fourthPulse+10, insolar.ZeroJetID.DebugString()),
+				}, problemKeys(report.Problems))
+			})
+
+			t.Run("reports overlapping jets", func(t *testing.T) {
+				ctx := inslogger.TestContext(t)
+				db, clean := storetest.TmpDB(t, engine)
+				defer clean()
+				drops := drop.NewStorageDB(db)
+				require.NoError(t, drops.Set(ctx, drop.Drop{Pulse: firstPulse, JetID: leftJet}))
+				require.NoError(t, drops.Set(ctx, drop.Drop{Pulse: firstPulse, JetID: rightJet}))
+				require.NoError(t, drops.Set(ctx, drop.Drop{Pulse: firstPulse, JetID: jet.NewIDFromString("11")}))
+
+				report, err := fsck.Check(ctx, db, scheme)
+				require.NoError(t, err)
+				assert.Equal(t, []string{
+					fmt.Sprintf("jet_not_covered %v %s", firstPulse, jet.NewIDFromString("10").DebugString()),
+					fmt.Sprintf("jet_overlap %v %s", firstPulse, rightJet.DebugString()),
+				}, problemKeys(report.Problems))
+			})
+		})
+	}
+}
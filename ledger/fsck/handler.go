@@ -0,0 +1,78 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package fsck
+
+import (
+	"context"
+	"net"
+	"net/http"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+)
+
+// Handler is a heavy node admin endpoint which checks storage of running node. It accepts requests only from
+// loopback addresses. Report is written as JSON, "format=text" query parameter selects human readable report.
+type Handler struct {
+	DB  store.Backend                      `inject:""`
+	PCS insolar.PlatformCryptographyScheme `inject:""`
+
+	path string
+}
+
+// NewHandler creates check endpoint served on provided path of API server.
+func NewHandler(path string) *Handler {
+	return &Handler{path: path}
+}
+
+// Start registers endpoint.
+func (h *Handler) Start(ctx context.Context) error {
+	http.Handle(h.path, h)
+	return nil
+}
+
+// ServeHTTP checks storage and writes report.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := inslogger.ContextWithTrace(r.Context(), "fsck")
+	logger := inslogger.FromContext(ctx)
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil || !net.ParseIP(host).IsLoopback() {
+		http.Error(w, "storage check is available only from localhost", http.StatusForbidden)
+		return
+	}
+
+	report, err := Check(ctx, h.DB, h.PCS)
+	if err != nil {
+		logger.Error("storage check failed: ", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	logger.Infof("storage check is done, %d problems found", len(report.Problems))
+
+	if r.URL.Query().Get("format") == "text" {
+		w.Header().Set("Content-Type", "text/plain")
+		err = report.WriteText(w)
+	} else {
+		w.Header().Set("Content-Type", "application/json")
+		err = report.WriteJSON(w)
+	}
+	if err != nil {
+		logger.Error("failed to write storage check report: ", err)
+	}
+}
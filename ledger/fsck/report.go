@@ -0,0 +1,86 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package fsck
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/insolar/insolar/insolar"
+)
+
+// WriteText writes human readable report, one problem per line followed by a summary.
+func (r *Report) WriteText(w io.Writer) error {
+	for _, p := range r.Problems {
+		if _, err := fmt.Fprintln(w, p.String()); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintf(
+		w,
+		"checked %d pulses, %d drops, %d records, %d lifelines: %d problems\n",
+		r.Pulses, r.Drops, r.Records, r.Lifelines, len(r.Problems),
+	)
+	return err
+}
+
+type jsonProblem struct {
+	Kind    Kind   `json:"kind"`
+	Pulse   uint32 `json:"pulse"`
+	JetID   string `json:"jet_id,omitempty"`
+	ID      string `json:"id,omitempty"`
+	Details string `json:"details"`
+}
+
+type jsonReport struct {
+	Pulses    int           `json:"pulses"`
+	Drops     int           `json:"drops"`
+	Records   int           `json:"records"`
+	Lifelines int           `json:"lifelines"`
+	Problems  []jsonProblem `json:"problems"`
+}
+
+// WriteJSON writes machine readable report. Jets and ids are base58 encoded.
+func (r *Report) WriteJSON(w io.Writer) error {
+	out := jsonReport{
+		Pulses:    r.Pulses,
+		Drops:     r.Drops,
+		Records:   r.Records,
+		Lifelines: r.Lifelines,
+		Problems:  make([]jsonProblem, 0, len(r.Problems)),
+	}
+	for _, p := range r.Problems {
+		jp := jsonProblem{
+			Kind:    p.Kind,
+			Pulse:   uint32(p.Pulse),
+			Details: p.Details,
+		}
+		if p.JetID != (insolar.JetID{}) {
+			jetID := insolar.ID(p.JetID)
+			jp.JetID = jetID.String()
+		}
+		if p.ID != nil {
+			jp.ID = p.ID.String()
+		}
+		out.Problems = append(out.Problems, jp)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
@@ -16,10 +16,17 @@
 
 package heavy
 
-import "github.com/insolar/insolar/ledger/heavy/internal/handler"
+import (
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/ledger/backup"
+	"github.com/insolar/insolar/ledger/fsck"
+	"github.com/insolar/insolar/ledger/heavy/internal/handler"
+)
 
-func Components() []interface{} {
+func Components(conf configuration.Ledger) []interface{} {
 	return []interface{}{
 		handler.New(),
+		backup.NewHandler(conf.Backup.Endpoint),
+		fsck.NewHandler(conf.Fsck.Endpoint),
 	}
 }
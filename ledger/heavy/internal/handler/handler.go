@@ -17,6 +17,7 @@
 package handler
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 
@@ -26,8 +27,11 @@ import (
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/message"
 	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/internal/ledger/store"
 	"github.com/insolar/insolar/ledger/storage"
+	"github.com/insolar/insolar/ledger/storage/drop"
 	"github.com/insolar/insolar/ledger/storage/object"
+	"github.com/insolar/insolar/utils/compression"
 )
 
 type Handler struct {
@@ -41,6 +45,11 @@ type Handler struct {
 
 	BlobAccessor blob.Accessor         `inject:""`
 	Records      object.RecordAccessor `inject:""`
+	Results      object.ResultAccessor `inject:""`
+	Events       object.EventAccessor  `inject:""`
+
+	Drops       drop.Accessor              `inject:""`
+	DropRecords object.DropRecordsAccessor `inject:""`
 
 	jetID insolar.JetID
 }
@@ -62,6 +71,9 @@ func (h *Handler) Init(ctx context.Context) error {
 	h.Bus.MustRegister(insolar.TypeGetChildren, h.handleGetChildren)
 	h.Bus.MustRegister(insolar.TypeGetObjectIndex, h.handleGetObjectIndex)
 	h.Bus.MustRegister(insolar.TypeGetRequest, h.handleGetRequest)
+	h.Bus.MustRegister(insolar.TypeGetRequestResult, h.handleGetRequestResult)
+	h.Bus.MustRegister(insolar.TypeGetEvents, h.handleGetEvents)
+	h.Bus.MustRegister(insolar.TypeGetRecordProof, h.handleGetRecordProof)
 	return nil
 }
 
@@ -138,6 +150,8 @@ func (h *Handler) handleGetObject(
 		IsPrototype:  state.GetIsPrototype(),
 		ChildPointer: childPointer,
 		Parent:       idx.Parent,
+		PrevState:    state.PrevStateID(),
+		Request:      state.GetRequest(),
 	}
 
 	if state.GetMemory() != nil {
@@ -266,6 +280,92 @@ func (h *Handler) handleGetRequest(ctx context.Context, parcel insolar.Parcel) (
 	return &rep, nil
 }
 
+func (h *Handler) handleGetRequestResult(ctx context.Context, parcel insolar.Parcel) (insolar.Reply, error) {
+	msg := parcel.Message().(*message.GetRequestResult)
+
+	resultID, err := h.Results.ForRequest(ctx, msg.Request)
+	if err == object.ErrNotFound {
+		_, err = h.Records.ForID(ctx, msg.Request)
+		if err == object.ErrNotFound {
+			return &reply.Error{ErrType: reply.ErrRequestNotFound}, nil
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to fetch request")
+		}
+		return &reply.RequestResult{Request: msg.Request}, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch result id")
+	}
+
+	rec, err := h.Records.ForID(ctx, resultID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch result")
+	}
+	res, ok := rec.Record.(*object.ResultRecord)
+	if !ok {
+		return nil, errors.New("failed to decode result")
+	}
+
+	rep := reply.RequestResult{
+		Request: msg.Request,
+		Result:  &resultID,
+		Payload: res.Payload,
+	}
+
+	return &rep, nil
+}
+
+func (h *Handler) handleGetEvents(ctx context.Context, parcel insolar.Parcel) (insolar.Reply, error) {
+	msg := parcel.Message().(*message.GetEvents)
+
+	events, err := h.Events.ForObject(ctx, msg.Object, msg.Name, msg.From, msg.To)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch events")
+	}
+
+	return &reply.Events{Events: events}, nil
+}
+
+func (h *Handler) handleGetRecordProof(ctx context.Context, parcel insolar.Parcel) (insolar.Reply, error) {
+	msg := parcel.Message().(*message.GetRecordProof)
+
+	rec, err := h.Records.ForID(ctx, msg.Record)
+	if err == object.ErrNotFound {
+		return &reply.Error{ErrType: reply.ErrRecordNotFound}, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch record")
+	}
+
+	dr, err := h.Drops.ForPulse(ctx, rec.JetID, msg.Record.Pulse())
+	if err == drop.ErrNotFound || err == store.ErrNotFound {
+		return &reply.Error{ErrType: reply.ErrRecordNotFound}, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch drop")
+	}
+
+	ids, err := h.DropRecords.ForDrop(ctx, rec.JetID, msg.Record.Pulse())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch drop records")
+	}
+	root, proof, err := drop.RecordProof(h.PCS, ids, msg.Record)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build proof")
+	}
+	if !bytes.Equal(root, dr.MerkleRoot) {
+		return nil, errors.New("drop records don't match drop merkle root")
+	}
+
+	return &reply.RecordProof{
+		Drop:     drop.MustEncode(&dr),
+		Index:    proof.Index,
+		Leaves:   proof.Leaves,
+		Siblings: proof.Siblings,
+	}, nil
+}
+
 func (h *Handler) handleGetObjectIndex(ctx context.Context, parcel insolar.Parcel) (insolar.Reply, error) {
 	msg := parcel.Message().(*message.GetObjectIndex)
 
@@ -296,21 +396,42 @@ func (h *Handler) getCode(ctx context.Context, id *insolar.ID) (*object.CodeReco
 
 func (h *Handler) handleHeavyPayload(ctx context.Context, genericMsg insolar.Parcel) (insolar.Reply, error) {
 	msg := genericMsg.Message().(*message.HeavyPayload)
+	jetID := insolar.ID(msg.JetID)
 
-	h.HeavySync.StoreRecords(ctx, insolar.ID(msg.JetID), msg.PulseNum, msg.Records)
-
-	if err := h.HeavySync.StoreIndices(ctx, insolar.ID(msg.JetID), msg.PulseNum, msg.Indices); err != nil {
+	next, err := h.HeavySync.NextChunk(ctx, jetID, msg.PulseNum)
+	if err != nil {
 		return heavyerrreply(err)
 	}
+	if msg.Chunk != next {
+		// Chunk is already stored or previous chunks are lost, light should continue from the expected one.
+		return &reply.HeavyAck{NextChunk: next}, nil
+	}
+
+	if err := msg.Decompress(); err != nil {
+		return nil, err
+	}
+
+	h.HeavySync.StoreRecords(ctx, jetID, msg.PulseNum, msg.Records)
 
-	if err := h.HeavySync.StoreDrop(ctx, msg.JetID, msg.Drop); err != nil {
+	if err := h.HeavySync.StoreIndices(ctx, jetID, msg.PulseNum, msg.Indices); err != nil {
 		return heavyerrreply(err)
 	}
+
+	// Drop is sent only in the first chunk.
+	if len(msg.Drop) > 0 {
+		if err := h.HeavySync.StoreDrop(ctx, msg.JetID, msg.Drop); err != nil {
+			return heavyerrreply(err)
+		}
+	}
 	if err := h.HeavySync.StoreBlobs(ctx, msg.PulseNum, msg.Blobs); err != nil {
 		return heavyerrreply(err)
 	}
 
-	return &reply.OK{}, nil
+	next, err = h.HeavySync.AckChunk(ctx, jetID, msg.PulseNum, msg.Chunk)
+	if err != nil {
+		return heavyerrreply(err)
+	}
+	return &reply.HeavyAck{NextChunk: next}, nil
 }
 
 func (h *Handler) handleHeavyStartStop(ctx context.Context, genericMsg insolar.Parcel) (insolar.Reply, error) {
@@ -318,16 +439,20 @@ func (h *Handler) handleHeavyStartStop(ctx context.Context, genericMsg insolar.P
 
 	// stop
 	if msg.Finished {
-		if err := h.HeavySync.Stop(ctx, insolar.ID(msg.JetID), msg.PulseNum); err != nil {
-			return nil, err
+		if err := h.HeavySync.Stop(ctx, insolar.ID(msg.JetID), msg.PulseNum, msg.Chunks); err != nil {
+			return heavyerrreply(err)
 		}
 		return &reply.OK{}, nil
 	}
 	// start
-	if err := h.HeavySync.Start(ctx, insolar.ID(msg.JetID), msg.PulseNum); err != nil {
+	next, err := h.HeavySync.Start(ctx, insolar.ID(msg.JetID), msg.PulseNum, msg.Digest)
+	if err != nil {
 		return heavyerrreply(err)
 	}
-	return &reply.OK{}, nil
+	return &reply.HeavyAck{
+		Compression: compression.Negotiate(msg.Compressions),
+		NextChunk:   next,
+	}, nil
 }
 
 func heavyerrreply(err error) (insolar.Reply, error) {
@@ -41,6 +41,10 @@ type Options struct {
 	SyncMessageLimit int
 	PulsesDeltaLimit int
 	BackoffConf      configuration.Backoff
+	// Compressions are payload compressions offered to heavy in order of preference.
+	Compressions []string
+	// ChunkRetries is a number of attempts to resend a payload chunk.
+	ChunkRetries int
 }
 
 // JetClient heavy replication client. Replicates records for one jet.
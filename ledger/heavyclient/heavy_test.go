@@ -24,7 +24,6 @@ import (
 	"testing"
 	"time"
 
-	"github.com/dgraph-io/badger"
 	"github.com/insolar/insolar/insolar/gen"
 	"github.com/insolar/insolar/insolar/record"
 	"github.com/insolar/insolar/ledger/storage/blob"
@@ -73,6 +72,7 @@ type heavySuite struct {
 	recordModifier  object.RecordModifier
 	recordCleaner   object.RecordCleaner
 	recSyncAccessor object.RecordCollectionAccessor
+	dropRecords     object.DropRecordsAccessor
 	storageCleaner  storage.Cleaner
 	pulseStorage    *pulse.StorageMem
 }
@@ -112,6 +112,7 @@ func (s *heavySuite) BeforeTest(suiteName, testName string) {
 	s.recordModifier = recordStorage
 	s.recordCleaner = recordStorage
 	s.recSyncAccessor = recordStorage
+	s.dropRecords = recordStorage
 
 	s.storageCleaner = storage.NewCleaner()
 
@@ -270,17 +271,24 @@ func sendToHeavy(s *heavySuite, withretry bool) {
 		},
 		SplitThreshold: 10 * 1000 * 1000,
 	}
-	pm := pulsemanager.NewPulseManager(
-		configuration.Ledger{
-			PulseManager:    pmconf,
-			LightChainLimit: 10,
+	ledgerconf := configuration.Ledger{
+		PulseManager:    pmconf,
+		LightChainLimit: 10,
+		JetSplit: configuration.JetSplit{
+			Policy: pulsemanager.SplitPolicySize,
 		},
+	}
+	splitPolicy, err := pulsemanager.NewSplitPolicy(ledgerconf)
+	require.NoError(s.T(), err)
+	pm := pulsemanager.NewPulseManager(
+		ledgerconf,
 		nil,
 		blobStorage,
 		blobStorage,
 		s.pulseStorage,
 		s.recordCleaner,
 		s.recSyncAccessor,
+		splitPolicy,
 	)
 	pm.NodeNet = nodenetMock
 	pm.Bus = busMock
@@ -296,6 +304,7 @@ func sendToHeavy(s *heavySuite, withretry bool) {
 	pm.ObjectStorage = s.objectStorage
 	pm.DropAccessor = s.dropAccessor
 	pm.DropModifier = s.dropModifier
+	pm.DropRecords = s.dropRecords
 	pm.PulseAppender = s.pulseStorage
 	pm.PulseAccessor = s.pulseStorage
 	pm.PulseCalculator = s.pulseStorage
@@ -317,7 +326,7 @@ func sendToHeavy(s *heavySuite, withretry bool) {
 
 	// Actial test logic
 	// start PulseManager
-	err := pm.Start(s.ctx)
+	err = pm.Start(s.ctx)
 	assert.NoError(s.T(), err)
 
 	// store last pulse as light material and set next one
@@ -385,7 +394,7 @@ func sendToHeavy(s *heavySuite, withretry bool) {
 
 	synckeys = uniqkeys(sortkeys(synckeys))
 
-	recs := getallkeys(s.db.GetBadgerDB())
+	recs := getallkeys(s.db.GetBackend())
 	recs = filterkeys(recs, func(k key) bool {
 		return storage.Key(k).PulseNumber() != 0
 	})
@@ -430,25 +439,21 @@ func (k key) String() string {
 	return storage.Key(k).String()
 }
 
-func getallkeys(db *badger.DB) (records []key) {
-	txn := db.NewTransaction(true)
-	defer txn.Discard()
-
-	it := txn.NewIterator(badger.DefaultIteratorOptions)
-	defer it.Close()
-	for it.Rewind(); it.Valid(); it.Next() {
-		item := it.Item()
-		k := item.KeyCopy(nil)
-		if storage.Key(k).PulseNumber() == 0 {
-			continue
-		}
-		switch k[0] {
-		case
-			scopeIDRecord,
-			scopeIDLifeline:
-			records = append(records, k)
-		}
-	}
+func getallkeys(db store.Backend) (records []key) {
+	_ = db.View(func(txn store.Txn) error {
+		return txn.Iterate(nil, nil, func(k, _ []byte) (bool, error) {
+			if storage.Key(k).PulseNumber() == 0 {
+				return true, nil
+			}
+			switch k[0] {
+			case
+				scopeIDRecord,
+				scopeIDLifeline:
+				records = append(records, k)
+			}
+			return true, nil
+		})
+	})
 	return
 }
 
@@ -25,7 +25,8 @@ import (
 )
 
 var (
-	tagJet = insmetrics.MustTagKey("jet")
+	tagJet         = insmetrics.MustTagKey("jet")
+	tagCompression = insmetrics.MustTagKey("compression")
 )
 
 var (
@@ -36,6 +37,10 @@ var (
 
 	statCleanLatencyDB = stats.Int64("lightcleanup/latency/db", "Light storage db cleanup time in milliseconds", stats.UnitMilliseconds)
 	statSyncedRetries  = stats.Int64("heavyserver/synced/retries", "Number of retries for sync", stats.UnitDimensionless)
+
+	statChunksSent          = stats.Int64("heavyclient/chunks/sent", "Number of payload chunks sent to heavy", stats.UnitDimensionless)
+	statChunksRetransmitted = stats.Int64("heavyclient/chunks/retransmitted", "Number of payload chunks sent to heavy again", stats.UnitDimensionless)
+	statCompressionSaved    = stats.Int64("heavyclient/compression/saved", "Amount of bytes saved by payload compression", stats.UnitBytes)
 )
 
 func init() {
@@ -74,6 +79,27 @@ func init() {
 			Measure:     statSyncedRetries,
 			Aggregation: view.Count(),
 		},
+		&view.View{
+			Name:        statChunksSent.Name(),
+			Description: statChunksSent.Description(),
+			Measure:     statChunksSent,
+			Aggregation: view.Count(),
+			TagKeys:     []tag.Key{tagJet},
+		},
+		&view.View{
+			Name:        statChunksRetransmitted.Name(),
+			Description: statChunksRetransmitted.Description(),
+			Measure:     statChunksRetransmitted,
+			Aggregation: view.Count(),
+			TagKeys:     []tag.Key{tagJet},
+		},
+		&view.View{
+			Name:        statCompressionSaved.Name(),
+			Description: statCompressionSaved.Description(),
+			Measure:     statCompressionSaved,
+			Aggregation: view.Sum(),
+			TagKeys:     []tag.Key{tagJet, tagCompression},
+		},
 	)
 	if err != nil {
 		panic(err)
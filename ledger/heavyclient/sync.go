@@ -17,36 +17,49 @@
 package heavyclient
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opencensus.io/stats"
 
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/message"
 	"github.com/insolar/insolar/insolar/record"
 	"github.com/insolar/insolar/insolar/reply"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/instrumentation/insmetrics"
 	"github.com/insolar/insolar/ledger/storage"
 	"github.com/insolar/insolar/ledger/storage/blob"
 	"github.com/insolar/insolar/ledger/storage/drop"
 	"github.com/insolar/insolar/ledger/storage/object"
+	"github.com/insolar/insolar/utils/compression"
 )
 
-func messageToHeavy(ctx context.Context, bus insolar.MessageBus, msg insolar.Message) error {
+// messageToHeavy sends message to heavy and returns its acknowledgement.
+// Acknowledgement is nil if heavy doesn't support it.
+func messageToHeavy(ctx context.Context, bus insolar.MessageBus, msg insolar.Message) (*reply.HeavyAck, error) {
 	busreply, buserr := bus.Send(ctx, msg, nil)
 	if buserr != nil {
-		return buserr
+		return nil, buserr
 	}
-	if busreply != nil {
-		herr, ok := busreply.(*reply.HeavyError)
-		if ok {
-			return herr
-		}
+	switch r := busreply.(type) {
+	case *reply.HeavyError:
+		return nil, r
+	case *reply.HeavyAck:
+		return r, nil
 	}
-	return nil
+	return nil, nil
 }
 
 // HeavySync syncs records from light to heavy node, returns last synced pulse and error.
 //
-// It syncs records from start to end of provided pulse numbers.
+// It syncs records from start to end of provided pulse numbers. Payload is sent in chunks,
+// if heavy has already acknowledged some of them, sync resumes from the first unacknowledged chunk.
 func (c *JetClient) HeavySync(
 	ctx context.Context,
 	pn insolar.PulseNumber,
@@ -56,15 +69,6 @@ func (c *JetClient) HeavySync(
 	inslog = inslog.WithField("jetID", jetID.DebugString())
 	inslog = inslog.WithField("pulseNum", pn)
 
-	signalMsg := &message.HeavyStartStop{
-		JetID:    jetID,
-		PulseNum: pn,
-	}
-	if err := messageToHeavy(ctx, c.bus, signalMsg); err != nil {
-		inslog.Error("synchronize: start failed")
-		return err
-	}
-
 	dr, err := c.dropAccessor.ForPulse(ctx, jetID, pn)
 	if err != nil {
 		inslog.Error("synchronize: can't fetch a drop")
@@ -91,21 +95,73 @@ func (c *JetClient) HeavySync(
 
 	records := c.recSyncAccessor.ForPulse(ctx, jetID, pn)
 
-	msg := &message.HeavyPayload{
-		JetID:    jetID,
-		PulseNum: pn,
-		Indices:  idxs,
-		Drop:     drop.MustEncode(&dr),
-		Blobs:    convertBlobs(bls),
-		Records:  convertRecords(records),
+	chunks := splitPayload(
+		jetID,
+		pn,
+		drop.MustEncode(&dr),
+		convertRecords(records),
+		convertBlobs(bls),
+		idxs,
+		c.opts.SyncMessageLimit,
+	)
+
+	signalMsg := &message.HeavyStartStop{
+		JetID:        jetID,
+		PulseNum:     pn,
+		Compressions: c.opts.Compressions,
+		Chunks:       uint32(len(chunks)),
+		Digest:       payloadDigest(chunks),
 	}
-	if err := messageToHeavy(ctx, c.bus, msg); err != nil {
-		inslog.Error("synchronize: payload failed")
+	ack, err := messageToHeavy(ctx, c.bus, signalMsg)
+	if err != nil {
+		inslog.Error("synchronize: start failed")
 		return err
 	}
+	var (
+		compressionName string
+		next            uint32
+	)
+	if ack != nil {
+		compressionName = ack.Compression
+		next = ack.NextChunk
+	}
+	if int(next) > len(chunks) {
+		return errors.Errorf("heavy expects chunk %v, but payload has only %v chunks", next, len(chunks))
+	}
+	if next > 0 {
+		inslog.Infof("synchronize: resume from chunk %v of %v", next, len(chunks))
+	}
+
+	ctx = insmetrics.InsertTag(ctx, tagJet, jetID.DebugString())
+	for _, chunk := range chunks[next:] {
+		if err := compressChunk(ctx, chunk, compressionName); err != nil {
+			inslog.Error("synchronize: compression failed")
+			return err
+		}
+	}
+
+	sent := make([]bool, len(chunks))
+	for int(next) < len(chunks) {
+		ack, err := c.sendChunk(ctx, chunks[next], sent[next])
+		if err != nil {
+			inslog.Errorf("synchronize: payload chunk %v failed", next)
+			return err
+		}
+		sent[next] = true
+
+		// heavy doesn't acknowledge chunks
+		if ack == nil {
+			next++
+			continue
+		}
+		if int(ack.NextChunk) > len(chunks) {
+			return errors.Errorf("heavy expects chunk %v, but payload has only %v chunks", ack.NextChunk, len(chunks))
+		}
+		next = ack.NextChunk
+	}
 
 	signalMsg.Finished = true
-	if err := messageToHeavy(ctx, c.bus, signalMsg); err != nil {
+	if _, err := messageToHeavy(ctx, c.bus, signalMsg); err != nil {
 		inslog.Error("synchronize: finish failed")
 		return err
 	}
@@ -113,6 +169,140 @@ func (c *JetClient) HeavySync(
 	return nil
 }
 
+// sendChunk sends payload chunk to heavy and retries on failures.
+func (c *JetClient) sendChunk(
+	ctx context.Context,
+	chunk *message.HeavyPayload,
+	resend bool,
+) (*reply.HeavyAck, error) {
+	retries := c.syncbackoff.Copy()
+	for {
+		if resend {
+			stats.Record(ctx, statChunksRetransmitted.M(1))
+		}
+		stats.Record(ctx, statChunksSent.M(1))
+
+		ack, err := messageToHeavy(ctx, c.bus, chunk)
+		if err == nil {
+			return ack, nil
+		}
+		// sync should be started again, so it is up to the sync loop
+		if herr, ok := err.(*reply.HeavyError); ok && herr.ConcreteType() == reply.ErrHeavySyncNotStarted {
+			return nil, err
+		}
+		if retries.Attempt() >= c.opts.ChunkRetries {
+			return nil, err
+		}
+
+		inslogger.FromContext(ctx).Warnf("synchronize: resend payload chunk %v: %v", chunk.Chunk, err)
+		resend = true
+		select {
+		case <-time.After(retries.Duration()):
+		case <-ctx.Done():
+			return nil, err
+		}
+	}
+}
+
+func compressChunk(ctx context.Context, chunk *message.HeavyPayload, name string) error {
+	if name == "" || name == compression.None {
+		return nil
+	}
+	raw, err := chunk.Compress(name)
+	if err != nil {
+		return err
+	}
+	ctx = insmetrics.InsertTag(ctx, tagCompression, name)
+	stats.Record(ctx, statCompressionSaved.M(int64(raw-len(chunk.Data))))
+	return nil
+}
+
+// payloadDigest returns hash of payload chunks. Heavy resumes sync only if digest of the payload is not changed,
+// otherwise acknowledged chunk sequence numbers could point to other data.
+func payloadDigest(chunks []*message.HeavyPayload) []byte {
+	h := sha256.New()
+	write := func(b []byte) {
+		var size [4]byte
+		binary.BigEndian.PutUint32(size[:], uint32(len(b)))
+		_, _ = h.Write(size[:])
+		_, _ = h.Write(b)
+	}
+	for _, chunk := range chunks {
+		write(chunk.Drop)
+		for _, rec := range chunk.Records {
+			write(rec)
+		}
+		write(nil)
+		for _, b := range chunk.Blobs {
+			write(b)
+		}
+		write(nil)
+		for _, kv := range chunk.Indices {
+			write(kv.K)
+			write(kv.V)
+		}
+		write(nil)
+	}
+	return h.Sum(nil)
+}
+
+// splitPayload splits pulse payload into chunks of about limit bytes. Drop is always in the first chunk.
+//
+// Records and blobs are sorted, so the same payload is always split into the same chunks
+// and chunk sequence numbers acknowledged by heavy remain valid on resume.
+func splitPayload(
+	jetID insolar.JetID,
+	pn insolar.PulseNumber,
+	rawDrop []byte,
+	records [][]byte,
+	blobs [][]byte,
+	indices []insolar.KV,
+	limit int,
+) []*message.HeavyPayload {
+	sortBytes(records)
+	sortBytes(blobs)
+
+	chunk := &message.HeavyPayload{
+		JetID:    jetID,
+		PulseNum: pn,
+		Drop:     rawDrop,
+	}
+	chunks := []*message.HeavyPayload{chunk}
+	size := len(rawDrop)
+	reserve := func(itemSize int) {
+		if limit > 0 && size > 0 && size+itemSize > limit {
+			chunk = &message.HeavyPayload{
+				JetID:    jetID,
+				PulseNum: pn,
+				Chunk:    uint32(len(chunks)),
+			}
+			chunks = append(chunks, chunk)
+			size = 0
+		}
+		size += itemSize
+	}
+
+	for _, rec := range records {
+		reserve(len(rec))
+		chunk.Records = append(chunk.Records, rec)
+	}
+	for _, b := range blobs {
+		reserve(len(b))
+		chunk.Blobs = append(chunk.Blobs, b)
+	}
+	for _, kv := range indices {
+		reserve(len(kv.K) + len(kv.V))
+		chunk.Indices = append(chunk.Indices, kv)
+	}
+	return chunks
+}
+
+func sortBytes(items [][]byte) {
+	sort.Slice(items, func(i, j int) bool {
+		return bytes.Compare(items[i], items[j]) < 0
+	})
+}
+
 func convertBlobs(blobs []blob.Blob) [][]byte {
 	var res [][]byte
 	for _, b := range blobs {
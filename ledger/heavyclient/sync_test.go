@@ -0,0 +1,117 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package heavyclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/testutils"
+	"github.com/insolar/insolar/utils/backoff"
+)
+
+func TestSplitPayload(t *testing.T) {
+	jetID := gen.JetID()
+	pn := gen.PulseNumber()
+	drop := []byte("drop")
+	records := [][]byte{[]byte("record_2"), []byte("record_1")}
+	blobs := [][]byte{[]byte("blob")}
+	indices := []insolar.KV{{K: []byte("key"), V: []byte("value")}}
+
+	chunks := splitPayload(jetID, pn, drop, records, blobs, indices, 0)
+	require.Len(t, chunks, 1)
+	assert.Equal(t, drop, chunks[0].Drop)
+	assert.Equal(t, [][]byte{[]byte("record_1"), []byte("record_2")}, chunks[0].Records)
+	assert.Equal(t, blobs, chunks[0].Blobs)
+	assert.Equal(t, indices, chunks[0].Indices)
+
+	chunks = splitPayload(jetID, pn, drop, records, blobs, indices, 12)
+	require.Len(t, chunks, 3)
+	for i, chunk := range chunks {
+		assert.Equal(t, uint32(i), chunk.Chunk)
+		assert.Equal(t, jetID, chunk.JetID)
+		assert.Equal(t, pn, chunk.PulseNum)
+	}
+	assert.Equal(t, drop, chunks[0].Drop)
+	assert.Equal(t, [][]byte{[]byte("record_1")}, chunks[0].Records)
+	assert.Nil(t, chunks[1].Drop)
+	assert.Equal(t, [][]byte{[]byte("record_2")}, chunks[1].Records)
+	assert.Equal(t, blobs, chunks[1].Blobs)
+	assert.Equal(t, indices, chunks[2].Indices)
+}
+
+func TestPayloadDigest(t *testing.T) {
+	jetID := gen.JetID()
+	pn := gen.PulseNumber()
+	records := [][]byte{[]byte("record_1"), []byte("record_2")}
+
+	digest := payloadDigest(splitPayload(jetID, pn, []byte("drop"), records, nil, nil, 12))
+	assert.Equal(t, digest, payloadDigest(splitPayload(jetID, pn, []byte("drop"), records, nil, nil, 12)))
+	assert.NotEqual(t, digest, payloadDigest(splitPayload(jetID, pn, []byte("drop"), records, nil, nil, 0)),
+		"other chunk boundaries")
+	assert.NotEqual(t, digest, payloadDigest(splitPayload(jetID, pn, []byte("drop"), records[:1], nil, nil, 12)),
+		"other payload")
+}
+
+func TestJetClient_SendChunk(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	chunk := &message.HeavyPayload{Chunk: 1}
+
+	var sent int
+	bus := testutils.NewMessageBusMock(t)
+	bus.SendFunc = func(ctx context.Context, msg insolar.Message, ops *insolar.MessageSendOptions) (insolar.Reply, error) {
+		sent++
+		if sent < 3 {
+			return nil, errors.New("network failure")
+		}
+		return &reply.HeavyAck{NextChunk: 2}, nil
+	}
+	c := &JetClient{
+		bus:         bus,
+		syncbackoff: &backoff.Backoff{Min: 1, Max: 2},
+		opts:        Options{ChunkRetries: 2},
+	}
+
+	ack, err := c.sendChunk(ctx, chunk, false)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), ack.NextChunk)
+	assert.Equal(t, 3, sent)
+
+	sent = 0
+	c.opts.ChunkRetries = 1
+	_, err = c.sendChunk(ctx, chunk, false)
+	assert.Error(t, err, "retries are exhausted")
+	assert.Equal(t, 2, sent)
+
+	sent = 0
+	bus.SendFunc = func(ctx context.Context, msg insolar.Message, ops *insolar.MessageSendOptions) (insolar.Reply, error) {
+		sent++
+		return &reply.HeavyError{SubType: reply.ErrHeavySyncNotStarted}, nil
+	}
+	_, err = c.sendChunk(ctx, chunk, false)
+	assert.Error(t, err, "sync should be restarted")
+	assert.Equal(t, 1, sent)
+}
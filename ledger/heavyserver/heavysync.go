@@ -17,6 +17,7 @@
 package heavyserver
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"sync"
@@ -46,34 +47,70 @@ func errSyncInProgress(jetID insolar.ID, pn insolar.PulseNumber) *reply.HeavyErr
 	}
 }
 
+func errSyncIncomplete(jetID insolar.ID, pn insolar.PulseNumber, stored, chunks uint32) *reply.HeavyError {
+	return &reply.HeavyError{
+		Message:  fmt.Sprintf("Heavy node stored %v of %v payload chunks", stored, chunks),
+		SubType:  reply.ErrHeavySyncIncomplete,
+		JetID:    jetID,
+		PulseNum: pn,
+	}
+}
+
+func errSyncNotStarted(jetID insolar.ID, pn insolar.PulseNumber) *reply.HeavyError {
+	return &reply.HeavyError{
+		Message:  "Heavy node sync is not started",
+		SubType:  reply.ErrHeavySyncNotStarted,
+		JetID:    jetID,
+		PulseNum: pn,
+	}
+}
+
 // in testnet we start with only one jet
 type syncstate struct {
 	sync.Mutex
 	lastok insolar.PulseNumber
 	// insyncend insolar.PulseNumber
 	syncpulse *insolar.PulseNumber
+	syncjet   insolar.ID
 	insync    bool
 	timer     *time.Timer
+
+	// payload chunks progress survives sync timeout, so light could resume sync of the same pulse and payload
+	chunkjet    insolar.ID
+	chunkpulse  insolar.PulseNumber
+	chunkdigest []byte
+	nextchunk   uint32
 }
 
-func (s *syncstate) resetTimeout(ctx context.Context, timeout time.Duration) {
-	if s.timer != nil {
-		s.timer.Reset(timeout)
-	} else {
-		s.timer = time.NewTimer(timeout)
-	}
-	timer := s.timer
-	go func() {
-		<-timer.C
+func (s *syncstate) resetChunks(jetID insolar.ID, pn insolar.PulseNumber, digest []byte) {
+	s.chunkjet = jetID
+	s.chunkpulse = pn
+	s.chunkdigest = digest
+	s.nextchunk = 0
+}
 
+// resetTimeout restarts sync timeout. It should be called with locked state, so the timer callback
+// can't run before the timer is saved.
+func (s *syncstate) resetTimeout(ctx context.Context, timeout time.Duration) {
+	s.stopTimeout()
+	var timer *time.Timer
+	timer = time.AfterFunc(timeout, func() {
 		s.Lock()
+		defer s.Unlock()
 		if s.timer == timer {
 			stats.Record(ctx, statSyncedTimeout.M(1))
 			s.syncpulse = nil
 			s.timer = nil
 		}
-		s.Unlock()
-	}()
+	})
+	s.timer = timer
+}
+
+func (s *syncstate) stopTimeout() {
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
 }
 
 type jetprefix [insolar.JetPrefixSize]byte
@@ -143,30 +180,75 @@ func (s *Sync) getJetSyncState(ctx context.Context, jetID insolar.ID) *syncstate
 }
 
 // Start try to start heavy sync for provided pulse.
-func (s *Sync) Start(ctx context.Context, jetID insolar.ID, pn insolar.PulseNumber) error {
+//
+// It returns sequence number of the first payload chunk light should send. It is not zero
+// if sync of the same jet, pulse and payload digest was interrupted by timeout and could be resumed.
+// Sync in progress can't be started again.
+func (s *Sync) Start(ctx context.Context, jetID insolar.ID, pn insolar.PulseNumber, digest []byte) (uint32, error) {
 	jetState := s.getJetSyncState(ctx, jetID)
 	jetState.Lock()
 	defer jetState.Unlock()
 
 	if jetState.syncpulse != nil {
 		if *jetState.syncpulse >= pn {
-			return fmt.Errorf("heavyserver: pulse %v is not greater than current in-sync pulse %v (jet=%v)",
+			return 0, fmt.Errorf("heavyserver: pulse %v is not greater than current in-sync pulse %v (jet=%v)",
 				pn, *jetState.syncpulse, jetID)
 		}
-		return errSyncInProgress(jetID, pn)
+		return 0, errSyncInProgress(jetID, pn)
 	}
 
 	if pn <= insolar.FirstPulseNumber {
-		return fmt.Errorf("heavyserver: sync pulse should be greater than first pulse %v (got %v)", insolar.FirstPulseNumber, pn)
+		return 0, fmt.Errorf("heavyserver: sync pulse should be greater than first pulse %v (got %v)", insolar.FirstPulseNumber, pn)
 	}
 
 	if err := s.checkIsNextPulse(ctx, jetID, jetState, pn); err != nil {
-		return err
+		return 0, err
 	}
 
+	if jetState.chunkjet != jetID || jetState.chunkpulse != pn || !bytes.Equal(jetState.chunkdigest, digest) {
+		jetState.resetChunks(jetID, pn, digest)
+	} else {
+		inslogger.FromContext(ctx).Debugf("heavyserver: Resume sync: jetID=%v, pulse=%v, chunk=%v",
+			jetID, pn, jetState.nextchunk)
+	}
 	jetState.syncpulse = &pn
+	jetState.syncjet = jetID
 	jetState.resetTimeout(ctx, defaultTimeout)
-	return nil
+	return jetState.nextchunk, nil
+}
+
+// NextChunk returns sequence number of the next payload chunk expected for provided pulse.
+func (s *Sync) NextChunk(ctx context.Context, jetID insolar.ID, pn insolar.PulseNumber) (uint32, error) {
+	jetState := s.getJetSyncState(ctx, jetID)
+	jetState.Lock()
+	defer jetState.Unlock()
+
+	if jetState.syncpulse == nil || *jetState.syncpulse != pn || jetState.syncjet != jetID {
+		return 0, errSyncNotStarted(jetID, pn)
+	}
+	return jetState.nextchunk, nil
+}
+
+// AckChunk marks payload chunk as stored and returns sequence number of the next expected chunk.
+func (s *Sync) AckChunk(ctx context.Context, jetID insolar.ID, pn insolar.PulseNumber, chunk uint32) (uint32, error) {
+	jetState := s.getJetSyncState(ctx, jetID)
+	jetState.Lock()
+	defer jetState.Unlock()
+
+	if jetState.syncpulse == nil || *jetState.syncpulse != pn || jetState.syncjet != jetID {
+		return 0, errSyncNotStarted(jetID, pn)
+	}
+	if chunk != jetState.nextchunk {
+		return jetState.nextchunk, fmt.Errorf("heavyserver: chunk %v doesn't match expected chunk %v (jet=%v, pulse=%v)",
+			chunk, jetState.nextchunk, jetID, pn)
+	}
+
+	jetState.nextchunk++
+	jetState.resetTimeout(ctx, defaultTimeout)
+
+	ctx = insmetrics.InsertTag(ctx, tagJet, jetID.DebugString())
+	stats.Record(ctx, statSyncedChunks.M(1))
+	return jetState.nextchunk, nil
 }
 
 // StoreIndices stores recieved key/value pairs for indices at heavy storage.
@@ -275,8 +357,10 @@ func (s *Sync) StoreRecords(ctx context.Context, jetID insolar.ID, pn insolar.Pu
 	}
 }
 
-// Stop successfully stops replication for specified pulse.
-func (s *Sync) Stop(ctx context.Context, jetID insolar.ID, pn insolar.PulseNumber) error {
+// Stop successfully stops replication for specified pulse if all payload chunks are stored.
+//
+// If count of stored chunks doesn't match, sync is dropped and light should start it again.
+func (s *Sync) Stop(ctx context.Context, jetID insolar.ID, pn insolar.PulseNumber, chunks uint32) error {
 	jetState := s.getJetSyncState(ctx, jetID)
 	jetState.Lock()
 	defer jetState.Unlock()
@@ -293,11 +377,18 @@ func (s *Sync) Stop(ctx context.Context, jetID insolar.ID, pn insolar.PulseNumbe
 		return errSyncInProgress(jetID, pn)
 	}
 	jetState.syncpulse = nil
+	jetState.stopTimeout()
+	if jetState.nextchunk != chunks {
+		stored := jetState.nextchunk
+		jetState.resetChunks(insolar.ID{}, 0, nil)
+		return errSyncIncomplete(jetID, pn, stored, chunks)
+	}
 
 	err := s.ReplicaStorage.SetHeavySyncedPulse(ctx, jetID, pn)
 	if err != nil {
 		return err
 	}
+	jetState.resetChunks(insolar.ID{}, 0, nil)
 	inslogger.FromContext(ctx).Debugf("heavyserver: Fin sync: jetID=%v, pulse=%v", jetID, pn)
 	jetState.lastok = pn
 	return nil
@@ -316,5 +407,7 @@ func (s *Sync) Reset(ctx context.Context, jetID insolar.ID, pn insolar.PulseNumb
 
 	inslogger.FromContext(ctx).Debugf("heavyserver: Reset sync: jetID=%v, pulse=%v", jetID, pn)
 	jetState.syncpulse = nil
+	jetState.stopTimeout()
+	jetState.resetChunks(insolar.ID{}, 0, nil)
 	return nil
 }
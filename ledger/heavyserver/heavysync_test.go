@@ -25,6 +25,7 @@ import (
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/gen"
 	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/insolar/reply"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/ledger/storage"
 	"github.com/insolar/insolar/ledger/storage/object"
@@ -133,43 +134,43 @@ func (s *heavysyncSuite) TestHeavy_SyncBasic() {
 	sync := NewSync(s.db, s.records)
 	sync.ReplicaStorage = s.replicaStorage
 	sync.PlatformCryptographyScheme = testutils.NewPlatformCryptographyScheme()
-	err = sync.Start(s.ctx, jetID, pnum)
+	_, err = sync.Start(s.ctx, jetID, pnum, nil)
 	require.Error(s.T(), err, "start with zero pulse")
 
 	// err = sync.StoreRecords(s.ctx, jetID, pnum, recs)
 	// require.Error(s.T(), err, "store values on non started sync")
 
-	err = sync.Stop(s.ctx, jetID, pnum)
+	err = sync.Stop(s.ctx, jetID, pnum, 0)
 	require.Error(s.T(), err, "stop on non started sync")
 
 	pnum = 5
-	err = sync.Start(s.ctx, jetID, pnum)
+	_, err = sync.Start(s.ctx, jetID, pnum, nil)
 	require.Error(s.T(), err, "last synced pulse is less when 'first pulse number'")
 
 	pnum = insolar.FirstPulseNumber
-	err = sync.Start(s.ctx, jetID, pnum)
+	_, err = sync.Start(s.ctx, jetID, pnum, nil)
 	require.Error(s.T(), err, "start from first pulse on empty storage")
 
 	pnum = insolar.FirstPulseNumber + 1
-	err = sync.Start(s.ctx, jetID, pnum)
+	_, err = sync.Start(s.ctx, jetID, pnum, nil)
 	require.NoError(s.T(), err, "start sync on empty heavy jet with non first pulse number")
 
-	err = sync.Start(s.ctx, jetID, pnum)
+	_, err = sync.Start(s.ctx, jetID, pnum, nil)
 	require.Error(s.T(), err, "double start")
 
 	pnumNext := pnum + 1
-	err = sync.Start(s.ctx, jetID, pnumNext)
+	_, err = sync.Start(s.ctx, jetID, pnumNext, nil)
 	require.Error(s.T(), err, "start next pulse sync when previous not end")
 
 	// stop previous
-	err = sync.Stop(s.ctx, jetID, pnum)
+	err = sync.Stop(s.ctx, jetID, pnum, 0)
 	require.NoError(s.T(), err)
 
 	// start sparse next
 	pnumNextPlus := pnumNext + 1
-	err = sync.Start(s.ctx, jetID, pnumNextPlus)
+	_, err = sync.Start(s.ctx, jetID, pnumNextPlus, nil)
 	require.NoError(s.T(), err, "sparse sync is ok")
-	err = sync.Stop(s.ctx, jetID, pnumNextPlus)
+	err = sync.Stop(s.ctx, jetID, pnumNextPlus, 0)
 	require.NoError(s.T(), err)
 
 	// prepare pulse helper
@@ -184,31 +185,31 @@ func (s *heavysyncSuite) TestHeavy_SyncBasic() {
 	preparepulse(pnum)
 	preparepulse(pnumNext) // should set correct next for previous pulse
 
-	err = sync.Start(s.ctx, jetID, pnumNext)
+	_, err = sync.Start(s.ctx, jetID, pnumNext, nil)
 	require.NoError(s.T(), err, "start next pulse")
 
 	// err = sync.StoreRecords(s.ctx, jetID, pnumNextPlus, recs)
 	// require.Error(s.T(), err, "store from other pulse at the same jet")
 
-	err = sync.Stop(s.ctx, jetID, pnumNextPlus)
+	err = sync.Stop(s.ctx, jetID, pnumNextPlus, 0)
 	require.Error(s.T(), err, "stop from other pulse at the same jet")
 
 	// err = sync.StoreRecords(s.ctx, jetID, pnumNext, recs)
 	// require.NoError(s.T(), err, "store on current range")
 	// err = sync.StoreRecords(s.ctx, jetID, pnumNext, recs)
 	// require.NoError(s.T(), err, "store the same on current range")
-	err = sync.Stop(s.ctx, jetID, pnumNext)
+	err = sync.Stop(s.ctx, jetID, pnumNext, 0)
 	require.NoError(s.T(), err, "stop current range")
 
 	preparepulse(pnumNextPlus) // should set corret next for previous pulse
 	sync = NewSync(s.db, s.records)
 	sync.ReplicaStorage = s.replicaStorage
 	sync.PlatformCryptographyScheme = testutils.NewPlatformCryptographyScheme()
-	err = sync.Start(s.ctx, jetID, pnumNextPlus)
+	_, err = sync.Start(s.ctx, jetID, pnumNextPlus, nil)
 	require.NoError(s.T(), err, "start next+1 range on new sync instance (checkpoint check)")
 	// err = sync.StoreRecords(s.ctx, jetID, pnumNextPlus, recs)
 	// require.NoError(s.T(), err, "store next+1 pulse")
-	err = sync.Stop(s.ctx, jetID, pnumNextPlus)
+	err = sync.Stop(s.ctx, jetID, pnumNextPlus, 0)
 	require.NoError(s.T(), err, "stop next+1 range on new sync instance")
 }
 
@@ -236,13 +237,13 @@ func (s *heavysyncSuite) TestHeavy_SyncBasic() {
 // 	preparepulse(s, pnum)
 // 	preparepulse(s, pnumNext) // should set correct next for previous pulse
 //
-// 	err = sync.Start(s.ctx, jetID1, insolar.FirstPulseNumber)
+// 	_, err = sync.Start(s.ctx, jetID1, insolar.FirstPulseNumber)
 // 	require.Error(s.T(), err)
 //
-// 	err = sync.Start(s.ctx, jetID1, pnum)
+// 	_, err = sync.Start(s.ctx, jetID1, pnum)
 // 	require.NoError(s.T(), err, "start from first+1 pulse on empty storage, jet1")
 //
-// 	err = sync.Start(s.ctx, jetID2, pnum)
+// 	_, err = sync.Start(s.ctx, jetID2, pnum)
 // 	require.NoError(s.T(), err, "start from first+1 pulse on empty storage, jet2")
 //
 // 	err = sync.StoreRecords(s.ctx, jetID2, pnum, kvalues2)
@@ -273,17 +274,17 @@ func (s *heavysyncSuite) TestHeavy_SyncLockOnPrefix() {
 	preparepulse(s, pnum-1)
 	preparepulse(s, pnum)
 
-	err = sync.Start(s.ctx, jetID1, pnum)
+	_, err = sync.Start(s.ctx, jetID1, pnum, nil)
 	require.NoError(s.T(), err, "all should be ok")
 
-	err = sync.Start(s.ctx, jetID2, pnum)
+	_, err = sync.Start(s.ctx, jetID2, pnum, nil)
 	require.Error(s.T(), err, "should not start on same prefix")
 
 	// stop previous sync (only prefix matters)
-	err = sync.Stop(s.ctx, jetID2, pnum)
+	err = sync.Stop(s.ctx, jetID2, pnum, 0)
 	require.NoError(s.T(), err)
 
-	err = sync.Start(s.ctx, jetID2, pnum+1)
+	_, err = sync.Start(s.ctx, jetID2, pnum+1, nil)
 	require.NoError(s.T(), err, "should start after released lock")
 }
 
@@ -293,7 +294,7 @@ func (s *heavysyncSuite) TestHeavy_Timeout() {
 
 	sync := NewSync(s.db, s.records)
 	sync.ReplicaStorage = s.replicaStorage
-	err := sync.Start(s.ctx, jetID, pn)
+	_, err := sync.Start(s.ctx, jetID, pn, nil)
 	require.NoError(s.T(), err)
 	state := sync.getJetSyncState(s.ctx, jetID)
 	state.Lock()
@@ -308,6 +309,93 @@ func (s *heavysyncSuite) TestHeavy_Timeout() {
 	state.Unlock()
 }
 
+func (s *heavysyncSuite) TestHeavy_ResumeChunks() {
+	pn := insolar.PulseNumber(insolar.FirstPulseNumber + 1)
+	jetID := testutils.RandomJet()
+	otherJetID := testutils.RandomJet()
+
+	sync := NewSync(s.db, s.records)
+	sync.ReplicaStorage = s.replicaStorage
+
+	_, err := sync.NextChunk(s.ctx, jetID, pn)
+	require.Error(s.T(), err, "next chunk on non started sync")
+	_, err = sync.AckChunk(s.ctx, jetID, pn, 0)
+	require.Error(s.T(), err, "ack chunk on non started sync")
+
+	digest := []byte("payload")
+	next, err := sync.Start(s.ctx, jetID, pn, digest)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), uint32(0), next)
+
+	next, err = sync.AckChunk(s.ctx, jetID, pn, 0)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), uint32(1), next)
+	next, err = sync.AckChunk(s.ctx, jetID, pn, 1)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), uint32(2), next)
+
+	next, err = sync.AckChunk(s.ctx, jetID, pn, 5)
+	require.Error(s.T(), err, "ack of unexpected chunk")
+	require.Equal(s.T(), uint32(2), next)
+
+	_, err = sync.NextChunk(s.ctx, otherJetID, pn)
+	require.Error(s.T(), err, "next chunk of other jet")
+
+	timeout := func() {
+		state := sync.getJetSyncState(s.ctx, jetID)
+		state.Lock()
+		state.timer.Reset(0)
+		state.Unlock()
+		time.Sleep(time.Second)
+	}
+
+	// restart after timeout resumes from the next chunk
+	timeout()
+	_, err = sync.NextChunk(s.ctx, jetID, pn)
+	require.Error(s.T(), err, "next chunk after timeout")
+
+	next, err = sync.Start(s.ctx, jetID, pn, digest)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), uint32(2), next)
+
+	next, err = sync.NextChunk(s.ctx, jetID, pn)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), uint32(2), next)
+
+	// restart with other payload digest starts from the first chunk
+	timeout()
+	next, err = sync.Start(s.ctx, jetID, pn, []byte("other payload"))
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), uint32(0), next)
+	for chunk := uint32(0); chunk < 2; chunk++ {
+		_, err = sync.AckChunk(s.ctx, jetID, pn, chunk)
+		require.NoError(s.T(), err)
+	}
+
+	// sync isn't finished until all chunks are stored
+	err = sync.Stop(s.ctx, jetID, pn, 3)
+	require.Error(s.T(), err)
+	herr, ok := err.(*reply.HeavyError)
+	require.True(s.T(), ok)
+	require.True(s.T(), herr.IsRetryable())
+
+	next, err = sync.Start(s.ctx, jetID, pn, digest)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), uint32(0), next)
+	for chunk := uint32(0); chunk < 3; chunk++ {
+		_, err = sync.AckChunk(s.ctx, jetID, pn, chunk)
+		require.NoError(s.T(), err)
+	}
+
+	// finished sync drops chunks progress
+	err = sync.Stop(s.ctx, jetID, pn, 3)
+	require.NoError(s.T(), err)
+
+	next, err = sync.Start(s.ctx, jetID, pn+1, nil)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), uint32(0), next)
+}
+
 func preparepulse(s *heavysyncSuite, pn insolar.PulseNumber) {
 	pulse := insolar.Pulse{PulseNumber: pn}
 	err := s.pulseAppender.Append(s.ctx, pulse)
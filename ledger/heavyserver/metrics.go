@@ -34,6 +34,7 @@ var (
 	statSyncedPulse   = stats.Int64("heavyserver/synced/pulse", "Last synced pulse", stats.UnitDimensionless)
 	statSyncedBytes   = stats.Int64("heavyserver/synced/bytes", "Amount of synced records in bytes", stats.UnitBytes)
 	statSyncedTimeout = stats.Int64("heavyserver/synced/timeout", "Number of timeouts on sync", stats.UnitDimensionless)
+	statSyncedChunks  = stats.Int64("heavyserver/synced/chunks", "Number of acknowledged payload chunks", stats.UnitDimensionless)
 )
 
 func init() {
@@ -74,6 +75,13 @@ func init() {
 			Aggregation: view.Count(),
 			TagKeys:     commontags,
 		},
+		&view.View{
+			Name:        statSyncedChunks.Name(),
+			Description: statSyncedChunks.Description(),
+			Measure:     statSyncedChunks,
+			Aggregation: view.Count(),
+			TagKeys:     commontags,
+		},
 	)
 	if err != nil {
 		panic(err)
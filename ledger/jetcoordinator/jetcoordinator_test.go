@@ -130,6 +130,77 @@ func (s *jetCoordinatorSuite) TestJetCoordinator_QueryRole() {
 	assert.Equal(s.T(), []insolar.Reference{nodeRefs[16], nodeRefs[21], nodeRefs[78]}, selected)
 }
 
+func (s *jetCoordinatorSuite) TestJetCoordinator_LightExecutorForObject_AcrossMerge() {
+	var (
+		beforeMerge = insolar.PulseNumber(insolar.FirstPulseNumber + 10)
+		afterMerge  = insolar.PulseNumber(insolar.FirstPulseNumber + 20)
+		notified    = insolar.PulseNumber(insolar.FirstPulseNumber + 30)
+	)
+	for i, pn := range []insolar.PulseNumber{beforeMerge, afterMerge, notified} {
+		err := s.pulseAppender.Append(s.ctx, insolar.Pulse{PulseNumber: pn, Entropy: insolar.Entropy{byte(i), 2, 3}})
+		require.NoError(s.T(), err)
+	}
+	var nds []insolar.Node
+	for i := 0; i < 100; i++ {
+		ref := *insolar.NewReference(insolar.DomainID, *insolar.NewID(0, []byte{byte(i)}))
+		nds = append(nds, insolar.Node{ID: ref, Role: insolar.StaticRoleLightMaterial})
+	}
+	s.nodeStorage.InRoleMock.Return(nds, nil)
+
+	var (
+		leftJet   = jet.NewIDFromString("10")
+		rightJet  = jet.NewIDFromString("11")
+		parentJet = jet.NewIDFromString("1")
+		otherJet  = jet.NewIDFromString("0")
+
+		leftObj  = *insolar.NewID(beforeMerge, []byte{0x80}) // 10000000
+		rightObj = *insolar.NewID(beforeMerge, []byte{0xC0}) // 11000000
+		otherObj = *insolar.NewID(beforeMerge, []byte{0x40}) // 01000000
+	)
+	s.jetStorage.Update(s.ctx, beforeMerge, true, otherJet, leftJet, rightJet)
+
+	// assertRouting checks that object is routed to executor of expected jet.
+	assertRouting := func(objID insolar.ID, jetID insolar.JetID, pn insolar.PulseNumber) {
+		expected, err := s.coordinator.LightExecutorForJet(s.ctx, insolar.ID(jetID), pn)
+		require.NoError(s.T(), err)
+
+		executor, err := s.coordinator.LightExecutorForObject(s.ctx, objID, pn)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), expected, executor, "object %v in pulse %v", objID.DebugString(), pn)
+
+		selected, err := s.coordinator.QueryRole(s.ctx, insolar.DynamicRoleLightExecutor, objID, pn)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), []insolar.Reference{*expected}, selected)
+	}
+
+	assertRouting(leftObj, leftJet, beforeMerge)
+	assertRouting(rightObj, rightJet, beforeMerge)
+	assertRouting(otherObj, otherJet, beforeMerge)
+
+	// Merge is performed by the last executor of both jets.
+	s.jetStorage.Clone(s.ctx, beforeMerge, afterMerge)
+	merged, err := s.jetStorage.Merge(s.ctx, afterMerge, leftJet, rightJet)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), parentJet, merged)
+	s.jetStorage.Update(s.ctx, afterMerge, true, merged)
+
+	assertRouting(leftObj, parentJet, afterMerge)
+	assertRouting(rightObj, parentJet, afterMerge)
+	assertRouting(otherObj, otherJet, afterMerge)
+
+	// Past pulse routing is not affected by merge.
+	assertRouting(leftObj, leftJet, beforeMerge)
+	assertRouting(rightObj, rightJet, beforeMerge)
+
+	// Other nodes learn about merge from hot data or jet request, when merged jet is set actual.
+	s.jetStorage.Clone(s.ctx, beforeMerge, notified)
+	s.jetStorage.Update(s.ctx, notified, true, parentJet)
+
+	assertRouting(leftObj, parentJet, notified)
+	assertRouting(rightObj, parentJet, notified)
+	assertRouting(otherObj, otherJet, notified)
+}
+
 func TestJetCoordinator_Me(t *testing.T) {
 	t.Parallel()
 	// Arrange
@@ -25,6 +25,7 @@ import (
 	"github.com/insolar/insolar/insolar/jet"
 	"github.com/insolar/insolar/internal/ledger/store"
 	"github.com/insolar/insolar/ledger/artifactmanager"
+	"github.com/insolar/insolar/ledger/exporter"
 	"github.com/insolar/insolar/ledger/heavy"
 	"github.com/insolar/insolar/ledger/heavyserver"
 	"github.com/insolar/insolar/ledger/jetcoordinator"
@@ -42,12 +43,12 @@ import (
 func GetLedgerComponents(conf configuration.Ledger, certificate insolar.Certificate) []interface{} {
 	idLocker := storage.NewIDLocker()
 
-	legacyDB, err := storage.NewDB(conf, nil)
+	legacyDB, err := storage.NewDB(conf)
 	if err != nil {
 		panic(errors.Wrap(err, "failed to initialize DB"))
 	}
 
-	db, err := store.NewBadgerDB(conf.Storage.DataDirectoryNewDB)
+	db, err := store.Open(conf.Storage.Engine, conf.Storage.DataDirectoryNewDB)
 	if err != nil {
 		panic(errors.Wrap(err, "failed to initialize DB"))
 	}
@@ -70,6 +71,12 @@ func GetLedgerComponents(conf configuration.Ledger, certificate insolar.Certific
 	var recordAccessor object.RecordAccessor
 	var recSyncAccessor object.RecordCollectionAccessor
 	var recordCleaner object.RecordCleaner
+	var resultAccessor object.ResultAccessor
+	var eventAccessor object.EventAccessor
+	var eventModifier object.EventModifier
+	var dropRecordsAccessor object.DropRecordsAccessor
+
+	var storageExporter insolar.StorageExporter
 	// Comparision with insolar.StaticRoleUnknown is a hack for genesis pulse (INS-1537)
 	switch certificate.GetRole() {
 	case insolar.StaticRoleUnknown, insolar.StaticRoleHeavyMaterial:
@@ -90,6 +97,12 @@ func GetLedgerComponents(conf configuration.Ledger, certificate insolar.Certific
 		records := object.NewRecordDB(db)
 		recordModifier = records
 		recordAccessor = records
+		resultAccessor = records
+		eventAccessor = records
+		eventModifier = records
+		dropRecordsAccessor = records
+
+		storageExporter = exporter.NewExporter(db, conf.Exporter)
 	default:
 		ps := pulse.NewStorageMem()
 		pulseAccessor = ps
@@ -113,9 +126,29 @@ func GetLedgerComponents(conf configuration.Ledger, certificate insolar.Certific
 		recordAccessor = records
 		recSyncAccessor = records
 		recordCleaner = records
+		resultAccessor = records
+		eventAccessor = records
+		eventModifier = records
+		dropRecordsAccessor = records
+
+		storageExporter = exporter.NewExporter(nil, conf.Exporter)
+	}
+
+	splitPolicy, err := pulsemanager.NewSplitPolicy(conf)
+	if err != nil {
+		panic(errors.Wrap(err, "failed to initialize jet split policy"))
 	}
 
-	pm := pulsemanager.NewPulseManager(conf, dropCleaner, blobCleaner, blobCollectionAccessor, pulseShifter, recordCleaner, recSyncAccessor)
+	pm := pulsemanager.NewPulseManager(
+		conf,
+		dropCleaner,
+		blobCleaner,
+		blobCollectionAccessor,
+		pulseShifter,
+		recordCleaner,
+		recSyncAccessor,
+		splitPolicy,
+	)
 
 	components := []interface{}{
 		legacyDB,
@@ -130,6 +163,11 @@ func GetLedgerComponents(conf configuration.Ledger, certificate insolar.Certific
 		pulseCalculator,
 		recordModifier,
 		recordAccessor,
+		resultAccessor,
+		eventAccessor,
+		eventModifier,
+		dropRecordsAccessor,
+		storageExporter,
 		storage.NewCleaner(),
 		jet.NewStore(),
 		node.NewStorage(),
@@ -148,7 +186,7 @@ func GetLedgerComponents(conf configuration.Ledger, certificate insolar.Certific
 		components = append(components, pm)
 	case insolar.StaticRoleHeavyMaterial:
 		components = append(components, pm)
-		components = append(components, heavy.Components()...)
+		components = append(components, heavy.Components(conf)...)
 	}
 
 	return components
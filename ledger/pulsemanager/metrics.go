@@ -19,15 +19,29 @@ package pulsemanager
 import (
 	"go.opencensus.io/stats"
 	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+
+	"github.com/insolar/insolar/instrumentation/insmetrics"
+)
+
+var (
+	tagJet         = insmetrics.MustTagKey("jet")
+	tagSplitPolicy = insmetrics.MustTagKey("split_policy")
 )
 
 var (
 	statCleanLatencyTotal = stats.Int64("lightcleanup/latency/total", "Light storage cleanup time in milliseconds", stats.UnitMilliseconds)
 	statHotObjectsSent    = stats.Int64("hotdata/objects/total", "Amount of hot objects sent to the next executor", stats.UnitDimensionless)
 	statPendingSent       = stats.Int64("hotdata/pending/total", "Amount of pending requests sent to the next executor", stats.UnitDimensionless)
+
+	statJetRecords     = stats.Int64("jet/load/records", "Amount of records saved in jet drop", stats.UnitDimensionless)
+	statJetSize        = stats.Int64("jet/load/size", "Size of records and blobs saved in jet drop", stats.UnitBytes)
+	statJetRequestRate = stats.Float64("jet/load/requests/rate", "Amount of requests per second registered in jet", stats.UnitDimensionless)
+	statJetSplit       = stats.Int64("jet/split", "Split policy decision for jet, 1 if jet is split", stats.UnitDimensionless)
 )
 
 func init() {
+	jetTags := []tag.Key{tagJet, tagSplitPolicy}
 	err := view.Register(
 
 		&view.View{
@@ -50,6 +64,42 @@ func init() {
 			Measure:     statPendingSent,
 			Aggregation: view.Sum(),
 		},
+
+		&view.View{
+			Name:        statJetRecords.Name(),
+			Description: statJetRecords.Description(),
+			Measure:     statJetRecords,
+			Aggregation: view.LastValue(),
+			TagKeys:     jetTags,
+		},
+		&view.View{
+			Name:        statJetSize.Name(),
+			Description: statJetSize.Description(),
+			Measure:     statJetSize,
+			Aggregation: view.LastValue(),
+			TagKeys:     jetTags,
+		},
+		&view.View{
+			Name:        statJetRequestRate.Name(),
+			Description: statJetRequestRate.Description(),
+			Measure:     statJetRequestRate,
+			Aggregation: view.LastValue(),
+			TagKeys:     jetTags,
+		},
+		&view.View{
+			Name:        "jet/split/decision",
+			Description: "Last split policy decision for jet, 1 if jet was split",
+			Measure:     statJetSplit,
+			Aggregation: view.LastValue(),
+			TagKeys:     jetTags,
+		},
+		&view.View{
+			Name:        "jet/split/total",
+			Description: "Amount of splits performed for jet",
+			Measure:     statJetSplit,
+			Aggregation: view.Sum(),
+			TagKeys:     jetTags,
+		},
 	)
 	if err != nil {
 		panic(err)
@@ -19,7 +19,6 @@ package pulsemanager
 import (
 	"context"
 	"fmt"
-	"math/rand"
 	"sync"
 	"time"
 
@@ -36,6 +35,7 @@ import (
 	"github.com/insolar/insolar/insolar/message"
 	"github.com/insolar/insolar/insolar/reply"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/instrumentation/insmetrics"
 	"github.com/insolar/insolar/instrumentation/instracer"
 	"github.com/insolar/insolar/ledger/artifactmanager"
 	"github.com/insolar/insolar/ledger/heavyclient"
@@ -76,8 +76,9 @@ type PulseManager struct {
 	DBContext      storage.DBContext      `inject:""`
 	StorageCleaner storage.Cleaner        `inject:""`
 
-	DropModifier drop.Modifier `inject:""`
-	DropAccessor drop.Accessor `inject:""`
+	DropModifier drop.Modifier              `inject:""`
+	DropAccessor drop.Accessor              `inject:""`
+	DropRecords  object.DropRecordsAccessor `inject:""`
 	DropCleaner  drop.Cleaner
 
 	PulseAccessor   pulse.Accessor   `inject:""`
@@ -93,6 +94,8 @@ type PulseManager struct {
 
 	syncClientsPool *heavyclient.Pool
 
+	splitPolicy SplitPolicy
+
 	currentPulse insolar.Pulse
 
 	// setLock locks Set method call.
@@ -109,15 +112,20 @@ type jetInfo struct {
 	mineNext bool
 	left     *jetInfo
 	right    *jetInfo
+	// sibling and parent are set if jet was merged with its right sibling.
+	sibling *jetInfo
+	parent  *jetInfo
 }
 
 // Just store ledger configuration in PM. This is not required.
 type pmOptions struct {
 	enableSync            bool
-	splitThreshold        uint64
+	splitPolicy           string
 	dropHistorySize       int
 	storeLightPulses      int
 	heavySyncMessageLimit int
+	heavySyncCompressions []string
+	heavySyncChunkRetries int
 	lightChainLimit       int
 }
 
@@ -130,6 +138,7 @@ func NewPulseManager(
 	pulseShifter pulse.Shifter,
 	recCleaner object.RecordCleaner,
 	recSyncAccessor object.RecordCollectionAccessor,
+	splitPolicy SplitPolicy,
 ) *PulseManager {
 	pmconf := conf.PulseManager
 
@@ -137,9 +146,11 @@ func NewPulseManager(
 		currentPulse: *insolar.GenesisPulse,
 		options: pmOptions{
 			enableSync:            pmconf.HeavySyncEnabled,
-			splitThreshold:        pmconf.SplitThreshold,
+			splitPolicy:           conf.JetSplit.Policy,
 			storeLightPulses:      conf.LightChainLimit,
 			heavySyncMessageLimit: pmconf.HeavySyncMessageLimit,
+			heavySyncCompressions: pmconf.HeavySyncCompressions,
+			heavySyncChunkRetries: pmconf.HeavySyncChunkRetries,
 			lightChainLimit:       conf.LightChainLimit,
 		},
 		DropCleaner:      dropCleaner,
@@ -148,6 +159,7 @@ func NewPulseManager(
 		PulseShifter:     pulseShifter,
 		RecCleaner:       recCleaner,
 		RecSyncAccessor:  recSyncAccessor,
+		splitPolicy:      splitPolicy,
 	}
 	return pm
 }
@@ -190,7 +202,39 @@ func (m *PulseManager) processEndPulse(
 				}
 			}
 
-			if info.left == nil && info.right == nil {
+			if info.parent != nil {
+				siblingDrop, siblingSerialized, _, err := m.createDrop(
+					ctx, insolar.ID(info.sibling.id), prevPulseNumber, currentPulse.PulseNumber,
+				)
+				if err != nil {
+					return errors.Wrapf(err, "create drop on pulse %v failed", currentPulse.PulseNumber)
+				}
+				msg, err := m.getExecutorHotData(
+					ctx, insolar.ID(info.id), newPulse.PulseNumber, drop, dropSerialized,
+				)
+				if err != nil {
+					return errors.Wrapf(err, "getExecutorData failed for jet id %v", info.id)
+				}
+				siblingMsg, err := m.getExecutorHotData(
+					ctx, insolar.ID(info.sibling.id), newPulse.PulseNumber, siblingDrop, siblingSerialized,
+				)
+				if err != nil {
+					return errors.Wrapf(err, "getExecutorData failed for jet id %v", info.sibling.id)
+				}
+				// Merge happened. Hot data of both jets is sent as one message, so the parent is unlocked at once.
+				// Both drops are sent, next executor chains the parent drop to them.
+				msg.SiblingDrop = siblingDrop
+				for id, index := range siblingMsg.RecentObjects {
+					msg.RecentObjects[id] = index
+				}
+				for id, pending := range siblingMsg.PendingRequests {
+					msg.PendingRequests[id] = pending
+				}
+				if !info.parent.mineNext {
+					go sender(*msg, info.parent.id)
+				}
+				m.RecentStorageProvider.RemovePendingStorage(ctx, insolar.ID(info.sibling.id))
+			} else if info.left == nil && info.right == nil {
 				msg, err := m.getExecutorHotData(
 					ctx, insolar.ID(info.id), newPulse.PulseNumber, drop, dropSerialized,
 				)
@@ -240,9 +284,20 @@ func (m *PulseManager) createDrop(
 	messages [][]byte,
 	err error,
 ) {
+	ids, err := m.DropRecords.ForDrop(ctx, insolar.JetID(jetID), currentPulse)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "[ createDrop ] Can't get drop records")
+	}
+
+	prevHash := m.prevDropHash(ctx, insolar.JetID(jetID), prevPulse)
+	root := drop.RecordsRoot(m.PlatformCryptographyScheme, ids)
 	block = &drop.Drop{
-		Pulse: currentPulse,
-		JetID: insolar.JetID(jetID),
+		Pulse:      currentPulse,
+		JetID:      insolar.JetID(jetID),
+		PrevHash:   prevHash,
+		Hash:       drop.ChainHash(m.PlatformCryptographyScheme, prevHash, root),
+		MerkleRoot: root,
+		Size:       m.measureLoad(ctx, insolar.JetID(jetID), currentPulse).Size,
 	}
 
 	err = m.DropModifier.Set(ctx, *block)
@@ -254,6 +309,60 @@ func (m *PulseManager) createDrop(
 	return
 }
 
+// prevDropHash returns hash of the drop which the drop of the jet continues. It is the drop of the same jet or of its
+// parent jet if the jet was split in previous pulse. If jet children were merged, it is hash of both children drops.
+// Nil is returned if previous drop is unknown to the node.
+func (m *PulseManager) prevDropHash(ctx context.Context, jetID insolar.JetID, prevPulse insolar.PulseNumber) []byte {
+	for id := jetID; ; id = jet.Parent(id) {
+		prev, err := m.DropAccessor.ForPulse(ctx, id, prevPulse)
+		if err == nil {
+			return prev.Hash
+		}
+		if id.Depth() == 0 {
+			break
+		}
+	}
+
+	left, right := jet.Siblings(jetID)
+	leftDrop, leftErr := m.DropAccessor.ForPulse(ctx, left, prevPulse)
+	rightDrop, rightErr := m.DropAccessor.ForPulse(ctx, right, prevPulse)
+	if leftErr == nil && rightErr == nil {
+		return drop.ChainHash(m.PlatformCryptographyScheme, leftDrop.Hash, rightDrop.Hash)
+	}
+
+	inslogger.FromContext(ctx).Warnf("no drop of jet %s in previous pulse %v", jetID.DebugString(), prevPulse)
+	return nil
+}
+
+// measureLoad returns load of records and blobs saved in provided jet in provided pulse.
+func (m *PulseManager) measureLoad(ctx context.Context, jetID insolar.JetID, pn insolar.PulseNumber) JetLoad {
+	load := JetLoad{JetID: jetID}
+	for _, rec := range m.RecSyncAccessor.ForPulse(ctx, jetID, pn) {
+		load.Records++
+		load.Size += uint64(len(object.EncodeMaterial(rec)))
+		if _, ok := rec.Record.(*object.RequestRecord); ok {
+			load.Requests++
+		}
+	}
+	for _, b := range m.BlobSyncAccessor.ForPulse(ctx, jetID, pn) {
+		load.Size += uint64(len(b.Value))
+	}
+	return load
+}
+
+// pulseDuration returns duration between provided pulses or zero if it's unknown.
+func (m *PulseManager) pulseDuration(ctx context.Context, from, to insolar.PulseNumber) time.Duration {
+	fromPulse, err := m.PulseAccessor.ForPulseNumber(ctx, from)
+	if err != nil {
+		return 0
+	}
+	toPulse, err := m.PulseAccessor.ForPulseNumber(ctx, to)
+	if err != nil {
+		return 0
+	}
+	return time.Duration(toPulse.PulseTimestamp-fromPulse.PulseTimestamp) * time.Second
+}
+
 func (m *PulseManager) getExecutorHotData(
 	ctx context.Context,
 	jetID insolar.ID,
@@ -308,8 +417,6 @@ func (m *PulseManager) getExecutorHotData(
 	return msg, nil
 }
 
-var splitCount = 5
-
 func (m *PulseManager) processJets(ctx context.Context, currentPulse, newPulse insolar.PulseNumber) ([]jetInfo, error) {
 	ctx, span := instracer.StartSpan(ctx, "jets.process")
 	defer span.End()
@@ -327,8 +434,22 @@ func (m *PulseManager) processJets(ctx context.Context, currentPulse, newPulse i
 		"current_pulse": currentPulse,
 		"new_pulse":     newPulse,
 	})
-	indexToSplit := rand.Intn(len(jetIDs))
-	for i, jetID := range jetIDs {
+	duration := m.pulseDuration(ctx, currentPulse, newPulse)
+	merges, err := m.jetsToMerge(ctx, jetIDs, currentPulse, duration)
+	if err != nil {
+		return nil, err
+	}
+	mergedRight := make(map[insolar.JetID]struct{}, len(merges))
+	for _, right := range merges {
+		mergedRight[right] = struct{}{}
+	}
+
+	for _, jetID := range jetIDs {
+		// Right sibling is processed together with the left one.
+		if _, ok := mergedRight[jetID]; ok {
+			continue
+		}
+
 		wasExecutor := false
 		executor, err := m.JetCoordinator.LightExecutorForJet(ctx, insolar.ID(jetID), currentPulse)
 		if err != nil && err != node.ErrNoNodes {
@@ -346,9 +467,36 @@ func (m *PulseManager) processJets(ctx context.Context, currentPulse, newPulse i
 		}
 
 		info := jetInfo{id: jetID}
-		if indexToSplit == i && splitCount > 0 {
-			splitCount--
+		if rightJetID, ok := merges[jetID]; ok {
+			parentJetID, err := m.JetModifier.Merge(ctx, newPulse, jetID, rightJetID)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to merge jet tree")
+			}
+
+			// Set actual because we are the last executor for both jets.
+			m.JetModifier.Update(ctx, newPulse, true, parentJetID)
 
+			info.sibling = &jetInfo{id: rightJetID}
+			info.parent = &jetInfo{id: parentJetID}
+			nextExecutor, err := m.JetCoordinator.LightExecutorForJet(ctx, insolar.ID(parentJetID), newPulse)
+			if err != nil {
+				return nil, err
+			}
+			if *nextExecutor == me {
+				info.parent.mineNext = true
+				for _, childJetID := range []insolar.JetID{jetID, rightJetID} {
+					err := m.rewriteHotData(ctx, insolar.ID(childJetID), insolar.ID(parentJetID))
+					if err != nil {
+						return nil, err
+					}
+				}
+			}
+
+			logger.WithFields(map[string]interface{}{
+				"sibling": rightJetID.DebugString(),
+				"parent":  parentJetID.DebugString(),
+			}).Info("jet merge performed")
+		} else if m.shouldSplit(ctx, jetID, currentPulse, duration) {
 			leftJetID, rightJetID, err := m.JetModifier.Split(
 				ctx,
 				newPulse,
@@ -407,6 +555,90 @@ func (m *PulseManager) processJets(ctx context.Context, currentPulse, newPulse i
 	return results, nil
 }
 
+// shouldSplit measures load of the jet in current pulse and asks split policy whether the jet should be split.
+// Both load and decision are recorded to metrics.
+func (m *PulseManager) shouldSplit(
+	ctx context.Context, jetID insolar.JetID, currentPulse insolar.PulseNumber, duration time.Duration,
+) bool {
+	load := m.measureLoad(ctx, jetID, currentPulse)
+	load.Duration = duration
+	split := m.splitPolicy.Split(ctx, load)
+
+	ctx = insmetrics.InsertTag(ctx, tagJet, jetID.DebugString())
+	ctx = insmetrics.InsertTag(ctx, tagSplitPolicy, m.options.splitPolicy)
+	decision := int64(0)
+	if split {
+		decision = 1
+	}
+	stats.Record(
+		ctx,
+		statJetRecords.M(int64(load.Records)),
+		statJetSize.M(int64(load.Size)),
+		statJetRequestRate.M(load.RequestRate()),
+		statJetSplit.M(decision),
+	)
+
+	return split
+}
+
+// jetsToMerge returns sibling jets (left to right) which should be merged in new pulse. Siblings are merged
+// only if split policy allows it and current node executed both of them, so the decision doesn't require agreement
+// with other nodes.
+func (m *PulseManager) jetsToMerge(
+	ctx context.Context, jetIDs []insolar.JetID, currentPulse insolar.PulseNumber, duration time.Duration,
+) (map[insolar.JetID]insolar.JetID, error) {
+	merges := map[insolar.JetID]insolar.JetID{}
+	leaves := make(map[insolar.JetID]struct{}, len(jetIDs))
+	for _, jetID := range jetIDs {
+		leaves[jetID] = struct{}{}
+	}
+
+	me := m.JetCoordinator.Me()
+	isMine := func(jetID insolar.JetID) (bool, error) {
+		executor, err := m.JetCoordinator.LightExecutorForJet(ctx, insolar.ID(jetID), currentPulse)
+		if err == node.ErrNoNodes {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		return *executor == me, nil
+	}
+
+	for _, jetID := range jetIDs {
+		if jetID.Depth() == 0 {
+			continue
+		}
+		left, right := jet.Siblings(jet.Parent(jetID))
+		if jetID != left {
+			continue
+		}
+		if _, ok := leaves[right]; !ok {
+			continue
+		}
+
+		leftLoad, rightLoad := m.measureLoad(ctx, left, currentPulse), m.measureLoad(ctx, right, currentPulse)
+		leftLoad.Duration, rightLoad.Duration = duration, duration
+		if !m.splitPolicy.Merge(ctx, leftLoad, rightLoad) {
+			continue
+		}
+
+		leftMine, err := isMine(left)
+		if err != nil {
+			return nil, err
+		}
+		rightMine, err := isMine(right)
+		if err != nil {
+			return nil, err
+		}
+		if leftMine && rightMine {
+			merges[left] = right
+		}
+	}
+
+	return merges, nil
+}
+
 func (m *PulseManager) rewriteHotData(ctx context.Context, fromJetID, toJetID insolar.ID) error {
 	indexStorage := m.RecentStorageProvider.GetIndexStorage(ctx, fromJetID)
 
@@ -599,6 +831,9 @@ func (m *PulseManager) addSync(ctx context.Context, jets []jetInfo, pulse insola
 
 	for _, jInfo := range jets {
 		m.syncClientsPool.AddPulsesToSyncClient(ctx, insolar.ID(jInfo.id), true, pulse)
+		if jInfo.sibling != nil {
+			m.syncClientsPool.AddPulsesToSyncClient(ctx, insolar.ID(jInfo.sibling.id), true, pulse)
+		}
 	}
 }
 
@@ -663,7 +898,15 @@ func (m *PulseManager) prepareArtifactManagerMessageHandlerForNextPulse(ctx cont
 
 	logger := inslogger.FromContext(ctx)
 	for _, jetInfo := range jets {
-		if jetInfo.left == nil && jetInfo.right == nil {
+		if jetInfo.parent != nil {
+			// Merge happened.
+			if jetInfo.parent.mineNext {
+				err := m.HotDataWaiter.Unlock(ctx, insolar.ID(jetInfo.parent.id))
+				if err != nil {
+					logger.Error(err)
+				}
+			}
+		} else if jetInfo.left == nil && jetInfo.right == nil {
 			// No split happened.
 			if jetInfo.mineNext {
 				err := m.HotDataWaiter.Unlock(ctx, insolar.ID(jetInfo.id))
@@ -711,6 +954,8 @@ func (m *PulseManager) Start(ctx context.Context) error {
 			heavyclient.Options{
 				SyncMessageLimit: m.options.heavySyncMessageLimit,
 				PulsesDeltaLimit: m.options.lightChainLimit,
+				Compressions:     m.options.heavySyncCompressions,
+				ChunkRetries:     m.options.heavySyncChunkRetries,
 			},
 		)
 		m.syncClientsPool = heavySyncPool
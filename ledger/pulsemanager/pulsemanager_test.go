@@ -0,0 +1,109 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package pulsemanager
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/recentstorage"
+	"github.com/insolar/insolar/ledger/storage/blob"
+	"github.com/insolar/insolar/ledger/storage/drop"
+	"github.com/insolar/insolar/ledger/storage/object"
+	"github.com/insolar/insolar/platformpolicy"
+	"github.com/insolar/insolar/testutils"
+)
+
+func newTestPulseManager(bus insolar.MessageBus) *PulseManager {
+	drops := drop.NewStorageMemory()
+	records := object.NewRecordMemory()
+	return &PulseManager{
+		Bus:                        bus,
+		PlatformCryptographyScheme: platformpolicy.NewPlatformCryptographyScheme(),
+		RecentStorageProvider:      recentstorage.NewRecentStorageProvider(10),
+		DropModifier:               drops,
+		DropAccessor:               drops,
+		DropRecords:                records,
+		RecSyncAccessor:            records,
+		BlobSyncAccessor:           blob.NewStorageMemory(),
+	}
+}
+
+func TestPulseManager_processEndPulse_MergeToOtherExecutor(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	prevPulse := insolar.PulseNumber(insolar.FirstPulseNumber)
+	currentPulse := insolar.Pulse{PulseNumber: prevPulse + 10}
+	newPulse := insolar.Pulse{PulseNumber: prevPulse + 20}
+	parent := *insolar.NewJetID(0, nil)
+	left, right := jet.Siblings(parent)
+
+	sent := make(chan *message.HotData, 1)
+	bus := testutils.NewMessageBusMock(mc)
+	bus.SendFunc = func(ctx context.Context, msg insolar.Message, _ *insolar.MessageSendOptions) (insolar.Reply, error) {
+		sent <- msg.(*message.HotData)
+		return &reply.OK{}, nil
+	}
+
+	pm := newTestPulseManager(bus)
+	require.NoError(t, pm.DropModifier.Set(ctx, drop.Drop{Pulse: prevPulse, JetID: left, Hash: []byte{1}}))
+	require.NoError(t, pm.DropModifier.Set(ctx, drop.Drop{Pulse: prevPulse, JetID: right, Hash: []byte{2}}))
+
+	jets := []jetInfo{{
+		id:      left,
+		sibling: &jetInfo{id: right},
+		parent:  &jetInfo{id: parent},
+	}}
+	err := pm.processEndPulse(ctx, jets, prevPulse, currentPulse, newPulse)
+	require.NoError(t, err)
+
+	var msg *message.HotData
+	select {
+	case msg = <-sent:
+	case <-time.After(time.Minute):
+		t.Fatal("hot data isn't sent")
+	}
+	require.Equal(t, insolar.ID(parent), *msg.Jet.Record())
+
+	leftDrop, err := pm.DropAccessor.ForPulse(ctx, left, currentPulse.PulseNumber)
+	require.NoError(t, err)
+	rightDrop, err := pm.DropAccessor.ForPulse(ctx, right, currentPulse.PulseNumber)
+	require.NoError(t, err)
+	require.Equal(t, leftDrop, msg.Drop)
+	require.NotNil(t, msg.SiblingDrop)
+	require.Equal(t, rightDrop, *msg.SiblingDrop)
+
+	// Next executor of the parent knows child drops only from hot data.
+	next := newTestPulseManager(nil)
+	require.NoError(t, next.DropModifier.Set(ctx, msg.Drop))
+	require.NoError(t, next.DropModifier.Set(ctx, *msg.SiblingDrop))
+
+	parentDrop, _, _, err := next.createDrop(ctx, insolar.ID(parent), currentPulse.PulseNumber, newPulse.PulseNumber)
+	require.NoError(t, err)
+	require.Equal(t, drop.ChainHash(next.PlatformCryptographyScheme, leftDrop.Hash, rightDrop.Hash), parentDrop.PrevHash)
+}
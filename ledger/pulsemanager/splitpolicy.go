@@ -0,0 +1,154 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package pulsemanager
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+)
+
+// Split policy names available in configuration.
+const (
+	SplitPolicyRecords = "records"
+	SplitPolicySize    = "size"
+	SplitPolicyRate    = "rate"
+	SplitPolicyDepth   = "depth"
+)
+
+// JetLoad is a load of the jet measured in the finished pulse.
+type JetLoad struct {
+	JetID insolar.JetID
+	// Records is a count of records saved in the jet drop.
+	Records int
+	// Size is a physical size of records and blobs saved in the jet drop.
+	Size uint64
+	// Requests is a count of requests registered in the jet.
+	Requests int
+	// Duration is a duration of the pulse. Zero value means it's unknown.
+	Duration time.Duration
+}
+
+// RequestRate returns count of requests per second registered in the jet.
+func (l JetLoad) RequestRate() float64 {
+	if l.Duration <= 0 {
+		return float64(l.Requests)
+	}
+	return float64(l.Requests) / l.Duration.Seconds()
+}
+
+// SplitPolicy decides whether the jet should be split and whether sibling jets should be merged in the new pulse.
+// Merge decision must not contradict split decision for the merged load, otherwise jets are split and merged in turn.
+type SplitPolicy interface {
+	Split(ctx context.Context, load JetLoad) bool
+	Merge(ctx context.Context, left, right JetLoad) bool
+}
+
+// NewSplitPolicy creates split policy selected in configuration.
+func NewSplitPolicy(conf configuration.Ledger) (SplitPolicy, error) {
+	var policy SplitPolicy
+	switch conf.JetSplit.Policy {
+	case SplitPolicyRecords:
+		policy = recordsPolicy{threshold: conf.JetSplit.RecordsThreshold}
+	case SplitPolicySize:
+		split, merge := conf.PulseManager.SplitThreshold, conf.PulseManager.MergeThreshold
+		if merge != 0 && merge >= split {
+			return nil, errors.Errorf("merge threshold %v isn't less than split threshold %v", merge, split)
+		}
+		policy = sizePolicy{threshold: split, mergeThreshold: merge}
+	case SplitPolicyRate:
+		policy = ratePolicy{threshold: conf.JetSplit.RateThreshold}
+	case SplitPolicyDepth:
+		return depthPolicy{depth: conf.JetSplit.Depth}, nil
+	default:
+		return nil, errors.Errorf("unknown jet split policy %q", conf.JetSplit.Policy)
+	}
+
+	if conf.JetSplit.MaxDepth == 0 {
+		return policy, nil
+	}
+	return maxDepthPolicy{policy: policy, depth: conf.JetSplit.MaxDepth}, nil
+}
+
+type recordsPolicy struct {
+	threshold int
+}
+
+func (p recordsPolicy) Split(ctx context.Context, load JetLoad) bool {
+	return load.Records > p.threshold
+}
+
+func (p recordsPolicy) Merge(ctx context.Context, left, right JetLoad) bool {
+	return false
+}
+
+// sizePolicy merges siblings if their combined size is less than merge threshold. Zero merge threshold disables merge.
+type sizePolicy struct {
+	threshold      uint64
+	mergeThreshold uint64
+}
+
+func (p sizePolicy) Split(ctx context.Context, load JetLoad) bool {
+	return load.Size > p.threshold
+}
+
+func (p sizePolicy) Merge(ctx context.Context, left, right JetLoad) bool {
+	return left.Size+right.Size < p.mergeThreshold
+}
+
+type ratePolicy struct {
+	threshold float64
+}
+
+func (p ratePolicy) Split(ctx context.Context, load JetLoad) bool {
+	return load.RequestRate() > p.threshold
+}
+
+func (p ratePolicy) Merge(ctx context.Context, left, right JetLoad) bool {
+	return false
+}
+
+// depthPolicy splits jets regardless of load until all of them reach provided depth.
+type depthPolicy struct {
+	depth uint8
+}
+
+func (p depthPolicy) Split(ctx context.Context, load JetLoad) bool {
+	return load.JetID.Depth() < p.depth
+}
+
+func (p depthPolicy) Merge(ctx context.Context, left, right JetLoad) bool {
+	return false
+}
+
+// maxDepthPolicy prevents split of jets which reached provided depth.
+type maxDepthPolicy struct {
+	policy SplitPolicy
+	depth  uint8
+}
+
+func (p maxDepthPolicy) Split(ctx context.Context, load JetLoad) bool {
+	return load.JetID.Depth() < p.depth && p.policy.Split(ctx, load)
+}
+
+func (p maxDepthPolicy) Merge(ctx context.Context, left, right JetLoad) bool {
+	return p.policy.Merge(ctx, left, right)
+}
@@ -0,0 +1,101 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package pulsemanager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+func TestNewSplitPolicy(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+
+	newPolicy := func(js configuration.JetSplit) SplitPolicy {
+		conf := configuration.NewLedger()
+		conf.PulseManager.SplitThreshold = 1000
+		conf.JetSplit = js
+		policy, err := NewSplitPolicy(conf)
+		require.NoError(t, err)
+		return policy
+	}
+	shallow := jet.NewIDFromString("01")
+	deep := jet.NewIDFromString("0101")
+
+	t.Run("unknown policy returns error", func(t *testing.T) {
+		_, err := NewSplitPolicy(configuration.Ledger{JetSplit: configuration.JetSplit{Policy: "random"}})
+		assert.Error(t, err)
+	})
+
+	t.Run("merge threshold must be less than split threshold", func(t *testing.T) {
+		conf := configuration.NewLedger()
+		conf.JetSplit.Policy = SplitPolicySize
+		conf.PulseManager.SplitThreshold = 1000
+		conf.PulseManager.MergeThreshold = 1000
+		_, err := NewSplitPolicy(conf)
+		assert.Error(t, err)
+	})
+
+	t.Run("records", func(t *testing.T) {
+		policy := newPolicy(configuration.JetSplit{Policy: SplitPolicyRecords, RecordsThreshold: 10})
+		assert.False(t, policy.Split(ctx, JetLoad{JetID: shallow, Records: 10, Size: 1 << 20}))
+		assert.True(t, policy.Split(ctx, JetLoad{JetID: shallow, Records: 11}))
+		assert.False(t, policy.Merge(ctx, JetLoad{JetID: shallow}, JetLoad{JetID: shallow}))
+	})
+
+	t.Run("size", func(t *testing.T) {
+		policy := newPolicy(configuration.JetSplit{Policy: SplitPolicySize})
+		assert.False(t, policy.Split(ctx, JetLoad{JetID: shallow, Size: 1000, Records: 1000}))
+		assert.True(t, policy.Split(ctx, JetLoad{JetID: shallow, Size: 1001}))
+		assert.False(t, policy.Merge(ctx, JetLoad{JetID: shallow}, JetLoad{JetID: shallow}), "merge is disabled")
+
+		conf := configuration.NewLedger()
+		conf.PulseManager.SplitThreshold = 1000
+		conf.PulseManager.MergeThreshold = 100
+		conf.JetSplit = configuration.JetSplit{Policy: SplitPolicySize, MaxDepth: 4}
+		policy, err := NewSplitPolicy(conf)
+		require.NoError(t, err)
+		assert.True(t, policy.Merge(ctx, JetLoad{JetID: deep, Size: 50}, JetLoad{JetID: deep, Size: 49}))
+		assert.False(t, policy.Merge(ctx, JetLoad{JetID: deep, Size: 50}, JetLoad{JetID: deep, Size: 50}))
+	})
+
+	t.Run("rate", func(t *testing.T) {
+		policy := newPolicy(configuration.JetSplit{Policy: SplitPolicyRate, RateThreshold: 2})
+		assert.False(t, policy.Split(ctx, JetLoad{JetID: shallow, Requests: 20, Duration: 10 * time.Second}))
+		assert.True(t, policy.Split(ctx, JetLoad{JetID: shallow, Requests: 21, Duration: 10 * time.Second}))
+		assert.True(t, policy.Split(ctx, JetLoad{JetID: shallow, Requests: 3}), "unknown duration counts as second")
+	})
+
+	t.Run("depth", func(t *testing.T) {
+		policy := newPolicy(configuration.JetSplit{Policy: SplitPolicyDepth, Depth: 4, MaxDepth: 1})
+		assert.True(t, policy.Split(ctx, JetLoad{JetID: shallow}))
+		assert.False(t, policy.Split(ctx, JetLoad{JetID: deep}))
+		assert.False(t, policy.Merge(ctx, JetLoad{JetID: deep}, JetLoad{JetID: deep}))
+	})
+
+	t.Run("max depth", func(t *testing.T) {
+		policy := newPolicy(configuration.JetSplit{Policy: SplitPolicyRecords, MaxDepth: 4})
+		assert.True(t, policy.Split(ctx, JetLoad{JetID: shallow, Records: 1}))
+		assert.False(t, policy.Split(ctx, JetLoad{JetID: deep, Records: 1}))
+	})
+}
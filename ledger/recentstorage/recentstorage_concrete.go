@@ -86,6 +86,7 @@ func (p *RecentStorageProvider) Count() int {
 }
 
 // CloneIndexStorage clones indexes from one jet to another one
+// If another jet already has a storage, indexes are added to it
 func (p *RecentStorageProvider) CloneIndexStorage(ctx context.Context, fromJetID, toJetID insolar.ID) {
 	p.indexLock.Lock()
 	defer p.indexLock.Unlock()
@@ -94,33 +95,49 @@ func (p *RecentStorageProvider) CloneIndexStorage(ctx context.Context, fromJetID
 	if !ok {
 		return
 	}
-	toStorage := &RecentIndexStorageConcrete{
-		jetID:      toJetID,
-		indexes:    map[insolar.ID]recentObjectMeta{},
-		DefaultTTL: p.DefaultTTL,
+	toStorage, ok := p.indexStorages[toJetID]
+	if !ok {
+		toStorage = &RecentIndexStorageConcrete{
+			jetID:      toJetID,
+			indexes:    map[insolar.ID]recentObjectMeta{},
+			DefaultTTL: p.DefaultTTL,
+		}
+		p.indexStorages[toJetID] = toStorage
+	}
+	if toStorage == fromStorage {
+		return
 	}
+
+	toStorage.lock.Lock()
+	defer toStorage.lock.Unlock()
 	for k, v := range fromStorage.indexes {
 		clone := v
 		toStorage.indexes[k] = clone
 	}
-	p.indexStorages[toJetID] = toStorage
 }
 
 // ClonePendingStorage clones pending requests from one jet to another one
+// If another jet already has a storage, requests are added to it
 func (p *RecentStorageProvider) ClonePendingStorage(ctx context.Context, fromJetID, toJetID insolar.ID) {
 	p.pendingLock.Lock()
 	fromStorage, ok := p.pendingStorages[fromJetID]
+	if !ok {
+		p.pendingLock.Unlock()
+		return
+	}
+	toStorage, ok := p.pendingStorages[toJetID]
+	if !ok {
+		toStorage = NewPendingStorage(toJetID)
+		p.pendingStorages[toJetID] = toStorage
+	}
 	p.pendingLock.Unlock()
 
-	if !ok {
+	if toStorage == fromStorage {
 		return
 	}
 
 	fromStorage.lock.RLock()
-	toStorage := &PendingStorageConcrete{
-		jetID:    toJetID,
-		requests: map[insolar.ID]*lockedPendingObjectContext{},
-	}
+	toStorage.lock.Lock()
 	for objID, pendingContext := range fromStorage.requests {
 		if len(pendingContext.Context.Requests) == 0 {
 			continue
@@ -138,11 +155,8 @@ func (p *RecentStorageProvider) ClonePendingStorage(ctx context.Context, fromJet
 
 		pendingContext.lock.Unlock()
 	}
+	toStorage.lock.Unlock()
 	fromStorage.lock.RUnlock()
-
-	p.pendingLock.Lock()
-	p.pendingStorages[toJetID] = toStorage
-	p.pendingLock.Unlock()
 }
 
 // DecreaseIndexesTTL decrease ttl of all indexes in all storages
@@ -312,6 +312,42 @@ func TestRecentStorageProvider_DecreaseIndexesTTL_WorksOnEmptyStorage(t *testing
 	require.Equal(t, map[insolar.ID][]insolar.ID{}, result)
 }
 
+func TestRecentStorageProvider_Clone_AddsToExistingStorage(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx := inslogger.TestContext(t)
+
+	leftJet := *insolar.NewID(123, []byte{1})
+	rightJet := *insolar.NewID(123, []byte{2})
+	parentJet := *insolar.NewID(123, []byte{3})
+
+	leftObj := *insolar.NewID(123, []byte{11})
+	rightObj := *insolar.NewID(123, []byte{22})
+	leftReq := *insolar.NewID(123, []byte{111})
+	rightReq := *insolar.NewID(123, []byte{222})
+
+	provider := NewRecentStorageProvider(8)
+	provider.GetIndexStorage(ctx, leftJet).AddObjectWithTLL(ctx, leftObj, 3)
+	provider.GetIndexStorage(ctx, rightJet).AddObjectWithTLL(ctx, rightObj, 5)
+	provider.GetPendingStorage(ctx, leftJet).AddPendingRequest(ctx, leftObj, leftReq)
+	provider.GetPendingStorage(ctx, rightJet).AddPendingRequest(ctx, rightObj, rightReq)
+
+	// Act
+	for _, jetID := range []insolar.ID{leftJet, rightJet} {
+		provider.CloneIndexStorage(ctx, jetID, parentJet)
+		provider.ClonePendingStorage(ctx, jetID, parentJet)
+	}
+
+	// Assert
+	require.Equal(t,
+		map[insolar.ID]int{leftObj: 3, rightObj: 5},
+		provider.GetIndexStorage(ctx, parentJet).GetObjects(),
+	)
+	pendingStorage := provider.GetPendingStorage(ctx, parentJet)
+	require.Equal(t, []insolar.ID{leftReq}, pendingStorage.GetRequestsForObject(leftObj))
+	require.Equal(t, []insolar.ID{rightReq}, pendingStorage.GetRequestsForObject(rightObj))
+}
+
 func TestPendingStorageConcrete_GetRequestsForObject(t *testing.T) {
 	t.Parallel()
 
@@ -28,22 +28,27 @@ import (
 	"github.com/insolar/insolar/insolar/gen"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/internal/ledger/store/storetest"
 )
 
 func TestBlobStorages(t *testing.T) {
 	t.Parallel()
 
+	storetest.ForEachEngine(t, testBlobStorages)
+}
+
+func testBlobStorages(t *testing.T, newDB func() store.DB) {
 	ctx := inslogger.TestContext(t)
 
 	memStorage := NewStorageMemory()
-	dbStorage := NewStorageDB(store.NewMemoryMockDB())
+	dbStorage := NewStorageDB(newDB())
 	type storage interface {
 		Accessor
 		Modifier
 	}
 	storages := map[string]storage{
 		"memory": memStorage,
-		"badger": dbStorage,
+		"db":     dbStorage,
 	}
 
 	seen := map[insolar.ID]bool{}
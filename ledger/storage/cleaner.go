@@ -19,10 +19,10 @@ package storage
 import (
 	"context"
 
-	"github.com/dgraph-io/badger"
 	"github.com/hashicorp/go-multierror"
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/instrumentation/insmetrics"
+	"github.com/insolar/insolar/internal/ledger/store"
 	"github.com/insolar/insolar/ledger/recentstorage"
 	"github.com/pkg/errors"
 	"go.opencensus.io/stats"
@@ -116,17 +116,21 @@ func (c *cleaner) removeJetRecordsUntil(
 	jetprefix := prefixkey(namespace, prefix)
 	startprefix := prefixkey(namespace, prefix, rmScanFromPulse)
 
-	return stat, c.DB.GetBadgerDB().Update(func(txn *badger.Txn) error {
-		opts := badger.DefaultIteratorOptions
-		opts.PrefetchSize = 0
-		opts.PrefetchValues = false
-		it := txn.NewIterator(opts)
-		defer it.Close()
-		for it.Seek(startprefix); it.ValidForPrefix(jetprefix); it.Next() {
-			key := it.Item().KeyCopy(nil)
+	return stat, c.DB.GetBackend().Update(func(txn store.Txn) error {
+		var keys [][]byte
+		err := txn.Iterate(jetprefix, startprefix, func(key, _ []byte) (bool, error) {
 			if pulseFromKey(key) >= pn {
-				break
+				return false, nil
 			}
+			keys = append(keys, key)
+			return true, nil
+		})
+		if err != nil {
+			return err
+		}
+
+		// keys are removed after iteration, because not all engines allow to modify data under an iterator
+		for _, key := range keys {
 			stat.Scanned++
 
 			if err := txn.Delete(key); err != nil {
@@ -155,7 +159,7 @@ func (c *cleaner) CleanJetIndexes(
 		for _, recID := range fordelete {
 			stat.Scanned++
 			key := prefixkey(scopeIDLifeline, prefix, recID[:])
-			err := c.DB.GetBadgerDB().Update(func(txn *badger.Txn) error {
+			err := c.DB.GetBackend().Update(func(txn store.Txn) error {
 				return txn.Delete(key)
 			})
 			if err != nil {
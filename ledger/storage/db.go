@@ -19,14 +19,13 @@ package storage
 import (
 	"context"
 	"encoding/hex"
-	"path/filepath"
 	"sync"
 
 	"github.com/insolar/insolar/insolar/record"
 
-	"github.com/dgraph-io/badger"
 	"github.com/insolar/insolar/configuration"
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/internal/ledger/store"
 	"github.com/insolar/insolar/ledger/storage/object"
 	"github.com/pkg/errors"
 )
@@ -58,7 +57,7 @@ type DBContext interface {
 
 	StoreKeyValues(ctx context.Context, kvs []insolar.KV) error
 
-	GetBadgerDB() *badger.DB
+	GetBackend() store.Backend
 
 	Close() error
 
@@ -73,18 +72,18 @@ type DBContext interface {
 	) error
 }
 
-// DB represents BadgerDB storage implementation.
+// DB represents storage implementation over one of embedded store engines.
 type DB struct {
 	PlatformCryptographyScheme insolar.PlatformCryptographyScheme `inject:""`
 
-	db *badger.DB
+	db store.Backend
 
 	// dropLock protects dropWG from concurrent calls to Add and Wait
 	dropLock sync.Mutex
 	// dropWG guards inflight updates before jet drop calculated.
 	dropWG sync.WaitGroup
 
-	// for BadgerDB it is normal to have transaction conflicts
+	// for some engines (e.g. BadgerDB) it is normal to have transaction conflicts
 	// and these conflicts we should resolve by ourself
 	// so txretiries is our knob to tune up retry logic.
 	txretiries int
@@ -95,29 +94,10 @@ type DB struct {
 	isClosed  bool
 }
 
-func setOptions(o *badger.Options) *badger.Options {
-	newo := &badger.Options{}
-	if o != nil {
-		*newo = *o
-	} else {
-		*newo = badger.DefaultOptions
-	}
-	return newo
-}
-
-// NewDB returns storage.DB with BadgerDB instance initialized by opts.
+// NewDB returns storage.DB with engine selected by conf.Storage.Engine.
 // Creates database in provided dir or in current directory if dir parameter is empty.
-func NewDB(conf configuration.Ledger, opts *badger.Options) (DBContext, error) {
-	opts = setOptions(opts)
-	dir, err := filepath.Abs(conf.Storage.DataDirectory)
-	if err != nil {
-		return nil, err
-	}
-
-	opts.Dir = dir
-	opts.ValueDir = dir
-
-	bdb, err := badger.Open(*opts)
+func NewDB(conf configuration.Ledger) (DBContext, error) {
+	bdb, err := store.Open(conf.Storage.Engine, conf.Storage.DataDirectory)
 	if err != nil {
 		return nil, errors.Wrap(err, "local database open failed")
 	}
@@ -130,11 +110,10 @@ func NewDB(conf configuration.Ledger, opts *badger.Options) (DBContext, error) {
 	return db, nil
 }
 
-// Close wraps BadgerDB Close method.
+// Close wraps backend Close method.
 //
-// From https://godoc.org/github.com/dgraph-io/badger#DB.Close:
-// «It's crucial to call it to ensure all the pending updates make their way to disk.
-// Calling DB.Close() multiple times is not safe and wouldcause panic.»
+// It's crucial to call it to ensure all the pending updates make their way to disk.
+// Calling backend Close multiple times is not safe, so subsequent calls return ErrClosed.
 func (db *DB) Close() error {
 	db.closeLock.Lock()
 	defer db.closeLock.Unlock()
@@ -202,7 +181,7 @@ func (db *DB) Update(ctx context.Context, fn func(*TransactionManager) error) er
 		if err == nil {
 			break
 		}
-		if err != badger.ErrConflict {
+		if err != store.ErrConflict {
 			break
 		}
 		if tries < 1 {
@@ -221,8 +200,8 @@ func (db *DB) Update(ctx context.Context, fn func(*TransactionManager) error) er
 	return err
 }
 
-// GetBadgerDB return badger.DB instance (for internal usage, like tests)
-func (db *DB) GetBadgerDB() *badger.DB {
+// GetBackend returns store engine instance (for internal usage, like tests)
+func (db *DB) GetBackend() store.Backend {
 	return db.db
 }
 
@@ -301,22 +280,10 @@ func (db *DB) iterate(
 		return ErrClosed
 	}
 
-	return db.db.View(func(txn *badger.Txn) error {
-		it := txn.NewIterator(badger.DefaultIteratorOptions)
-		defer it.Close()
-
-		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
-			key := it.Item().KeyCopy(nil)[len(prefix):]
-			value, err := it.Item().ValueCopy(nil)
-			if err != nil {
-				return err
-			}
-			err = handler(key, value)
-			if err != nil {
-				return err
-			}
-		}
-		return nil
+	return db.db.View(func(txn store.Txn) error {
+		return txn.Iterate(prefix, nil, func(k, v []byte) (bool, error) {
+			return true, handler(k[len(prefix):], v)
+		})
 	})
 }
 
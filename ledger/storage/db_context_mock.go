@@ -10,10 +10,10 @@ import (
 	"sync/atomic"
 	"time"
 
-	badger "github.com/dgraph-io/badger"
 	"github.com/gojuno/minimock"
 	insolar "github.com/insolar/insolar/insolar"
 	record "github.com/insolar/insolar/insolar/record"
+	store "github.com/insolar/insolar/internal/ledger/store"
 
 	testify_assert "github.com/stretchr/testify/assert"
 )
@@ -37,10 +37,10 @@ type DBContextMock struct {
 	GetPreCounter uint64
 	GetMock       mDBContextMockGet
 
-	GetBadgerDBFunc       func() (r *badger.DB)
-	GetBadgerDBCounter    uint64
-	GetBadgerDBPreCounter uint64
-	GetBadgerDBMock       mDBContextMockGetBadgerDB
+	GetBackendFunc       func() (r store.Backend)
+	GetBackendCounter    uint64
+	GetBackendPreCounter uint64
+	GetBackendMock       mDBContextMockGetBackend
 
 	IterateRecordsOnPulseFunc       func(p context.Context, p1 insolar.ID, p2 insolar.PulseNumber, p3 func(p insolar.ID, p1 record.VirtualRecord) (r error)) (r error)
 	IterateRecordsOnPulseCounter    uint64
@@ -89,7 +89,7 @@ func NewDBContextMock(t minimock.Tester) *DBContextMock {
 	m.BeginTransactionMock = mDBContextMockBeginTransaction{mock: m}
 	m.CloseMock = mDBContextMockClose{mock: m}
 	m.GetMock = mDBContextMockGet{mock: m}
-	m.GetBadgerDBMock = mDBContextMockGetBadgerDB{mock: m}
+	m.GetBackendMock = mDBContextMockGetBackend{mock: m}
 	m.IterateRecordsOnPulseMock = mDBContextMockIterateRecordsOnPulse{mock: m}
 	m.SetMock = mDBContextMockSet{mock: m}
 	m.StoreKeyValuesMock = mDBContextMockStoreKeyValues{mock: m}
@@ -536,82 +536,82 @@ func (m *DBContextMock) GetFinished() bool {
 	return true
 }
 
-type mDBContextMockGetBadgerDB struct {
+type mDBContextMockGetBackend struct {
 	mock              *DBContextMock
-	mainExpectation   *DBContextMockGetBadgerDBExpectation
-	expectationSeries []*DBContextMockGetBadgerDBExpectation
+	mainExpectation   *DBContextMockGetBackendExpectation
+	expectationSeries []*DBContextMockGetBackendExpectation
 }
 
-type DBContextMockGetBadgerDBExpectation struct {
-	result *DBContextMockGetBadgerDBResult
+type DBContextMockGetBackendExpectation struct {
+	result *DBContextMockGetBackendResult
 }
 
-type DBContextMockGetBadgerDBResult struct {
-	r *badger.DB
+type DBContextMockGetBackendResult struct {
+	r store.Backend
 }
 
-//Expect specifies that invocation of DBContext.GetBadgerDB is expected from 1 to Infinity times
-func (m *mDBContextMockGetBadgerDB) Expect() *mDBContextMockGetBadgerDB {
-	m.mock.GetBadgerDBFunc = nil
+//Expect specifies that invocation of DBContext.GetBackend is expected from 1 to Infinity times
+func (m *mDBContextMockGetBackend) Expect() *mDBContextMockGetBackend {
+	m.mock.GetBackendFunc = nil
 	m.expectationSeries = nil
 
 	if m.mainExpectation == nil {
-		m.mainExpectation = &DBContextMockGetBadgerDBExpectation{}
+		m.mainExpectation = &DBContextMockGetBackendExpectation{}
 	}
 
 	return m
 }
 
-//Return specifies results of invocation of DBContext.GetBadgerDB
-func (m *mDBContextMockGetBadgerDB) Return(r *badger.DB) *DBContextMock {
-	m.mock.GetBadgerDBFunc = nil
+//Return specifies results of invocation of DBContext.GetBackend
+func (m *mDBContextMockGetBackend) Return(r store.Backend) *DBContextMock {
+	m.mock.GetBackendFunc = nil
 	m.expectationSeries = nil
 
 	if m.mainExpectation == nil {
-		m.mainExpectation = &DBContextMockGetBadgerDBExpectation{}
+		m.mainExpectation = &DBContextMockGetBackendExpectation{}
 	}
-	m.mainExpectation.result = &DBContextMockGetBadgerDBResult{r}
+	m.mainExpectation.result = &DBContextMockGetBackendResult{r}
 	return m.mock
 }
 
-//ExpectOnce specifies that invocation of DBContext.GetBadgerDB is expected once
-func (m *mDBContextMockGetBadgerDB) ExpectOnce() *DBContextMockGetBadgerDBExpectation {
-	m.mock.GetBadgerDBFunc = nil
+//ExpectOnce specifies that invocation of DBContext.GetBackend is expected once
+func (m *mDBContextMockGetBackend) ExpectOnce() *DBContextMockGetBackendExpectation {
+	m.mock.GetBackendFunc = nil
 	m.mainExpectation = nil
 
-	expectation := &DBContextMockGetBadgerDBExpectation{}
+	expectation := &DBContextMockGetBackendExpectation{}
 
 	m.expectationSeries = append(m.expectationSeries, expectation)
 	return expectation
 }
 
-func (e *DBContextMockGetBadgerDBExpectation) Return(r *badger.DB) {
-	e.result = &DBContextMockGetBadgerDBResult{r}
+func (e *DBContextMockGetBackendExpectation) Return(r store.Backend) {
+	e.result = &DBContextMockGetBackendResult{r}
 }
 
-//Set uses given function f as a mock of DBContext.GetBadgerDB method
-func (m *mDBContextMockGetBadgerDB) Set(f func() (r *badger.DB)) *DBContextMock {
+//Set uses given function f as a mock of DBContext.GetBackend method
+func (m *mDBContextMockGetBackend) Set(f func() (r store.Backend)) *DBContextMock {
 	m.mainExpectation = nil
 	m.expectationSeries = nil
 
-	m.mock.GetBadgerDBFunc = f
+	m.mock.GetBackendFunc = f
 	return m.mock
 }
 
-//GetBadgerDB implements github.com/insolar/insolar/ledger/storage.DBContext interface
-func (m *DBContextMock) GetBadgerDB() (r *badger.DB) {
-	counter := atomic.AddUint64(&m.GetBadgerDBPreCounter, 1)
-	defer atomic.AddUint64(&m.GetBadgerDBCounter, 1)
+//GetBackend implements github.com/insolar/insolar/ledger/storage.DBContext interface
+func (m *DBContextMock) GetBackend() (r store.Backend) {
+	counter := atomic.AddUint64(&m.GetBackendPreCounter, 1)
+	defer atomic.AddUint64(&m.GetBackendCounter, 1)
 
-	if len(m.GetBadgerDBMock.expectationSeries) > 0 {
-		if counter > uint64(len(m.GetBadgerDBMock.expectationSeries)) {
-			m.t.Fatalf("Unexpected call to DBContextMock.GetBadgerDB.")
+	if len(m.GetBackendMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.GetBackendMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to DBContextMock.GetBackend.")
 			return
 		}
 
-		result := m.GetBadgerDBMock.expectationSeries[counter-1].result
+		result := m.GetBackendMock.expectationSeries[counter-1].result
 		if result == nil {
-			m.t.Fatal("No results are set for the DBContextMock.GetBadgerDB")
+			m.t.Fatal("No results are set for the DBContextMock.GetBackend")
 			return
 		}
 
@@ -620,11 +620,11 @@ func (m *DBContextMock) GetBadgerDB() (r *badger.DB) {
 		return
 	}
 
-	if m.GetBadgerDBMock.mainExpectation != nil {
+	if m.GetBackendMock.mainExpectation != nil {
 
-		result := m.GetBadgerDBMock.mainExpectation.result
+		result := m.GetBackendMock.mainExpectation.result
 		if result == nil {
-			m.t.Fatal("No results are set for the DBContextMock.GetBadgerDB")
+			m.t.Fatal("No results are set for the DBContextMock.GetBackend")
 		}
 
 		r = result.r
@@ -632,39 +632,39 @@ func (m *DBContextMock) GetBadgerDB() (r *badger.DB) {
 		return
 	}
 
-	if m.GetBadgerDBFunc == nil {
-		m.t.Fatalf("Unexpected call to DBContextMock.GetBadgerDB.")
+	if m.GetBackendFunc == nil {
+		m.t.Fatalf("Unexpected call to DBContextMock.GetBackend.")
 		return
 	}
 
-	return m.GetBadgerDBFunc()
+	return m.GetBackendFunc()
 }
 
-//GetBadgerDBMinimockCounter returns a count of DBContextMock.GetBadgerDBFunc invocations
-func (m *DBContextMock) GetBadgerDBMinimockCounter() uint64 {
-	return atomic.LoadUint64(&m.GetBadgerDBCounter)
+//GetBackendMinimockCounter returns a count of DBContextMock.GetBackendFunc invocations
+func (m *DBContextMock) GetBackendMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.GetBackendCounter)
 }
 
-//GetBadgerDBMinimockPreCounter returns the value of DBContextMock.GetBadgerDB invocations
-func (m *DBContextMock) GetBadgerDBMinimockPreCounter() uint64 {
-	return atomic.LoadUint64(&m.GetBadgerDBPreCounter)
+//GetBackendMinimockPreCounter returns the value of DBContextMock.GetBackend invocations
+func (m *DBContextMock) GetBackendMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.GetBackendPreCounter)
 }
 
-//GetBadgerDBFinished returns true if mock invocations count is ok
-func (m *DBContextMock) GetBadgerDBFinished() bool {
+//GetBackendFinished returns true if mock invocations count is ok
+func (m *DBContextMock) GetBackendFinished() bool {
 	// if expectation series were set then invocations count should be equal to expectations count
-	if len(m.GetBadgerDBMock.expectationSeries) > 0 {
-		return atomic.LoadUint64(&m.GetBadgerDBCounter) == uint64(len(m.GetBadgerDBMock.expectationSeries))
+	if len(m.GetBackendMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.GetBackendCounter) == uint64(len(m.GetBackendMock.expectationSeries))
 	}
 
 	// if main expectation was set then invocations count should be greater than zero
-	if m.GetBadgerDBMock.mainExpectation != nil {
-		return atomic.LoadUint64(&m.GetBadgerDBCounter) > 0
+	if m.GetBackendMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.GetBackendCounter) > 0
 	}
 
 	// if func was set then invocations count should be greater than zero
-	if m.GetBadgerDBFunc != nil {
-		return atomic.LoadUint64(&m.GetBadgerDBCounter) > 0
+	if m.GetBackendFunc != nil {
+		return atomic.LoadUint64(&m.GetBackendCounter) > 0
 	}
 
 	return true
@@ -1688,8 +1688,8 @@ func (m *DBContextMock) ValidateCallCounters() {
 		m.t.Fatal("Expected call to DBContextMock.Get")
 	}
 
-	if !m.GetBadgerDBFinished() {
-		m.t.Fatal("Expected call to DBContextMock.GetBadgerDB")
+	if !m.GetBackendFinished() {
+		m.t.Fatal("Expected call to DBContextMock.GetBackend")
 	}
 
 	if !m.IterateRecordsOnPulseFinished() {
@@ -1749,8 +1749,8 @@ func (m *DBContextMock) MinimockFinish() {
 		m.t.Fatal("Expected call to DBContextMock.Get")
 	}
 
-	if !m.GetBadgerDBFinished() {
-		m.t.Fatal("Expected call to DBContextMock.GetBadgerDB")
+	if !m.GetBackendFinished() {
+		m.t.Fatal("Expected call to DBContextMock.GetBackend")
 	}
 
 	if !m.IterateRecordsOnPulseFinished() {
@@ -1798,7 +1798,7 @@ func (m *DBContextMock) MinimockWait(timeout time.Duration) {
 		ok = ok && m.BeginTransactionFinished()
 		ok = ok && m.CloseFinished()
 		ok = ok && m.GetFinished()
-		ok = ok && m.GetBadgerDBFinished()
+		ok = ok && m.GetBackendFinished()
 		ok = ok && m.IterateRecordsOnPulseFinished()
 		ok = ok && m.SetFinished()
 		ok = ok && m.StoreKeyValuesFinished()
@@ -1826,8 +1826,8 @@ func (m *DBContextMock) MinimockWait(timeout time.Duration) {
 				m.t.Error("Expected call to DBContextMock.Get")
 			}
 
-			if !m.GetBadgerDBFinished() {
-				m.t.Error("Expected call to DBContextMock.GetBadgerDB")
+			if !m.GetBackendFinished() {
+				m.t.Error("Expected call to DBContextMock.GetBackend")
 			}
 
 			if !m.IterateRecordsOnPulseFinished() {
@@ -1882,7 +1882,7 @@ func (m *DBContextMock) AllMocksCalled() bool {
 		return false
 	}
 
-	if !m.GetBadgerDBFinished() {
+	if !m.GetBackendFinished() {
 		return false
 	}
 
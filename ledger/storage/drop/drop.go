@@ -63,6 +63,9 @@ type Drop struct {
 
 	// JetID represents data about JetID of the current jet.Drop.
 	JetID insolar.JetID
+
+	// MerkleRoot is a root of Merkle tree over ids of all records belongs to the drop. See RecordsRoot.
+	MerkleRoot []byte
 }
 
 // MustEncode serializes jet drop.
@@ -21,6 +21,7 @@ import (
 
 	fuzz "github.com/google/gofuzz"
 	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/internal/ledger/store/storetest"
 	"github.com/stretchr/testify/require"
 
 	"github.com/insolar/insolar/insolar"
@@ -75,8 +76,12 @@ func TestDropStorageMemory(t *testing.T) {
 }
 
 func TestDropStorageDB(t *testing.T) {
+	storetest.ForEachEngine(t, testDropStorageDB)
+}
+
+func testDropStorageDB(t *testing.T, newDB func() store.DB) {
 	ctx := inslogger.TestContext(t)
-	ds := NewStorageDB(store.NewMemoryMockDB())
+	ds := NewStorageDB(newDB())
 
 	var drops []Drop
 	genInputs := map[jetPulse]struct{}{}
@@ -105,9 +110,13 @@ func TestDropStorageDB(t *testing.T) {
 }
 
 func TestDropStorageCompare(t *testing.T) {
+	storetest.ForEachEngine(t, testDropStorageCompare)
+}
+
+func testDropStorageCompare(t *testing.T, newDB func() store.DB) {
 	ctx := inslogger.TestContext(t)
 
-	ds := NewStorageDB(store.NewMemoryMockDB())
+	ds := NewStorageDB(newDB())
 	ms := NewStorageMemory()
 
 	var drops []Drop
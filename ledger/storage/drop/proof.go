@@ -0,0 +1,87 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package drop
+
+import (
+	"sort"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/network/merkle"
+)
+
+// RecordsRoot returns root of Merkle tree over ids of records of a drop. Leaves of the tree are record hashes in order
+// of record ids. It returns nil for a drop without records.
+func RecordsRoot(scheme insolar.PlatformCryptographyScheme, ids []insolar.ID) []byte {
+	tree, _ := recordsTree(scheme, ids)
+	return tree.Root()
+}
+
+// ChainHash returns hash of a drop that continues the chain of drops with provided previous hash.
+func ChainHash(scheme insolar.PlatformCryptographyScheme, prevHash, merkleRoot []byte) []byte {
+	hasher := scheme.IntegrityHasher()
+	_, err := hasher.Write(prevHash)
+	if err != nil {
+		panic(err)
+	}
+	_, err = hasher.Write(merkleRoot)
+	if err != nil {
+		panic(err)
+	}
+	return hasher.Sum(nil)
+}
+
+// RecordProof returns root of Merkle tree over ids of records of a drop and a proof that record with provided id is
+// one of them.
+func RecordProof(
+	scheme insolar.PlatformCryptographyScheme, ids []insolar.ID, id insolar.ID,
+) ([]byte, *merkle.InclusionProof, error) {
+	tree, sorted := recordsTree(scheme, ids)
+	index := sort.Search(len(sorted), func(i int) bool {
+		return sorted[i].Compare(id) >= 0
+	})
+	if index == len(sorted) || sorted[index] != id {
+		return nil, nil, ErrNotFound
+	}
+	proof, err := tree.Proof(uint64(index))
+	if err != nil {
+		return nil, nil, err
+	}
+	return tree.Root(), proof, nil
+}
+
+// VerifyRecord checks that record with provided id belongs to the drop. It needs nothing but the drop and the proof,
+// so light clients and auditors can run it offline against a drop they trust.
+func VerifyRecord(scheme insolar.PlatformCryptographyScheme, drop Drop, id insolar.ID, proof merkle.InclusionProof) bool {
+	if id.Pulse() != drop.Pulse || len(drop.MerkleRoot) == 0 {
+		return false
+	}
+	return merkle.VerifyInclusion(scheme, drop.MerkleRoot, id.Hash(), proof)
+}
+
+func recordsTree(scheme insolar.PlatformCryptographyScheme, ids []insolar.ID) (*merkle.HashTree, []insolar.ID) {
+	sorted := make([]insolar.ID, len(ids))
+	copy(sorted, ids)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Compare(sorted[j]) < 0
+	})
+
+	leaves := make([][]byte, 0, len(sorted))
+	for i := range sorted {
+		leaves = append(leaves, sorted[i].Hash())
+	}
+	return merkle.NewHashTree(scheme, leaves), sorted
+}
@@ -0,0 +1,70 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package drop
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/platformpolicy"
+)
+
+func randomID(pn insolar.PulseNumber) insolar.ID {
+	hash := make([]byte, insolar.RecordHashSize)
+	rand.Read(hash)
+	return *insolar.NewID(pn, hash)
+}
+
+func TestRecordProof(t *testing.T) {
+	scheme := platformpolicy.NewPlatformCryptographyScheme()
+	pn := gen.PulseNumber()
+
+	ids := make([]insolar.ID, rand.Intn(50)+1)
+	for i := range ids {
+		ids[i] = randomID(pn)
+	}
+	dr := Drop{Pulse: pn, JetID: gen.JetID(), MerkleRoot: RecordsRoot(scheme, ids)}
+	require.NotNil(t, dr.MerkleRoot)
+
+	for _, id := range ids {
+		root, proof, err := RecordProof(scheme, ids, id)
+		require.NoError(t, err)
+		assert.Equal(t, dr.MerkleRoot, root)
+		assert.True(t, VerifyRecord(scheme, dr, id, *proof))
+
+		foreign := *insolar.NewID(pn+1, id.Hash())
+		assert.False(t, VerifyRecord(scheme, dr, foreign, *proof))
+	}
+
+	_, _, err := RecordProof(scheme, ids, randomID(pn))
+	assert.Equal(t, ErrNotFound, err)
+}
+
+func TestRecordsRoot_IgnoresOrder(t *testing.T) {
+	scheme := platformpolicy.NewPlatformCryptographyScheme()
+	pn := gen.PulseNumber()
+	ids := []insolar.ID{randomID(pn), randomID(pn), randomID(pn)}
+	reversed := []insolar.ID{ids[2], ids[1], ids[0]}
+
+	assert.Equal(t, RecordsRoot(scheme, ids), RecordsRoot(scheme, reversed))
+	assert.Nil(t, RecordsRoot(scheme, nil))
+}
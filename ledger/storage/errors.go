@@ -19,15 +19,15 @@ package storage
 import (
 	"errors"
 
-	"github.com/dgraph-io/badger"
+	"github.com/insolar/insolar/internal/ledger/store"
 )
 
 var (
 	// ErrConflictRetriesOver is returned if Update transaction fails on all retry attempts.
 	ErrConflictRetriesOver = errors.New("transaction conflict retries limit exceeded")
 
-	// ErrConflict is the alias for badger.ErrConflict.
-	ErrConflict = badger.ErrConflict
+	// ErrConflict is the alias for store.ErrConflict.
+	ErrConflict = store.ErrConflict
 
 	// ErrOverride is returned if something tries to update existing record.
 	ErrOverride = errors.New("records override is forbidden")
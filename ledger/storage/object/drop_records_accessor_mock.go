@@ -0,0 +1,267 @@
+package object
+
+/*
+DO NOT EDIT!
+This code was generated automatically using github.com/gojuno/minimock v1.9
+The original interface "DropRecordsAccessor" can be found in github.com/insolar/insolar/ledger/storage/object
+*/
+import (
+	context "context"
+	"sync/atomic"
+	"time"
+
+	"github.com/gojuno/minimock"
+	insolar "github.com/insolar/insolar/insolar"
+
+	testify_assert "github.com/stretchr/testify/assert"
+)
+
+//DropRecordsAccessorMock implements github.com/insolar/insolar/ledger/storage/object.DropRecordsAccessor
+type DropRecordsAccessorMock struct {
+	t minimock.Tester
+
+	ForDropFunc       func(p context.Context, p1 insolar.JetID, p2 insolar.PulseNumber) (r []insolar.ID, r1 error)
+	ForDropCounter    uint64
+	ForDropPreCounter uint64
+	ForDropMock       mDropRecordsAccessorMockForDrop
+}
+
+//NewDropRecordsAccessorMock returns a mock for github.com/insolar/insolar/ledger/storage/object.DropRecordsAccessor
+func NewDropRecordsAccessorMock(t minimock.Tester) *DropRecordsAccessorMock {
+	m := &DropRecordsAccessorMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ForDropMock = mDropRecordsAccessorMockForDrop{mock: m}
+
+	return m
+}
+
+type mDropRecordsAccessorMockForDrop struct {
+	mock              *DropRecordsAccessorMock
+	mainExpectation   *DropRecordsAccessorMockForDropExpectation
+	expectationSeries []*DropRecordsAccessorMockForDropExpectation
+}
+
+type DropRecordsAccessorMockForDropExpectation struct {
+	input  *DropRecordsAccessorMockForDropInput
+	result *DropRecordsAccessorMockForDropResult
+}
+
+type DropRecordsAccessorMockForDropInput struct {
+	p  context.Context
+	p1 insolar.JetID
+	p2 insolar.PulseNumber
+}
+
+type DropRecordsAccessorMockForDropResult struct {
+	r  []insolar.ID
+	r1 error
+}
+
+//Expect specifies that invocation of DropRecordsAccessor.ForDrop is expected from 1 to Infinity times
+func (m *mDropRecordsAccessorMockForDrop) Expect(p context.Context, p1 insolar.JetID, p2 insolar.PulseNumber) *mDropRecordsAccessorMockForDrop {
+	m.mock.ForDropFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &DropRecordsAccessorMockForDropExpectation{}
+	}
+	m.mainExpectation.input = &DropRecordsAccessorMockForDropInput{p, p1, p2}
+	return m
+}
+
+//Return specifies results of invocation of DropRecordsAccessor.ForDrop
+func (m *mDropRecordsAccessorMockForDrop) Return(r []insolar.ID, r1 error) *DropRecordsAccessorMock {
+	m.mock.ForDropFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &DropRecordsAccessorMockForDropExpectation{}
+	}
+	m.mainExpectation.result = &DropRecordsAccessorMockForDropResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of DropRecordsAccessor.ForDrop is expected once
+func (m *mDropRecordsAccessorMockForDrop) ExpectOnce(p context.Context, p1 insolar.JetID, p2 insolar.PulseNumber) *DropRecordsAccessorMockForDropExpectation {
+	m.mock.ForDropFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &DropRecordsAccessorMockForDropExpectation{}
+	expectation.input = &DropRecordsAccessorMockForDropInput{p, p1, p2}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *DropRecordsAccessorMockForDropExpectation) Return(r []insolar.ID, r1 error) {
+	e.result = &DropRecordsAccessorMockForDropResult{r, r1}
+}
+
+//Set uses given function f as a mock of DropRecordsAccessor.ForDrop method
+func (m *mDropRecordsAccessorMockForDrop) Set(f func(p context.Context, p1 insolar.JetID, p2 insolar.PulseNumber) (r []insolar.ID, r1 error)) *DropRecordsAccessorMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.ForDropFunc = f
+	return m.mock
+}
+
+//ForDrop implements github.com/insolar/insolar/ledger/storage/object.DropRecordsAccessor interface
+func (m *DropRecordsAccessorMock) ForDrop(p context.Context, p1 insolar.JetID, p2 insolar.PulseNumber) (r []insolar.ID, r1 error) {
+	counter := atomic.AddUint64(&m.ForDropPreCounter, 1)
+	defer atomic.AddUint64(&m.ForDropCounter, 1)
+
+	if len(m.ForDropMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.ForDropMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to DropRecordsAccessorMock.ForDrop. %v %v %v", p, p1, p2)
+			return
+		}
+
+		input := m.ForDropMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, DropRecordsAccessorMockForDropInput{p, p1, p2}, "DropRecordsAccessor.ForDrop got unexpected parameters")
+
+		result := m.ForDropMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the DropRecordsAccessorMock.ForDrop")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.ForDropMock.mainExpectation != nil {
+
+		input := m.ForDropMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, DropRecordsAccessorMockForDropInput{p, p1, p2}, "DropRecordsAccessor.ForDrop got unexpected parameters")
+		}
+
+		result := m.ForDropMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the DropRecordsAccessorMock.ForDrop")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.ForDropFunc == nil {
+		m.t.Fatalf("Unexpected call to DropRecordsAccessorMock.ForDrop. %v %v %v", p, p1, p2)
+		return
+	}
+
+	return m.ForDropFunc(p, p1, p2)
+}
+
+//ForDropMinimockCounter returns a count of DropRecordsAccessorMock.ForDropFunc invocations
+func (m *DropRecordsAccessorMock) ForDropMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.ForDropCounter)
+}
+
+//ForDropMinimockPreCounter returns the value of DropRecordsAccessorMock.ForDrop invocations
+func (m *DropRecordsAccessorMock) ForDropMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.ForDropPreCounter)
+}
+
+//ForDropFinished returns true if mock invocations count is ok
+func (m *DropRecordsAccessorMock) ForDropFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.ForDropMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.ForDropCounter) == uint64(len(m.ForDropMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.ForDropMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.ForDropCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.ForDropFunc != nil {
+		return atomic.LoadUint64(&m.ForDropCounter) > 0
+	}
+
+	return true
+}
+
+//ValidateCallCounters checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *DropRecordsAccessorMock) ValidateCallCounters() {
+
+	if !m.ForDropFinished() {
+		m.t.Fatal("Expected call to DropRecordsAccessorMock.ForDrop")
+	}
+
+}
+
+//CheckMocksCalled checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *DropRecordsAccessorMock) CheckMocksCalled() {
+	m.Finish()
+}
+
+//Finish checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish or use Finish method of minimock.Controller
+func (m *DropRecordsAccessorMock) Finish() {
+	m.MinimockFinish()
+}
+
+//MinimockFinish checks that all mocked methods of the interface have been called at least once
+func (m *DropRecordsAccessorMock) MinimockFinish() {
+
+	if !m.ForDropFinished() {
+		m.t.Fatal("Expected call to DropRecordsAccessorMock.ForDrop")
+	}
+
+}
+
+//Wait waits for all mocked methods to be called at least once
+//Deprecated: please use MinimockWait or use Wait method of minimock.Controller
+func (m *DropRecordsAccessorMock) Wait(timeout time.Duration) {
+	m.MinimockWait(timeout)
+}
+
+//MinimockWait waits for all mocked methods to be called at least once
+//this method is called by minimock.Controller
+func (m *DropRecordsAccessorMock) MinimockWait(timeout time.Duration) {
+	timeoutCh := time.After(timeout)
+	for {
+		ok := true
+		ok = ok && m.ForDropFinished()
+
+		if ok {
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+
+			if !m.ForDropFinished() {
+				m.t.Error("Expected call to DropRecordsAccessorMock.ForDrop")
+			}
+
+			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+//AllMocksCalled returns true if all mocked methods were called before the execution of AllMocksCalled,
+//it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
+func (m *DropRecordsAccessorMock) AllMocksCalled() bool {
+
+	if !m.ForDropFinished() {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,269 @@
+package object
+
+/*
+DO NOT EDIT!
+This code was generated automatically using github.com/gojuno/minimock v1.9
+The original interface "EventAccessor" can be found in github.com/insolar/insolar/ledger/storage/object
+*/
+import (
+	context "context"
+	"sync/atomic"
+	"time"
+
+	"github.com/gojuno/minimock"
+	insolar "github.com/insolar/insolar/insolar"
+
+	testify_assert "github.com/stretchr/testify/assert"
+)
+
+//EventAccessorMock implements github.com/insolar/insolar/ledger/storage/object.EventAccessor
+type EventAccessorMock struct {
+	t minimock.Tester
+
+	ForObjectFunc       func(p context.Context, p1 insolar.ID, p2 string, p3 insolar.PulseNumber, p4 insolar.PulseNumber) (r []insolar.EmittedEvent, r1 error)
+	ForObjectCounter    uint64
+	ForObjectPreCounter uint64
+	ForObjectMock       mEventAccessorMockForObject
+}
+
+//NewEventAccessorMock returns a mock for github.com/insolar/insolar/ledger/storage/object.EventAccessor
+func NewEventAccessorMock(t minimock.Tester) *EventAccessorMock {
+	m := &EventAccessorMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ForObjectMock = mEventAccessorMockForObject{mock: m}
+
+	return m
+}
+
+type mEventAccessorMockForObject struct {
+	mock              *EventAccessorMock
+	mainExpectation   *EventAccessorMockForObjectExpectation
+	expectationSeries []*EventAccessorMockForObjectExpectation
+}
+
+type EventAccessorMockForObjectExpectation struct {
+	input  *EventAccessorMockForObjectInput
+	result *EventAccessorMockForObjectResult
+}
+
+type EventAccessorMockForObjectInput struct {
+	p  context.Context
+	p1 insolar.ID
+	p2 string
+	p3 insolar.PulseNumber
+	p4 insolar.PulseNumber
+}
+
+type EventAccessorMockForObjectResult struct {
+	r  []insolar.EmittedEvent
+	r1 error
+}
+
+//Expect specifies that invocation of EventAccessor.ForObject is expected from 1 to Infinity times
+func (m *mEventAccessorMockForObject) Expect(p context.Context, p1 insolar.ID, p2 string, p3 insolar.PulseNumber, p4 insolar.PulseNumber) *mEventAccessorMockForObject {
+	m.mock.ForObjectFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &EventAccessorMockForObjectExpectation{}
+	}
+	m.mainExpectation.input = &EventAccessorMockForObjectInput{p, p1, p2, p3, p4}
+	return m
+}
+
+//Return specifies results of invocation of EventAccessor.ForObject
+func (m *mEventAccessorMockForObject) Return(r []insolar.EmittedEvent, r1 error) *EventAccessorMock {
+	m.mock.ForObjectFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &EventAccessorMockForObjectExpectation{}
+	}
+	m.mainExpectation.result = &EventAccessorMockForObjectResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of EventAccessor.ForObject is expected once
+func (m *mEventAccessorMockForObject) ExpectOnce(p context.Context, p1 insolar.ID, p2 string, p3 insolar.PulseNumber, p4 insolar.PulseNumber) *EventAccessorMockForObjectExpectation {
+	m.mock.ForObjectFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &EventAccessorMockForObjectExpectation{}
+	expectation.input = &EventAccessorMockForObjectInput{p, p1, p2, p3, p4}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *EventAccessorMockForObjectExpectation) Return(r []insolar.EmittedEvent, r1 error) {
+	e.result = &EventAccessorMockForObjectResult{r, r1}
+}
+
+//Set uses given function f as a mock of EventAccessor.ForObject method
+func (m *mEventAccessorMockForObject) Set(f func(p context.Context, p1 insolar.ID, p2 string, p3 insolar.PulseNumber, p4 insolar.PulseNumber) (r []insolar.EmittedEvent, r1 error)) *EventAccessorMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.ForObjectFunc = f
+	return m.mock
+}
+
+//ForObject implements github.com/insolar/insolar/ledger/storage/object.EventAccessor interface
+func (m *EventAccessorMock) ForObject(p context.Context, p1 insolar.ID, p2 string, p3 insolar.PulseNumber, p4 insolar.PulseNumber) (r []insolar.EmittedEvent, r1 error) {
+	counter := atomic.AddUint64(&m.ForObjectPreCounter, 1)
+	defer atomic.AddUint64(&m.ForObjectCounter, 1)
+
+	if len(m.ForObjectMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.ForObjectMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to EventAccessorMock.ForObject. %v %v %v %v %v", p, p1, p2, p3, p4)
+			return
+		}
+
+		input := m.ForObjectMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, EventAccessorMockForObjectInput{p, p1, p2, p3, p4}, "EventAccessor.ForObject got unexpected parameters")
+
+		result := m.ForObjectMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the EventAccessorMock.ForObject")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.ForObjectMock.mainExpectation != nil {
+
+		input := m.ForObjectMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, EventAccessorMockForObjectInput{p, p1, p2, p3, p4}, "EventAccessor.ForObject got unexpected parameters")
+		}
+
+		result := m.ForObjectMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the EventAccessorMock.ForObject")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.ForObjectFunc == nil {
+		m.t.Fatalf("Unexpected call to EventAccessorMock.ForObject. %v %v %v %v %v", p, p1, p2, p3, p4)
+		return
+	}
+
+	return m.ForObjectFunc(p, p1, p2, p3, p4)
+}
+
+//ForObjectMinimockCounter returns a count of EventAccessorMock.ForObjectFunc invocations
+func (m *EventAccessorMock) ForObjectMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.ForObjectCounter)
+}
+
+//ForObjectMinimockPreCounter returns the value of EventAccessorMock.ForObject invocations
+func (m *EventAccessorMock) ForObjectMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.ForObjectPreCounter)
+}
+
+//ForObjectFinished returns true if mock invocations count is ok
+func (m *EventAccessorMock) ForObjectFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.ForObjectMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.ForObjectCounter) == uint64(len(m.ForObjectMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.ForObjectMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.ForObjectCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.ForObjectFunc != nil {
+		return atomic.LoadUint64(&m.ForObjectCounter) > 0
+	}
+
+	return true
+}
+
+//ValidateCallCounters checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *EventAccessorMock) ValidateCallCounters() {
+
+	if !m.ForObjectFinished() {
+		m.t.Fatal("Expected call to EventAccessorMock.ForObject")
+	}
+
+}
+
+//CheckMocksCalled checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *EventAccessorMock) CheckMocksCalled() {
+	m.Finish()
+}
+
+//Finish checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish or use Finish method of minimock.Controller
+func (m *EventAccessorMock) Finish() {
+	m.MinimockFinish()
+}
+
+//MinimockFinish checks that all mocked methods of the interface have been called at least once
+func (m *EventAccessorMock) MinimockFinish() {
+
+	if !m.ForObjectFinished() {
+		m.t.Fatal("Expected call to EventAccessorMock.ForObject")
+	}
+
+}
+
+//Wait waits for all mocked methods to be called at least once
+//Deprecated: please use MinimockWait or use Wait method of minimock.Controller
+func (m *EventAccessorMock) Wait(timeout time.Duration) {
+	m.MinimockWait(timeout)
+}
+
+//MinimockWait waits for all mocked methods to be called at least once
+//this method is called by minimock.Controller
+func (m *EventAccessorMock) MinimockWait(timeout time.Duration) {
+	timeoutCh := time.After(timeout)
+	for {
+		ok := true
+		ok = ok && m.ForObjectFinished()
+
+		if ok {
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+
+			if !m.ForObjectFinished() {
+				m.t.Error("Expected call to EventAccessorMock.ForObject")
+			}
+
+			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+//AllMocksCalled returns true if all mocked methods were called before the execution of AllMocksCalled,
+//it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
+func (m *EventAccessorMock) AllMocksCalled() bool {
+
+	if !m.ForObjectFinished() {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,265 @@
+package object
+
+/*
+DO NOT EDIT!
+This code was generated automatically using github.com/gojuno/minimock v1.9
+The original interface "EventModifier" can be found in github.com/insolar/insolar/ledger/storage/object
+*/
+import (
+	context "context"
+	"sync/atomic"
+	"time"
+
+	"github.com/gojuno/minimock"
+	insolar "github.com/insolar/insolar/insolar"
+
+	testify_assert "github.com/stretchr/testify/assert"
+)
+
+//EventModifierMock implements github.com/insolar/insolar/ledger/storage/object.EventModifier
+type EventModifierMock struct {
+	t minimock.Tester
+
+	SetEventsFunc       func(p context.Context, p1 insolar.ID, p2 insolar.ID, p3 []insolar.Event) (r error)
+	SetEventsCounter    uint64
+	SetEventsPreCounter uint64
+	SetEventsMock       mEventModifierMockSetEvents
+}
+
+//NewEventModifierMock returns a mock for github.com/insolar/insolar/ledger/storage/object.EventModifier
+func NewEventModifierMock(t minimock.Tester) *EventModifierMock {
+	m := &EventModifierMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.SetEventsMock = mEventModifierMockSetEvents{mock: m}
+
+	return m
+}
+
+type mEventModifierMockSetEvents struct {
+	mock              *EventModifierMock
+	mainExpectation   *EventModifierMockSetEventsExpectation
+	expectationSeries []*EventModifierMockSetEventsExpectation
+}
+
+type EventModifierMockSetEventsExpectation struct {
+	input  *EventModifierMockSetEventsInput
+	result *EventModifierMockSetEventsResult
+}
+
+type EventModifierMockSetEventsInput struct {
+	p  context.Context
+	p1 insolar.ID
+	p2 insolar.ID
+	p3 []insolar.Event
+}
+
+type EventModifierMockSetEventsResult struct {
+	r error
+}
+
+//Expect specifies that invocation of EventModifier.SetEvents is expected from 1 to Infinity times
+func (m *mEventModifierMockSetEvents) Expect(p context.Context, p1 insolar.ID, p2 insolar.ID, p3 []insolar.Event) *mEventModifierMockSetEvents {
+	m.mock.SetEventsFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &EventModifierMockSetEventsExpectation{}
+	}
+	m.mainExpectation.input = &EventModifierMockSetEventsInput{p, p1, p2, p3}
+	return m
+}
+
+//Return specifies results of invocation of EventModifier.SetEvents
+func (m *mEventModifierMockSetEvents) Return(r error) *EventModifierMock {
+	m.mock.SetEventsFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &EventModifierMockSetEventsExpectation{}
+	}
+	m.mainExpectation.result = &EventModifierMockSetEventsResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of EventModifier.SetEvents is expected once
+func (m *mEventModifierMockSetEvents) ExpectOnce(p context.Context, p1 insolar.ID, p2 insolar.ID, p3 []insolar.Event) *EventModifierMockSetEventsExpectation {
+	m.mock.SetEventsFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &EventModifierMockSetEventsExpectation{}
+	expectation.input = &EventModifierMockSetEventsInput{p, p1, p2, p3}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *EventModifierMockSetEventsExpectation) Return(r error) {
+	e.result = &EventModifierMockSetEventsResult{r}
+}
+
+//Set uses given function f as a mock of EventModifier.SetEvents method
+func (m *mEventModifierMockSetEvents) Set(f func(p context.Context, p1 insolar.ID, p2 insolar.ID, p3 []insolar.Event) (r error)) *EventModifierMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.SetEventsFunc = f
+	return m.mock
+}
+
+//SetEvents implements github.com/insolar/insolar/ledger/storage/object.EventModifier interface
+func (m *EventModifierMock) SetEvents(p context.Context, p1 insolar.ID, p2 insolar.ID, p3 []insolar.Event) (r error) {
+	counter := atomic.AddUint64(&m.SetEventsPreCounter, 1)
+	defer atomic.AddUint64(&m.SetEventsCounter, 1)
+
+	if len(m.SetEventsMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.SetEventsMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to EventModifierMock.SetEvents. %v %v %v %v", p, p1, p2, p3)
+			return
+		}
+
+		input := m.SetEventsMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, EventModifierMockSetEventsInput{p, p1, p2, p3}, "EventModifier.SetEvents got unexpected parameters")
+
+		result := m.SetEventsMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the EventModifierMock.SetEvents")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.SetEventsMock.mainExpectation != nil {
+
+		input := m.SetEventsMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, EventModifierMockSetEventsInput{p, p1, p2, p3}, "EventModifier.SetEvents got unexpected parameters")
+		}
+
+		result := m.SetEventsMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the EventModifierMock.SetEvents")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.SetEventsFunc == nil {
+		m.t.Fatalf("Unexpected call to EventModifierMock.SetEvents. %v %v %v %v", p, p1, p2, p3)
+		return
+	}
+
+	return m.SetEventsFunc(p, p1, p2, p3)
+}
+
+//SetEventsMinimockCounter returns a count of EventModifierMock.SetEventsFunc invocations
+func (m *EventModifierMock) SetEventsMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.SetEventsCounter)
+}
+
+//SetEventsMinimockPreCounter returns the value of EventModifierMock.SetEvents invocations
+func (m *EventModifierMock) SetEventsMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.SetEventsPreCounter)
+}
+
+//SetEventsFinished returns true if mock invocations count is ok
+func (m *EventModifierMock) SetEventsFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.SetEventsMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.SetEventsCounter) == uint64(len(m.SetEventsMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.SetEventsMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.SetEventsCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.SetEventsFunc != nil {
+		return atomic.LoadUint64(&m.SetEventsCounter) > 0
+	}
+
+	return true
+}
+
+//ValidateCallCounters checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *EventModifierMock) ValidateCallCounters() {
+
+	if !m.SetEventsFinished() {
+		m.t.Fatal("Expected call to EventModifierMock.SetEvents")
+	}
+
+}
+
+//CheckMocksCalled checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *EventModifierMock) CheckMocksCalled() {
+	m.Finish()
+}
+
+//Finish checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish or use Finish method of minimock.Controller
+func (m *EventModifierMock) Finish() {
+	m.MinimockFinish()
+}
+
+//MinimockFinish checks that all mocked methods of the interface have been called at least once
+func (m *EventModifierMock) MinimockFinish() {
+
+	if !m.SetEventsFinished() {
+		m.t.Fatal("Expected call to EventModifierMock.SetEvents")
+	}
+
+}
+
+//Wait waits for all mocked methods to be called at least once
+//Deprecated: please use MinimockWait or use Wait method of minimock.Controller
+func (m *EventModifierMock) Wait(timeout time.Duration) {
+	m.MinimockWait(timeout)
+}
+
+//MinimockWait waits for all mocked methods to be called at least once
+//this method is called by minimock.Controller
+func (m *EventModifierMock) MinimockWait(timeout time.Duration) {
+	timeoutCh := time.After(timeout)
+	for {
+		ok := true
+		ok = ok && m.SetEventsFinished()
+
+		if ok {
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+
+			if !m.SetEventsFinished() {
+				m.t.Error("Expected call to EventModifierMock.SetEvents")
+			}
+
+			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+//AllMocksCalled returns true if all mocked methods were called before the execution of AllMocksCalled,
+//it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
+func (m *EventModifierMock) AllMocksCalled() bool {
+
+	if !m.SetEventsFinished() {
+		return false
+	}
+
+	return true
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/insolar/insolar/insolar/gen"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/internal/ledger/store/storetest"
 	"github.com/insolar/insolar/ledger/storage/object"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -33,10 +34,14 @@ import (
 func TestIndex_Components(t *testing.T) {
 	t.Parallel()
 
+	storetest.ForEachEngine(t, testIndexComponents)
+}
+
+func testIndexComponents(t *testing.T, newDB func() store.DB) {
 	ctx := inslogger.TestContext(t)
 
 	indexMemory := object.NewIndexMemory()
-	indexDB := object.NewIndexDB(store.NewMemoryMockDB())
+	indexDB := object.NewIndexDB(newDB())
 
 	type tempIndex struct {
 		id  insolar.ID
@@ -100,7 +105,7 @@ func TestIndex_Components(t *testing.T) {
 		t.Parallel()
 
 		indexMemory := object.NewIndexMemory()
-		indexDB := object.NewIndexDB(store.NewMemoryMockDB())
+		indexDB := object.NewIndexDB(newDB())
 
 		for _, i := range indices {
 			memErr := indexMemory.Set(ctx, i.id, i.idx)
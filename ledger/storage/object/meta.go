@@ -56,6 +56,11 @@ func (*GenesisRecord) GetIsPrototype() bool {
 	return false
 }
 
+// GetRequest returns reference to request that produced the state.
+func (*GenesisRecord) GetRequest() *insolar.Reference {
+	return nil
+}
+
 // ChildRecord is a child activation record. Its used for children iterating.
 type ChildRecord struct {
 	PrevChild *insolar.ID
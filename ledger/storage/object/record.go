@@ -20,10 +20,12 @@ import (
 	"context"
 	"sync"
 
+	"github.com/pkg/errors"
 	"go.opencensus.io/stats"
 
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/insolar/utils"
 	"github.com/insolar/insolar/internal/ledger/store"
 )
 
@@ -59,6 +61,33 @@ type RecordAccessor interface {
 	ForID(ctx context.Context, id insolar.ID) (record.MaterialRecord, error)
 }
 
+//go:generate minimock -i github.com/insolar/insolar/ledger/storage/object.ResultAccessor -o ./ -s _mock.go
+
+// ResultAccessor provides info about results registered for requests.
+type ResultAccessor interface {
+	// ForRequest returns id of result record registered for provided request id.
+	ForRequest(ctx context.Context, request insolar.ID) (insolar.ID, error)
+}
+
+//go:generate minimock -i github.com/insolar/insolar/ledger/storage/object.EventAccessor -o ./ -s _mock.go
+
+// EventAccessor provides info about events emitted by objects.
+type EventAccessor interface {
+	// ForObject returns events emitted by provided object in pulses from..to (both included) in order of emission.
+	// Events of all names are returned if name is empty.
+	ForObject(
+		ctx context.Context, obj insolar.ID, name string, from, to insolar.PulseNumber,
+	) ([]insolar.EmittedEvent, error)
+}
+
+//go:generate minimock -i github.com/insolar/insolar/ledger/storage/object.EventModifier -o ./ -s _mock.go
+
+// EventModifier provides methods for indexing events emitted by objects.
+type EventModifier interface {
+	// SetEvents indexes events emitted by provided object in a call which result is saved with provided id.
+	SetEvents(ctx context.Context, obj, result insolar.ID, events []insolar.Event) error
+}
+
 //go:generate minimock -i github.com/insolar/insolar/ledger/storage/object.RecordCollectionAccessor -o ./ -s _mock.go
 
 // RecordCollectionAccessor provides methods for querying records with specific search conditions.
@@ -67,6 +96,14 @@ type RecordCollectionAccessor interface {
 	ForPulse(ctx context.Context, jetID insolar.JetID, pn insolar.PulseNumber) []record.MaterialRecord
 }
 
+//go:generate minimock -i github.com/insolar/insolar/ledger/storage/object.DropRecordsAccessor -o ./ -s _mock.go
+
+// DropRecordsAccessor provides ids of records belonging to jet drops.
+type DropRecordsAccessor interface {
+	// ForDrop returns ids of records saved in provided jet in provided pulse.
+	ForDrop(ctx context.Context, jetID insolar.JetID, pn insolar.PulseNumber) ([]insolar.ID, error)
+}
+
 //go:generate minimock -i github.com/insolar/insolar/ledger/storage/object.RecordModifier -o ./ -s _mock.go
 
 // RecordModifier provides methods for setting record-values to storage.
@@ -88,8 +125,10 @@ type RecordMemory struct {
 	jetIndex         store.JetIndexModifier
 	jetIndexAccessor store.JetIndexAccessor
 
-	lock   sync.RWMutex
-	memory map[insolar.ID]record.MaterialRecord
+	lock    sync.RWMutex
+	memory  map[insolar.ID]record.MaterialRecord
+	results map[insolar.ID]insolar.ID
+	events  map[insolar.ID][]insolar.EmittedEvent
 }
 
 // NewRecordMemory creates a new instance of RecordMemory storage.
@@ -97,6 +136,8 @@ func NewRecordMemory() *RecordMemory {
 	ji := store.NewJetIndex()
 	return &RecordMemory{
 		memory:           map[insolar.ID]record.MaterialRecord{},
+		results:          map[insolar.ID]insolar.ID{},
+		events:           map[insolar.ID][]insolar.EmittedEvent{},
 		jetIndex:         ji,
 		jetIndexAccessor: ji,
 	}
@@ -114,6 +155,9 @@ func (m *RecordMemory) Set(ctx context.Context, id insolar.ID, rec record.Materi
 
 	m.memory[id] = rec
 	m.jetIndex.Add(id, rec.JetID)
+	if res, ok := rec.Record.(*ResultRecord); ok {
+		m.results[*res.Request.Record()] = id
+	}
 
 	stats.Record(ctx,
 		statIndexInMemoryCount.M(1),
@@ -136,6 +180,63 @@ func (m *RecordMemory) ForID(ctx context.Context, id insolar.ID) (rec record.Mat
 	return
 }
 
+// ForRequest returns id of result record registered for provided request id.
+func (m *RecordMemory) ForRequest(ctx context.Context, request insolar.ID) (insolar.ID, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	id, ok := m.results[request]
+	if !ok {
+		return insolar.ID{}, ErrNotFound
+	}
+
+	return id, nil
+}
+
+// ForObject returns events emitted by provided object in pulses from..to (both included) in order of emission.
+// Events of all names are returned if name is empty.
+func (m *RecordMemory) ForObject(
+	ctx context.Context, obj insolar.ID, name string, from, to insolar.PulseNumber,
+) ([]insolar.EmittedEvent, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	var events []insolar.EmittedEvent
+	for _, e := range m.events[obj] {
+		pn := e.Result.Pulse()
+		if pn < from || pn > to || (name != "" && e.Name != name) {
+			continue
+		}
+		events = append(events, e)
+	}
+
+	return events, nil
+}
+
+// SetEvents indexes events emitted by provided object in a call which result is saved with provided id.
+//
+// Result of a call is saved in the jet of its request, events are indexed by the light node of the object's jet
+// to be found by the object. Events of a result are indexed once.
+func (m *RecordMemory) SetEvents(ctx context.Context, obj, result insolar.ID, events []insolar.Event) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	// events are indexed in order of calls, so only events of the same pulse are checked
+	indexed := m.events[obj]
+	for i := len(indexed) - 1; i >= 0 && indexed[i].Result.Pulse() == result.Pulse(); i-- {
+		if indexed[i].Result == result {
+			return nil
+		}
+	}
+
+	for i, e := range events {
+		indexed = append(indexed, insolar.EmittedEvent{Event: e, Object: obj, Result: result, Index: i})
+	}
+	m.events[obj] = indexed
+
+	return nil
+}
+
 // ForPulse returns []MaterialRecord for a provided jetID and a pulse number.
 func (m *RecordMemory) ForPulse(
 	ctx context.Context, jetID insolar.JetID, pn insolar.PulseNumber,
@@ -153,6 +254,26 @@ func (m *RecordMemory) ForPulse(
 	return res
 }
 
+// ForDrop returns ids of records saved in provided jet in provided pulse.
+func (m *RecordMemory) ForDrop(
+	ctx context.Context, jetID insolar.JetID, pn insolar.PulseNumber,
+) ([]insolar.ID, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	ids := m.jetIndexAccessor.For(jetID, pn)
+	res := make([]insolar.ID, 0, len(ids))
+	for id := range ids {
+		// Jet index is not split by pulses, so records of other pulses are skipped here.
+		if id.Pulse() != pn {
+			continue
+		}
+		res = append(res, id)
+	}
+
+	return res, nil
+}
+
 // Remove method removes records from a storage for all pulses until pulse (pulse included)
 func (m *RecordMemory) Remove(ctx context.Context, pulse insolar.PulseNumber) {
 	m.lock.Lock()
@@ -165,6 +286,23 @@ func (m *RecordMemory) Remove(ctx context.Context, pulse insolar.PulseNumber) {
 
 		m.jetIndex.Delete(id, rec.JetID)
 		delete(m.memory, id)
+		if res, ok := rec.Record.(*ResultRecord); ok {
+			delete(m.results, *res.Request.Record())
+		}
+	}
+
+	for obj, events := range m.events {
+		left := events[:0]
+		for _, e := range events {
+			if e.Result.Pulse() != pulse {
+				left = append(left, e)
+			}
+		}
+		if len(left) == 0 {
+			delete(m.events, obj)
+			continue
+		}
+		m.events[obj] = left
 	}
 }
 
@@ -185,6 +323,89 @@ func (k recordKey) ID() []byte {
 	return (&res).Bytes()
 }
 
+type resultKey insolar.ID
+
+func (k resultKey) Scope() store.Scope {
+	return store.ScopeRequestResult
+}
+
+func (k resultKey) ID() []byte {
+	res := insolar.ID(k)
+	return (&res).Bytes()
+}
+
+// dropKey is a key of index of records belonging to a jet drop, there is a key for every record of the drop.
+type dropKey struct {
+	jetID  insolar.JetID
+	pulse  insolar.PulseNumber
+	record insolar.ID
+}
+
+func (k dropKey) Scope() store.Scope {
+	return store.ScopeDropRecords
+}
+
+func (k dropKey) ID() []byte {
+	return append(k.prefix(), k.record.Bytes()...)
+}
+
+// prefix returns common part of ids of keys of the drop.
+func (k dropKey) prefix() []byte {
+	return append(k.pulse.Bytes(), k.jetID[:]...)
+}
+
+// eventKey is a key of index of events emitted by an object, there is a key for every result with events.
+// Key with empty name indexes events of all names. Result id starts with its pulse, so keys of an object
+// and a name are ordered by pulse.
+type eventKey struct {
+	object insolar.ID
+	name   string
+	result insolar.ID
+}
+
+func (k eventKey) Scope() store.Scope {
+	return store.ScopeEvent
+}
+
+func (k eventKey) ID() []byte {
+	return append(k.prefix(), k.result.Bytes()...)
+}
+
+// prefix returns common part of ids of keys of the object and the name.
+func (k eventKey) prefix() []byte {
+	id := append(k.object.Bytes(), utils.UInt32ToBytes(uint32(len(k.name)))...)
+	return append(id, k.name...)
+}
+
+// eventKeys returns keys of indexes the result should be added to.
+func eventKeys(id insolar.ID, res *ResultRecord) []eventKey {
+	if len(res.Events) == 0 {
+		return nil
+	}
+	keys := []eventKey{{object: res.Object, result: id}}
+	names := map[string]struct{}{}
+	for _, e := range res.Events {
+		if _, ok := names[e.Name]; ok || e.Name == "" {
+			continue
+		}
+		names[e.Name] = struct{}{}
+		keys = append(keys, eventKey{object: res.Object, name: e.Name, result: id})
+	}
+	return keys
+}
+
+// resultEvents returns events of the result with provided name, all events if name is empty.
+func resultEvents(id insolar.ID, res *ResultRecord, name string) []insolar.EmittedEvent {
+	var events []insolar.EmittedEvent
+	for i, e := range res.Events {
+		if name != "" && e.Name != name {
+			continue
+		}
+		events = append(events, insolar.EmittedEvent{Event: e, Object: res.Object, Result: id, Index: i})
+	}
+	return events
+}
+
 // NewRecordDB creates new DB storage instance.
 func NewRecordDB(db store.DB) *RecordDB {
 	return &RecordDB{db: db}
@@ -206,15 +427,160 @@ func (r *RecordDB) ForID(ctx context.Context, id insolar.ID) (record.MaterialRec
 	return r.get(id)
 }
 
+// ForRequest returns id of result record registered for provided request id.
+func (r *RecordDB) ForRequest(ctx context.Context, request insolar.ID) (insolar.ID, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	buff, err := r.db.Get(resultKey(request))
+	if err == store.ErrNotFound {
+		return insolar.ID{}, ErrNotFound
+	}
+	if err != nil {
+		return insolar.ID{}, err
+	}
+	var id insolar.ID
+	copy(id[:], buff)
+	return id, nil
+}
+
+// SetResultIndex saves result id for request of provided result record. It's used to rebuild the index for records
+// that were written to db directly.
+func SetResultIndex(db store.DB, id insolar.ID, rec record.MaterialRecord) error {
+	res, ok := rec.Record.(*ResultRecord)
+	if !ok {
+		return nil
+	}
+	return db.Set(resultKey(*res.Request.Record()), id.Bytes())
+}
+
+// ForObject returns events emitted by provided object in pulses from..to (both included) in order of emission.
+// Events of all names are returned if name is empty.
+func (r *RecordDB) ForObject(
+	ctx context.Context, obj insolar.ID, name string, from, to insolar.PulseNumber,
+) ([]insolar.EmittedEvent, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	// ids are collected before reading records, so no nested transactions are opened
+	var ids []insolar.ID
+	prefix := eventKey{object: obj, name: name}.prefix()
+	err := store.Iterate(r.db, store.ScopeEvent, prefix, append(prefix, from.Bytes()...),
+		func(key, _ []byte) (bool, error) {
+			var id insolar.ID
+			copy(id[:], key[len(prefix):])
+			if id.Pulse() > to {
+				return false, nil
+			}
+			ids = append(ids, id)
+			return true, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []insolar.EmittedEvent
+	for _, id := range ids {
+		rec, err := r.get(id)
+		if err != nil {
+			return nil, err
+		}
+		res, ok := rec.Record.(*ResultRecord)
+		if !ok {
+			return nil, errors.New("failed to decode result")
+		}
+		events = append(events, resultEvents(id, res, name)...)
+	}
+
+	return events, nil
+}
+
+// ForDrop returns ids of records saved in provided jet in provided pulse. Ids are ordered.
+func (r *RecordDB) ForDrop(
+	ctx context.Context, jetID insolar.JetID, pn insolar.PulseNumber,
+) ([]insolar.ID, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	var ids []insolar.ID
+	prefix := dropKey{jetID: jetID, pulse: pn}.prefix()
+	err := store.Iterate(r.db, store.ScopeDropRecords, prefix, nil, func(key, _ []byte) (bool, error) {
+		var id insolar.ID
+		copy(id[:], key[len(prefix):])
+		ids = append(ids, id)
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// SetDropIndex adds id of provided record to index of records of its jet drop. It's used to rebuild the index for
+// records that were written to db directly.
+func SetDropIndex(db store.DB, id insolar.ID, rec record.MaterialRecord) error {
+	return db.Set(dropKey{jetID: rec.JetID, pulse: id.Pulse(), record: id}, []byte{})
+}
+
+// SetEvents does nothing, events are indexed when result records are saved. Heavy node keeps results of all jets,
+// so they are found by the object.
+func (r *RecordDB) SetEvents(ctx context.Context, obj, result insolar.ID, events []insolar.Event) error {
+	return nil
+}
+
+// SetEventIndex adds id of provided result record to indexes of events emitted by its object. It's used to rebuild
+// the index for records that were written to db directly.
+func SetEventIndex(db store.DB, id insolar.ID, rec record.MaterialRecord) error {
+	res, ok := rec.Record.(*ResultRecord)
+	if !ok {
+		return nil
+	}
+	for _, key := range eventKeys(id, res) {
+		err := db.Set(key, []byte{})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// set saves the record and its indexes. They are saved in one transaction if db is a backend, so indexes are never
+// left without the record or vice versa.
 func (r *RecordDB) set(id insolar.ID, rec record.MaterialRecord) error {
+	if backend, ok := r.db.(store.Backend); ok {
+		return backend.Update(func(txn store.Txn) error {
+			return setRecord(store.TxnDB(txn), id, rec)
+		})
+	}
+	return setRecord(r.db, id, rec)
+}
+
+func setRecord(db store.DB, id insolar.ID, rec record.MaterialRecord) error {
 	key := recordKey(id)
 
-	_, err := r.db.Get(key)
+	_, err := db.Get(key)
 	if err == nil {
 		return ErrOverride
 	}
 
-	return r.db.Set(key, EncodeMaterial(rec))
+	err = db.Set(key, EncodeMaterial(rec))
+	if err != nil {
+		return err
+	}
+
+	err = SetResultIndex(db, id, rec)
+	if err != nil {
+		return err
+	}
+
+	err = SetDropIndex(db, id, rec)
+	if err != nil {
+		return err
+	}
+
+	return SetEventIndex(db, id, rec)
 }
 
 func (r *RecordDB) get(id insolar.ID) (rec record.MaterialRecord, err error) {
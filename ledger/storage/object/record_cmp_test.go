@@ -18,6 +18,7 @@ package object_test
 
 import (
 	"math/rand"
+	"sort"
 	"testing"
 
 	fuzz "github.com/google/gofuzz"
@@ -29,13 +30,18 @@ import (
 	"github.com/insolar/insolar/insolar/record"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/internal/ledger/store/storetest"
 	"github.com/insolar/insolar/ledger/storage/object"
 )
 
 func TestRecord_Components(t *testing.T) {
+	storetest.ForEachEngine(t, testRecordComponents)
+}
+
+func testRecordComponents(t *testing.T, newDB func() store.DB) {
 	ctx := inslogger.TestContext(t)
 	memStorage := object.NewRecordMemory()
-	dbStorage := object.NewRecordDB(store.NewMemoryMockDB())
+	dbStorage := object.NewRecordDB(newDB())
 
 	type tempRecord struct {
 		id  insolar.ID
@@ -93,7 +99,7 @@ func TestRecord_Components(t *testing.T) {
 		t.Parallel()
 
 		memStorage := object.NewRecordMemory()
-		dbStorage := object.NewRecordDB(store.NewMemoryMockDB())
+		dbStorage := object.NewRecordDB(newDB())
 
 		for _, r := range records {
 			memErr := memStorage.Set(ctx, r.id, r.rec)
@@ -111,4 +117,142 @@ func TestRecord_Components(t *testing.T) {
 			assert.Equal(t, object.ErrOverride, dbErr)
 		}
 	})
+	t.Run("returns result for request", func(t *testing.T) {
+		t.Parallel()
+
+		memStorage := object.NewRecordMemory()
+		dbStorage := object.NewRecordDB(newDB())
+
+		for _, r := range records {
+			request := gen.Reference()
+			rec := record.MaterialRecord{
+				Record: &object.ResultRecord{Request: request},
+				JetID:  r.rec.JetID,
+			}
+			memErr := memStorage.Set(ctx, r.id, rec)
+			dbErr := dbStorage.Set(ctx, r.id, rec)
+			require.NoError(t, memErr)
+			require.NoError(t, dbErr)
+
+			memResult, memErr := memStorage.ForRequest(ctx, *request.Record())
+			dbResult, dbErr := dbStorage.ForRequest(ctx, *request.Record())
+			require.NoError(t, memErr)
+			require.NoError(t, dbErr)
+			assert.Equal(t, r.id, memResult)
+			assert.Equal(t, r.id, dbResult)
+		}
+
+		_, memErr := memStorage.ForRequest(ctx, gen.ID())
+		_, dbErr := dbStorage.ForRequest(ctx, gen.ID())
+		assert.Equal(t, object.ErrNotFound, memErr)
+		assert.Equal(t, object.ErrNotFound, dbErr)
+	})
+
+	t.Run("returns events for object", func(t *testing.T) {
+		t.Parallel()
+
+		memStorage := object.NewRecordMemory()
+		dbStorage := object.NewRecordDB(newDB())
+
+		obj := gen.ID()
+		pulse := gen.PulseNumber()
+		first := *insolar.NewID(pulse, []byte{1})
+		second := *insolar.NewID(pulse+1, []byte{2})
+		results := map[insolar.ID]*object.ResultRecord{
+			first: {Object: obj, Request: gen.Reference(), Events: []insolar.Event{
+				{Name: "Transfer", Payload: []byte{1}},
+				{Name: "Burn", Payload: []byte{2}},
+			}},
+			second: {Object: obj, Request: gen.Reference(), Events: []insolar.Event{
+				{Name: "Transfer", Payload: []byte{3}},
+			}},
+		}
+		for _, id := range []insolar.ID{first, second} {
+			rec := record.MaterialRecord{Record: results[id], JetID: gen.JetID()}
+			require.NoError(t, memStorage.Set(ctx, id, rec))
+			require.NoError(t, dbStorage.Set(ctx, id, rec))
+			// light node indexes events sent to the jet of the object, heavy node indexes saved results
+			require.NoError(t, memStorage.SetEvents(ctx, obj, id, results[id].Events))
+			require.NoError(t, dbStorage.SetEvents(ctx, obj, id, results[id].Events))
+		}
+		// repeated message doesn't duplicate events
+		require.NoError(t, memStorage.SetEvents(ctx, obj, second, results[second].Events))
+
+		check := func(name string, from, to insolar.PulseNumber, expected []insolar.EmittedEvent) {
+			memEvents, memErr := memStorage.ForObject(ctx, obj, name, from, to)
+			dbEvents, dbErr := dbStorage.ForObject(ctx, obj, name, from, to)
+			require.NoError(t, memErr)
+			require.NoError(t, dbErr)
+			assert.Equal(t, expected, memEvents)
+			assert.Equal(t, expected, dbEvents)
+		}
+
+		check("", pulse, pulse+1, []insolar.EmittedEvent{
+			{Event: insolar.Event{Name: "Transfer", Payload: []byte{1}}, Object: obj, Result: first, Index: 0},
+			{Event: insolar.Event{Name: "Burn", Payload: []byte{2}}, Object: obj, Result: first, Index: 1},
+			{Event: insolar.Event{Name: "Transfer", Payload: []byte{3}}, Object: obj, Result: second, Index: 0},
+		})
+		check("Transfer", pulse+1, pulse+1, []insolar.EmittedEvent{
+			{Event: insolar.Event{Name: "Transfer", Payload: []byte{3}}, Object: obj, Result: second, Index: 0},
+		})
+		check("Burn", pulse+1, pulse+1, nil)
+		check("Transfer", pulse, pulse+1, []insolar.EmittedEvent{
+			{Event: insolar.Event{Name: "Transfer", Payload: []byte{1}}, Object: obj, Result: first, Index: 0},
+			{Event: insolar.Event{Name: "Transfer", Payload: []byte{3}}, Object: obj, Result: second, Index: 0},
+		})
+
+		memStorage.Remove(ctx, pulse)
+		memEvents, err := memStorage.ForObject(ctx, obj, "", pulse, pulse+1)
+		require.NoError(t, err)
+		require.Len(t, memEvents, 1)
+		assert.Equal(t, second, memEvents[0].Result)
+	})
+
+	t.Run("returns records of drop", func(t *testing.T) {
+		t.Parallel()
+
+		memStorage := object.NewRecordMemory()
+		dbStorage := object.NewRecordDB(newDB())
+
+		jetID := gen.JetID()
+		pulse := gen.PulseNumber()
+		var expected []insolar.ID
+		for i := byte(0); i < 3; i++ {
+			id := *insolar.NewID(pulse, []byte{i})
+			rec := record.MaterialRecord{Record: &object.ResultRecord{Object: gen.ID()}, JetID: jetID}
+			require.NoError(t, memStorage.Set(ctx, id, rec))
+			require.NoError(t, dbStorage.Set(ctx, id, rec))
+			expected = append(expected, id)
+		}
+		other := record.MaterialRecord{Record: &object.ResultRecord{Object: gen.ID()}, JetID: jetID}
+		require.NoError(t, memStorage.Set(ctx, *insolar.NewID(pulse+1, []byte{1}), other))
+		require.NoError(t, dbStorage.Set(ctx, *insolar.NewID(pulse+1, []byte{1}), other))
+
+		memIDs, memErr := memStorage.ForDrop(ctx, jetID, pulse)
+		dbIDs, dbErr := dbStorage.ForDrop(ctx, jetID, pulse)
+		require.NoError(t, memErr)
+		require.NoError(t, dbErr)
+		byID := func(ids []insolar.ID) func(i, j int) bool {
+			return func(i, j int) bool { return ids[i].Compare(ids[j]) < 0 }
+		}
+		sort.Slice(memIDs, byID(memIDs))
+		assert.Equal(t, expected, memIDs)
+		assert.Equal(t, expected, dbIDs)
+	})
+
+	t.Run("doesn't index overridden record", func(t *testing.T) {
+		t.Parallel()
+
+		dbStorage := object.NewRecordDB(newDB())
+
+		id := gen.ID()
+		rec := record.MaterialRecord{Record: &object.ResultRecord{Object: gen.ID()}, JetID: gen.JetID()}
+		require.NoError(t, dbStorage.Set(ctx, id, rec))
+		other := record.MaterialRecord{Record: &object.ResultRecord{Object: gen.ID()}, JetID: rec.JetID}
+		assert.Equal(t, object.ErrOverride, dbStorage.Set(ctx, id, other))
+
+		ids, err := dbStorage.ForDrop(ctx, rec.JetID, id.Pulse())
+		require.NoError(t, err)
+		assert.Equal(t, []insolar.ID{id}, ids)
+	})
 }
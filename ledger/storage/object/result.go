@@ -48,6 +48,8 @@ type State interface {
 	GetMemory() *insolar.ID
 	// PrevStateID returns previous state id.
 	PrevStateID() *insolar.ID
+	// GetRequest returns reference to request that produced the state.
+	GetRequest() *insolar.Reference
 }
 
 // ResultRecord represents result of a VM method.
@@ -55,6 +57,8 @@ type ResultRecord struct {
 	Object  insolar.ID
 	Request insolar.Reference
 	Payload []byte
+	// Events are emitted by the method, the field is omitted in encoded record if there are no events.
+	Events []insolar.Event `codec:",omitempty"`
 }
 
 // WriteHashData writes record data to provided writer. This data is used to calculate record's hash.
@@ -68,6 +72,11 @@ type SideEffectRecord struct {
 	Request insolar.Reference
 }
 
+// GetRequest returns reference to request that produced the record.
+func (r *SideEffectRecord) GetRequest() *insolar.Reference {
+	return &r.Request
+}
+
 // TypeRecord is a code interface declaration.
 type TypeRecord struct {
 	SideEffectRecord
@@ -0,0 +1,266 @@
+package object
+
+/*
+DO NOT EDIT!
+This code was generated automatically using github.com/gojuno/minimock v1.9
+The original interface "ResultAccessor" can be found in github.com/insolar/insolar/ledger/storage/object
+*/
+import (
+	context "context"
+	"sync/atomic"
+	"time"
+
+	"github.com/gojuno/minimock"
+	insolar "github.com/insolar/insolar/insolar"
+
+	testify_assert "github.com/stretchr/testify/assert"
+)
+
+//ResultAccessorMock implements github.com/insolar/insolar/ledger/storage/object.ResultAccessor
+type ResultAccessorMock struct {
+	t minimock.Tester
+
+	ForRequestFunc       func(p context.Context, p1 insolar.ID) (r insolar.ID, r1 error)
+	ForRequestCounter    uint64
+	ForRequestPreCounter uint64
+	ForRequestMock       mResultAccessorMockForRequest
+}
+
+//NewResultAccessorMock returns a mock for github.com/insolar/insolar/ledger/storage/object.ResultAccessor
+func NewResultAccessorMock(t minimock.Tester) *ResultAccessorMock {
+	m := &ResultAccessorMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ForRequestMock = mResultAccessorMockForRequest{mock: m}
+
+	return m
+}
+
+type mResultAccessorMockForRequest struct {
+	mock              *ResultAccessorMock
+	mainExpectation   *ResultAccessorMockForRequestExpectation
+	expectationSeries []*ResultAccessorMockForRequestExpectation
+}
+
+type ResultAccessorMockForRequestExpectation struct {
+	input  *ResultAccessorMockForRequestInput
+	result *ResultAccessorMockForRequestResult
+}
+
+type ResultAccessorMockForRequestInput struct {
+	p  context.Context
+	p1 insolar.ID
+}
+
+type ResultAccessorMockForRequestResult struct {
+	r  insolar.ID
+	r1 error
+}
+
+//Expect specifies that invocation of ResultAccessor.ForRequest is expected from 1 to Infinity times
+func (m *mResultAccessorMockForRequest) Expect(p context.Context, p1 insolar.ID) *mResultAccessorMockForRequest {
+	m.mock.ForRequestFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ResultAccessorMockForRequestExpectation{}
+	}
+	m.mainExpectation.input = &ResultAccessorMockForRequestInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of ResultAccessor.ForRequest
+func (m *mResultAccessorMockForRequest) Return(r insolar.ID, r1 error) *ResultAccessorMock {
+	m.mock.ForRequestFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ResultAccessorMockForRequestExpectation{}
+	}
+	m.mainExpectation.result = &ResultAccessorMockForRequestResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of ResultAccessor.ForRequest is expected once
+func (m *mResultAccessorMockForRequest) ExpectOnce(p context.Context, p1 insolar.ID) *ResultAccessorMockForRequestExpectation {
+	m.mock.ForRequestFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ResultAccessorMockForRequestExpectation{}
+	expectation.input = &ResultAccessorMockForRequestInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ResultAccessorMockForRequestExpectation) Return(r insolar.ID, r1 error) {
+	e.result = &ResultAccessorMockForRequestResult{r, r1}
+}
+
+//Set uses given function f as a mock of ResultAccessor.ForRequest method
+func (m *mResultAccessorMockForRequest) Set(f func(p context.Context, p1 insolar.ID) (r insolar.ID, r1 error)) *ResultAccessorMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.ForRequestFunc = f
+	return m.mock
+}
+
+//ForRequest implements github.com/insolar/insolar/ledger/storage/object.ResultAccessor interface
+func (m *ResultAccessorMock) ForRequest(p context.Context, p1 insolar.ID) (r insolar.ID, r1 error) {
+	counter := atomic.AddUint64(&m.ForRequestPreCounter, 1)
+	defer atomic.AddUint64(&m.ForRequestCounter, 1)
+
+	if len(m.ForRequestMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.ForRequestMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ResultAccessorMock.ForRequest. %v %v", p, p1)
+			return
+		}
+
+		input := m.ForRequestMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ResultAccessorMockForRequestInput{p, p1}, "ResultAccessor.ForRequest got unexpected parameters")
+
+		result := m.ForRequestMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ResultAccessorMock.ForRequest")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.ForRequestMock.mainExpectation != nil {
+
+		input := m.ForRequestMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ResultAccessorMockForRequestInput{p, p1}, "ResultAccessor.ForRequest got unexpected parameters")
+		}
+
+		result := m.ForRequestMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ResultAccessorMock.ForRequest")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.ForRequestFunc == nil {
+		m.t.Fatalf("Unexpected call to ResultAccessorMock.ForRequest. %v %v", p, p1)
+		return
+	}
+
+	return m.ForRequestFunc(p, p1)
+}
+
+//ForRequestMinimockCounter returns a count of ResultAccessorMock.ForRequestFunc invocations
+func (m *ResultAccessorMock) ForRequestMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.ForRequestCounter)
+}
+
+//ForRequestMinimockPreCounter returns the value of ResultAccessorMock.ForRequest invocations
+func (m *ResultAccessorMock) ForRequestMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.ForRequestPreCounter)
+}
+
+//ForRequestFinished returns true if mock invocations count is ok
+func (m *ResultAccessorMock) ForRequestFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.ForRequestMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.ForRequestCounter) == uint64(len(m.ForRequestMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.ForRequestMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.ForRequestCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.ForRequestFunc != nil {
+		return atomic.LoadUint64(&m.ForRequestCounter) > 0
+	}
+
+	return true
+}
+
+//ValidateCallCounters checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *ResultAccessorMock) ValidateCallCounters() {
+
+	if !m.ForRequestFinished() {
+		m.t.Fatal("Expected call to ResultAccessorMock.ForRequest")
+	}
+
+}
+
+//CheckMocksCalled checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *ResultAccessorMock) CheckMocksCalled() {
+	m.Finish()
+}
+
+//Finish checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish or use Finish method of minimock.Controller
+func (m *ResultAccessorMock) Finish() {
+	m.MinimockFinish()
+}
+
+//MinimockFinish checks that all mocked methods of the interface have been called at least once
+func (m *ResultAccessorMock) MinimockFinish() {
+
+	if !m.ForRequestFinished() {
+		m.t.Fatal("Expected call to ResultAccessorMock.ForRequest")
+	}
+
+}
+
+//Wait waits for all mocked methods to be called at least once
+//Deprecated: please use MinimockWait or use Wait method of minimock.Controller
+func (m *ResultAccessorMock) Wait(timeout time.Duration) {
+	m.MinimockWait(timeout)
+}
+
+//MinimockWait waits for all mocked methods to be called at least once
+//this method is called by minimock.Controller
+func (m *ResultAccessorMock) MinimockWait(timeout time.Duration) {
+	timeoutCh := time.After(timeout)
+	for {
+		ok := true
+		ok = ok && m.ForRequestFinished()
+
+		if ok {
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+
+			if !m.ForRequestFinished() {
+				m.t.Error("Expected call to ResultAccessorMock.ForRequest")
+			}
+
+			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+//AllMocksCalled returns true if all mocked methods were called before the execution of AllMocksCalled,
+//it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
+func (m *ResultAccessorMock) AllMocksCalled() bool {
+
+	if !m.ForRequestFinished() {
+		return false
+	}
+
+	return true
+}
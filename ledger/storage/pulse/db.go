@@ -29,6 +29,8 @@ import (
 
 // StorageDB is a DB storage implementation. It saves pulses to disk and does not allow removal.
 type StorageDB struct {
+	notifier
+
 	db   store.DB
 	lock sync.RWMutex
 }
@@ -100,6 +102,15 @@ func (s *StorageDB) Latest(ctx context.Context) (pulse insolar.Pulse, err error)
 // Append appends provided pulse to current storage. Pulse number should be greater than currently saved for preserving
 // pulse consistency. If a provided pulse does not meet the requirements, ErrBadPulse will be returned.
 func (s *StorageDB) Append(ctx context.Context, pulse insolar.Pulse) error {
+	err := s.appendPulse(pulse)
+	if err != nil {
+		return err
+	}
+	s.notify(pulse)
+	return nil
+}
+
+func (s *StorageDB) appendPulse(pulse insolar.Pulse) error {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
@@ -26,6 +26,8 @@ import (
 
 // StorageMem is a memory storage implementation. It saves pulses to memory and allows removal.
 type StorageMem struct {
+	notifier
+
 	lock    sync.RWMutex
 	storage map[insolar.PulseNumber]*memNode
 	head    *memNode
@@ -74,6 +76,15 @@ func (s *StorageMem) Latest(ctx context.Context) (pulse insolar.Pulse, err error
 // Append appends provided a pulse to current storage. Pulse number should be greater than currently saved for preserving
 // pulse consistency. If provided Pulse does not meet the requirements, ErrBadPulse will be returned.
 func (s *StorageMem) Append(ctx context.Context, pulse insolar.Pulse) error {
+	err := s.appendPulse(pulse)
+	if err != nil {
+		return err
+	}
+	s.notify(pulse)
+	return nil
+}
+
+func (s *StorageMem) appendPulse(pulse insolar.Pulse) error {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
@@ -163,6 +163,22 @@ func TestNodeStorage_Append(t *testing.T) {
 		}
 
 	})
+
+	t.Run("notifies subscribers", func(t *testing.T) {
+		storage := NewStorageMem()
+		var notified []insolar.PulseNumber
+		unsubscribe := storage.Subscribe(func(p insolar.Pulse) {
+			notified = append(notified, p.PulseNumber)
+		})
+
+		require.NoError(t, storage.Append(ctx, insolar.Pulse{PulseNumber: 1}))
+		assert.Equal(t, ErrBadPulse, storage.Append(ctx, insolar.Pulse{PulseNumber: 1}))
+		require.NoError(t, storage.Append(ctx, insolar.Pulse{PulseNumber: 2}))
+		unsubscribe()
+		require.NoError(t, storage.Append(ctx, insolar.Pulse{PulseNumber: 3}))
+
+		assert.Equal(t, []insolar.PulseNumber{1, 2}, notified)
+	})
 }
 
 func TestMemoryStorage_Shift(t *testing.T) {
@@ -0,0 +1,59 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package pulse
+
+import (
+	"sync"
+
+	"github.com/insolar/insolar/insolar"
+)
+
+// notifier calls subscribed handlers on appended pulses. Zero value is ready to use.
+type notifier struct {
+	handlersLock sync.Mutex
+	handlers     map[int]func(insolar.Pulse)
+	nextHandler  int
+}
+
+// Subscribe implements Notifier.
+func (n *notifier) Subscribe(handler func(insolar.Pulse)) func() {
+	n.handlersLock.Lock()
+	defer n.handlersLock.Unlock()
+
+	if n.handlers == nil {
+		n.handlers = map[int]func(insolar.Pulse){}
+	}
+	id := n.nextHandler
+	n.nextHandler++
+	n.handlers[id] = handler
+
+	return func() {
+		n.handlersLock.Lock()
+		defer n.handlersLock.Unlock()
+
+		delete(n.handlers, id)
+	}
+}
+
+func (n *notifier) notify(pulse insolar.Pulse) {
+	n.handlersLock.Lock()
+	defer n.handlersLock.Unlock()
+
+	for _, handler := range n.handlers {
+		handler(pulse)
+	}
+}
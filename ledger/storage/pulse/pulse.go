@@ -44,6 +44,13 @@ type Appender interface {
 	Append(ctx context.Context, pulse insolar.Pulse) error
 }
 
+// Notifier notifies about pulses appended to storage.
+type Notifier interface {
+	// Subscribe registers handler which is called after every appended pulse. Handler should not block.
+	// Returned function cancels the subscription.
+	Subscribe(handler func(insolar.Pulse)) (unsubscribe func())
+}
+
 //go:generate minimock -i github.com/insolar/insolar/ledger/storage/pulse.Calculator -o ./ -s _mock.go
 
 // Calculator performs calculations for pulses.
@@ -21,8 +21,8 @@ import (
 	"context"
 	"errors"
 
-	"github.com/dgraph-io/badger"
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/internal/ledger/store"
 )
 
 // iterstate stores iterator state
@@ -32,7 +32,7 @@ type iterstate struct {
 	end    []byte
 }
 
-// ReplicaIter provides partial iterator over BadgerDB key/value pairs
+// ReplicaIter provides partial iterator over storage key/value pairs
 // required for replication to Heavy Material node in provided pulses range.
 //
 // "Required KV pairs" are all keys with record's namespaces
@@ -94,7 +94,7 @@ func (r *ReplicaIter) NextRecords() ([]insolar.KV, error) {
 		return nil, ErrReplicatorDone
 	}
 	fc := &fetchchunk{
-		db:    r.dbContext.GetBadgerDB(),
+		db:    r.dbContext.GetBackend(),
 		limit: r.limitBytes,
 	}
 	for _, is := range r.istates {
@@ -132,7 +132,7 @@ func (r *ReplicaIter) isDone() bool {
 }
 
 type fetchchunk struct {
-	db      *badger.DB
+	db      store.Backend
 	records []insolar.KV
 	size    int
 	limit   int
@@ -150,42 +150,29 @@ func (fc *fetchchunk) fetch(
 
 	var nextstart []byte
 	var lastpulse insolar.PulseNumber
-	err := fc.db.View(func(txn *badger.Txn) error {
-		it := txn.NewIterator(badger.DefaultIteratorOptions)
-		defer it.Close()
-
-		for it.Seek(start); it.ValidForPrefix(prefix); it.Next() {
-			item := it.Item()
-			if item == nil {
-				break
-			}
+	err := fc.db.View(func(txn store.Txn) error {
+		nextstart = nil
+		return txn.Iterate(prefix, start, func(key, value []byte) (bool, error) {
 			// key prefix < end
-			if bytes.Compare(item.Key()[:len(end)], end) != -1 {
-				break
+			if bytes.Compare(key[:len(end)], end) != -1 {
+				return false, nil
 			}
 
-			key := item.KeyCopy(nil)
 			if fc.size > fc.limit {
 				nextstart = key
 				// inslogger.FromContext(ctx).Warnf("size > r.limit: %v > %v (nextstart=%v)",
 				// 	fc.size, fc.limit, hex.EncodeToString(key))
-				return nil
+				return false, nil
 			}
 
 			lastpulse = pulseFromKey(key)
 			// fmt.Printf("Replica> key: %v (pulse=%v)\n", hex.EncodeToString(key), lastpulse)
 
-			value, err := it.Item().ValueCopy(nil)
-			if err != nil {
-				return err
-			}
-
 			NullifyJetInKey(key)
 			fc.records = append(fc.records, insolar.KV{K: key, V: value})
 			fc.size += len(key) + len(value)
-		}
-		nextstart = nil
-		return nil
+			return true, nil
+		})
 	})
 	return nextstart, lastpulse, err
 }
@@ -23,7 +23,6 @@ import (
 	"sort"
 	"testing"
 
-	"github.com/dgraph-io/badger"
 	"github.com/insolar/insolar/insolar/record"
 	"github.com/insolar/insolar/internal/ledger/store"
 	"github.com/insolar/insolar/ledger/storage/blob"
@@ -199,7 +198,7 @@ func Test_StoreKeyValues(t *testing.T) {
 				allKVs = append(allKVs, recs...)
 			}
 		}
-		expectedrecs, expectedidxs = getallkeys(tmpDB.GetBadgerDB())
+		expectedrecs, expectedidxs = getallkeys(tmpDB.GetBackend())
 		nullifyJetInKeys(expectedrecs)
 		nullifyJetInKeys(expectedidxs)
 		sortkeys(expectedrecs)
@@ -215,7 +214,7 @@ func Test_StoreKeyValues(t *testing.T) {
 		defer cleaner()
 		err := db.StoreKeyValues(ctx, allKVs)
 		require.NoError(t, err)
-		gotrecs, gotidxs = getallkeys(db.GetBadgerDB())
+		gotrecs, gotidxs = getallkeys(db.GetBackend())
 	}()
 
 	assert.Equal(t, len(expectedrecs), len(gotrecs), "records counts are the same after restore")
@@ -264,7 +263,7 @@ func (s *replicaIterSuite) Test_ReplicaIter_FirstPulse() {
 	}
 
 	got = sortkeys(got)
-	all, idxs := getallkeys(s.db.GetBadgerDB())
+	all, idxs := getallkeys(s.db.GetBackend())
 	all = append(all, idxs...)
 	all = sortkeys(all)
 
@@ -308,7 +307,7 @@ func Test_ReplicaIter_Base(t *testing.T) {
 	// it's easy to test simple case with zero Jet
 	jetID := insolar.ID(*insolar.NewJetID(0, nil))
 
-	recsBefore, idxBefore := getallkeys(tmpDB.GetBadgerDB())
+	recsBefore, idxBefore := getallkeys(tmpDB.GetBackend())
 	require.Nil(t, recsBefore)
 	require.Nil(t, idxBefore)
 
@@ -334,17 +333,17 @@ func Test_ReplicaIter_Base(t *testing.T) {
 
 		addRecords(ctx, t, os, bs, jetID, lastPulse, id)
 
-		recs, _ := getallkeys(tmpDB.GetBadgerDB())
+		recs, _ := getallkeys(tmpDB.GetBackend())
 		recKeys := getdelta(recsBefore, recs)
 		recsBefore = recs
 
-		_, idxAll := getallkeys(tmpDB.GetBadgerDB())
+		_, idxAll := getallkeys(tmpDB.GetBackend())
 
 		recsPerPulse[i] = recKeys
 		ttPerPulse[i] = append(ttPerPulse[i], recKeys...)
 		ttPerPulse[i] = append(ttPerPulse[i], idxAll...)
 	}
-	_, idxsAfter := getallkeys(tmpDB.GetBadgerDB())
+	_, idxsAfter := getallkeys(tmpDB.GetBackend())
 
 	for i := 0; i < pulsescount; i++ {
 		// in range should be all record from the next pulses
@@ -479,31 +478,27 @@ var (
 	scopeIDBlob     = byte(7)
 )
 
-func getallkeys(db *badger.DB) (records []key, indexes []key) {
-	txn := db.NewTransaction(true)
-	defer txn.Discard()
-
-	it := txn.NewIterator(badger.DefaultIteratorOptions)
-	defer it.Close()
-	for it.Rewind(); it.Valid(); it.Next() {
-		item := it.Item()
-		k := item.KeyCopy(nil)
-		pn := storage.Key(k).PulseNumber()
-		if pn == 0 {
-			continue
-		}
+func getallkeys(db store.Backend) (records []key, indexes []key) {
+	_ = db.View(func(txn store.Txn) error {
+		return txn.Iterate(nil, nil, func(k, _ []byte) (bool, error) {
+			pn := storage.Key(k).PulseNumber()
+			if pn == 0 {
+				return true, nil
+			}
 
-		switch k[0] {
-		case scopeIDRecord:
-			records = append(records, k)
-		case scopeIDBlob:
-			records = append(records, k)
-		case scopeIDJetDrop:
-			records = append(records, k)
-		case scopeIDLifeline:
-			indexes = append(indexes, k)
-		}
-	}
+			switch k[0] {
+			case scopeIDRecord:
+				records = append(records, k)
+			case scopeIDBlob:
+				records = append(records, k)
+			case scopeIDJetDrop:
+				records = append(records, k)
+			case scopeIDLifeline:
+				indexes = append(indexes, k)
+			}
+			return true, nil
+		})
+	})
 	return
 }
 
@@ -22,8 +22,8 @@ import (
 	"encoding/gob"
 	"io"
 
-	"github.com/dgraph-io/badger"
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/internal/ledger/store"
 	"github.com/pkg/errors"
 )
 
@@ -104,31 +104,19 @@ func (rs *replicaStorage) SetSyncClientJetPulses(ctx context.Context, jetID inso
 // GetAllSyncClientJets returns map of all jet's processed by node.
 func (rs *replicaStorage) GetAllSyncClientJets(ctx context.Context) (map[insolar.ID][]insolar.PulseNumber, error) {
 	jets := map[insolar.ID][]insolar.PulseNumber{}
-	err := rs.DB.GetBadgerDB().View(func(txn *badger.Txn) error {
-		it := txn.NewIterator(badger.DefaultIteratorOptions)
-		defer it.Close()
-
-		for it.Seek(sysHeavyClientStatePrefix); it.ValidForPrefix(sysHeavyClientStatePrefix); it.Next() {
-			item := it.Item()
-			if item == nil {
-				break
-			}
-			key := item.Key()
-			value, err := it.Item().Value()
-			if err != nil {
-				return err
-			}
+	err := rs.DB.GetBackend().View(func(txn store.Txn) error {
+		return txn.Iterate(sysHeavyClientStatePrefix, nil, func(key, value []byte) (bool, error) {
 			syncPulses, err := decodePulsesList(bytes.NewReader(value))
 			if err != nil {
-				return err
+				return false, err
 			}
 
 			var jetID insolar.ID
 			offset := len(sysHeavyClientStatePrefix)
 			copy(jetID[:], key[offset:offset+len(jetID)])
 			jets[jetID] = syncPulses
-		}
-		return nil
+			return true, nil
+		})
 	})
 	if err != nil {
 		return nil, err
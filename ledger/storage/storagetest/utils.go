@@ -39,6 +39,7 @@ import (
 
 type tmpDBOptions struct {
 	dir          string
+	engine       string
 	nobootstrap  bool
 	pulseStorage *pulse.StorageMem
 }
@@ -53,6 +54,13 @@ func Dir(dir string) Option {
 	}
 }
 
+// Engine defines storage engine for database.
+func Engine(engine string) Option {
+	return func(opts *tmpDBOptions) {
+		opts.engine = engine
+	}
+}
+
 // PulseStorage provides an external pulse storage for TmpDB
 func PulseStorage(ps *pulse.StorageMem) Option {
 	return func(opts *tmpDBOptions) {
@@ -80,9 +88,10 @@ func TmpDB(ctx context.Context, t testing.TB, options ...Option) (storage.DBCont
 
 	tmpDB, err := storage.NewDB(configuration.Ledger{
 		Storage: configuration.Storage{
+			Engine:        opts.engine,
 			DataDirectory: tmpdir,
 		},
-	}, nil)
+	})
 	require.NoError(t, err)
 
 	cm := &component.Manager{}
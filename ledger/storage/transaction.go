@@ -19,8 +19,8 @@ package storage
 import (
 	"context"
 
-	"github.com/dgraph-io/badger"
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/internal/ledger/store"
 )
 
 type keyval struct {
@@ -41,19 +41,15 @@ func (m *TransactionManager) Commit() error {
 	if len(m.txupdates) == 0 {
 		return nil
 	}
-	var err error
-	tx := m.db.db.NewTransaction(m.update)
-	defer tx.Discard()
-	for _, rec := range m.txupdates {
-		err = tx.Set(rec.k, rec.v)
-		if err != nil {
-			break
+	return m.db.db.Update(func(txn store.Txn) error {
+		for _, rec := range m.txupdates {
+			err := txn.Set(rec.k, rec.v)
+			if err != nil {
+				return err
+			}
 		}
-	}
-	if err != nil {
-		return err
-	}
-	return tx.Commit(nil)
+		return nil
+	})
 }
 
 // Discard terminates transaction without disk writes.
@@ -76,29 +72,26 @@ func (m *TransactionManager) get(ctx context.Context, key []byte) ([]byte, error
 		return kv.v, nil
 	}
 
-	txn := m.db.db.NewTransaction(false)
-	defer txn.Discard()
-	item, err := txn.Get(key)
+	var value []byte
+	err := m.db.db.View(func(txn store.Txn) error {
+		var err error
+		value, err = txn.Get(key)
+		return err
+	})
 	if err != nil {
-		if err == badger.ErrKeyNotFound {
+		if err == store.ErrNotFound {
 			return nil, insolar.ErrNotFound
 		}
 		return nil, err
 	}
-	return item.ValueCopy(nil)
+	return value, nil
 }
 
 // removes value by key
 func (m *TransactionManager) remove(ctx context.Context, key []byte) error {
 	debugf(ctx, "get key %v", bytes2hex(key))
 
-	txn := m.db.db.NewTransaction(true)
-	defer txn.Discard()
-
-	err := txn.Delete(key)
-	if err != nil {
-		return err
-	}
-
-	return txn.Commit(nil)
+	return m.db.db.Update(func(txn store.Txn) error {
+		return txn.Delete(key)
+	})
 }
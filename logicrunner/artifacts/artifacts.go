@@ -20,6 +20,8 @@ import (
 	"context"
 
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/ledger/storage/drop"
+	"github.com/insolar/insolar/network/merkle"
 )
 
 //go:generate minimock -i github.com/insolar/insolar/logicrunner/artifacts.Client -o ./ -s _mock.go
@@ -42,6 +44,11 @@ type Client interface {
 	// RegisterResult saves VM method call result.
 	RegisterResult(ctx context.Context, object, request insolar.Reference, payload []byte) (*insolar.ID, error)
 
+	// RegisterResultWithEvents saves VM method call result and events emitted by the call.
+	RegisterResultWithEvents(
+		ctx context.Context, object, request insolar.Reference, payload []byte, events []insolar.Event,
+	) (*insolar.ID, error)
+
 	// GetCode returns code from code record by provided reference according to provided machine preference.
 	//
 	// This method is used by VM to fetch code for execution.
@@ -59,6 +66,23 @@ type Client interface {
 	// HasPendingRequests returns true if object has unclosed requests.
 	HasPendingRequests(ctx context.Context, object insolar.Reference) (bool, error)
 
+	// GetRequestResult returns result id and payload for provided request.
+	//
+	// Result id is nil while request is pending. If request is unknown, insolar.ErrNotFound is returned.
+	GetRequestResult(ctx context.Context, request insolar.Reference) (*insolar.ID, []byte, error)
+
+	// GetEvents returns events emitted by provided object in pulses from..to (both included) in order of emission.
+	//
+	// Events of all names are returned if name is empty.
+	GetEvents(
+		ctx context.Context, object insolar.Reference, name string, from, to insolar.PulseNumber,
+	) ([]insolar.EmittedEvent, error)
+
+	// GetRecordProof returns jet drop containing provided record and a proof of the record inclusion into the drop.
+	//
+	// If record is unknown or its drop is not created yet, insolar.ErrNotFound is returned.
+	GetRecordProof(ctx context.Context, id insolar.ID) (*drop.Drop, *merkle.InclusionProof, error)
+
 	// GetDelegate returns provided object's delegate reference for provided type.
 	//
 	// Object delegate should be previously created for this object. If object delegate does not exist, an error will
@@ -158,6 +182,12 @@ type ObjectDescriptor interface {
 	// StateID returns reference to object state record.
 	StateID() *insolar.ID
 
+	// PrevStateID returns reference to previous object state record. Nil for the first state.
+	PrevStateID() *insolar.ID
+
+	// Request returns reference to request that produced the state.
+	Request() *insolar.Reference
+
 	// Memory fetches object memory from storage.
 	Memory() []byte
 
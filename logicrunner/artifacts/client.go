@@ -32,7 +32,9 @@ import (
 	"github.com/insolar/insolar/insolar/message"
 	"github.com/insolar/insolar/insolar/reply"
 	"github.com/insolar/insolar/instrumentation/instracer"
+	"github.com/insolar/insolar/ledger/storage/drop"
 	"github.com/insolar/insolar/ledger/storage/object"
+	"github.com/insolar/insolar/network/merkle"
 )
 
 const (
@@ -229,6 +231,8 @@ func (m *client) GetObject(
 			childPointer: r.ChildPointer,
 			memory:       r.Memory,
 			parent:       r.Parent,
+			prevState:    r.PrevState,
+			request:      r.Request,
 		}
 		return desc, err
 	case *reply.Error:
@@ -352,6 +356,205 @@ func (m *client) HasPendingRequests(
 	}
 }
 
+// GetRequestResult returns result id and payload for provided request. Result id is nil while request is pending.
+//
+// Light node is asked first. If request is unknown to it, the node responsible for request pulse is asked.
+func (m *client) GetRequestResult(
+	ctx context.Context, request insolar.Reference,
+) (*insolar.ID, []byte, error) {
+	var err error
+	ctx, span := instracer.StartSpan(ctx, "artifactmanager.GetRequestResult")
+	instrumenter := instrument(ctx, "GetRequestResult").err(&err)
+	defer func() {
+		if err != nil {
+			span.AddAttributes(trace.StringAttribute("error", err.Error()))
+		}
+		span.End()
+		instrumenter.end()
+	}()
+
+	currentPN, err := m.pulse(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bus := insolar.MessageBusFromContext(ctx, m.DefaultBus)
+	sender := BuildSender(
+		bus.Send,
+		retryJetSender(currentPN, m.JetStorage),
+	)
+	msg := &message.GetRequestResult{Request: *request.Record()}
+
+	genericReply, err := sender(ctx, msg, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if rep, ok := genericReply.(*reply.Error); ok && rep.ErrType == reply.ErrRequestNotFound {
+		var node *insolar.Reference
+		node, err = m.JetCoordinator.NodeForObject(ctx, *request.Record(), currentPN, request.Record().Pulse())
+		if err != nil {
+			return nil, nil, err
+		}
+		genericReply, err = sender(ctx, msg, &insolar.MessageSendOptions{Receiver: node})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	switch rep := genericReply.(type) {
+	case *reply.RequestResult:
+		return rep.Result, rep.Payload, nil
+	case *reply.Error:
+		err = rep.Error()
+		return nil, nil, err
+	default:
+		err = fmt.Errorf("GetRequestResult: unexpected reply: %#v", rep)
+		return nil, nil, err
+	}
+}
+
+// GetEvents returns events emitted by provided object in pulses from..to (both included) in order of emission.
+//
+// Both light node and heavy node are asked, events which are already replicated to heavy node are deduplicated.
+func (m *client) GetEvents(
+	ctx context.Context, obj insolar.Reference, name string, from, to insolar.PulseNumber,
+) ([]insolar.EmittedEvent, error) {
+	var err error
+	ctx, span := instracer.StartSpan(ctx, "artifactmanager.GetEvents")
+	instrumenter := instrument(ctx, "GetEvents").err(&err)
+	defer func() {
+		if err != nil {
+			span.AddAttributes(trace.StringAttribute("error", err.Error()))
+		}
+		span.End()
+		instrumenter.end()
+	}()
+
+	currentPN, err := m.pulse(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	heavy, err := m.JetCoordinator.Heavy(ctx, currentPN)
+	if err != nil {
+		return nil, err
+	}
+
+	bus := insolar.MessageBusFromContext(ctx, m.DefaultBus)
+	sender := BuildSender(
+		bus.Send,
+		retryJetSender(currentPN, m.JetStorage),
+	)
+	msg := &message.GetEvents{Object: *obj.Record(), Name: name, From: from, To: to}
+
+	type position struct {
+		result insolar.ID
+		index  int
+	}
+	var events []insolar.EmittedEvent
+	seen := map[position]struct{}{}
+	// Heavy node keeps older events, so it's asked first to keep the order of emission.
+	for _, options := range []*insolar.MessageSendOptions{{Receiver: heavy}, nil} {
+		var genericReply insolar.Reply
+		genericReply, err = sender(ctx, msg, options)
+		if err != nil {
+			return nil, err
+		}
+
+		switch rep := genericReply.(type) {
+		case *reply.Events:
+			for _, e := range rep.Events {
+				pos := position{result: e.Result, index: e.Index}
+				if _, ok := seen[pos]; ok {
+					continue
+				}
+				seen[pos] = struct{}{}
+				events = append(events, e)
+			}
+		case *reply.Error:
+			err = rep.Error()
+			return nil, err
+		default:
+			err = fmt.Errorf("GetEvents: unexpected reply: %#v", rep)
+			return nil, err
+		}
+	}
+
+	return events, nil
+}
+
+// GetRecordProof returns jet drop containing provided record and a proof of the record inclusion into the drop.
+//
+// Heavy node is asked first, light node is asked for records which are not replicated yet. Returned proof is verified
+// against the drop before returning.
+func (m *client) GetRecordProof(
+	ctx context.Context, id insolar.ID,
+) (*drop.Drop, *merkle.InclusionProof, error) {
+	var err error
+	ctx, span := instracer.StartSpan(ctx, "artifactmanager.GetRecordProof")
+	instrumenter := instrument(ctx, "GetRecordProof").err(&err)
+	defer func() {
+		if err != nil {
+			span.AddAttributes(trace.StringAttribute("error", err.Error()))
+		}
+		span.End()
+		instrumenter.end()
+	}()
+
+	currentPN, err := m.pulse(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	heavy, err := m.JetCoordinator.Heavy(ctx, currentPN)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bus := insolar.MessageBusFromContext(ctx, m.DefaultBus)
+	sender := BuildSender(
+		bus.Send,
+		retryJetSender(currentPN, m.JetStorage),
+	)
+	msg := &message.GetRecordProof{Record: id}
+
+	for _, options := range []*insolar.MessageSendOptions{{Receiver: heavy}, nil} {
+		var genericReply insolar.Reply
+		genericReply, err = sender(ctx, msg, options)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		switch rep := genericReply.(type) {
+		case *reply.RecordProof:
+			var dr *drop.Drop
+			dr, err = drop.Decode(rep.Drop)
+			if err != nil {
+				err = errors.Wrap(err, "failed to decode drop")
+				return nil, nil, err
+			}
+			proof := merkle.InclusionProof{Index: rep.Index, Leaves: rep.Leaves, Siblings: rep.Siblings}
+			if !drop.VerifyRecord(m.PlatformCryptographyScheme, *dr, id, proof) {
+				err = errors.New("record proof doesn't match drop")
+				return nil, nil, err
+			}
+			return dr, &proof, nil
+		case *reply.Error:
+			if rep.ErrType == reply.ErrRecordNotFound {
+				continue
+			}
+			err = rep.Error()
+			return nil, nil, err
+		default:
+			err = fmt.Errorf("GetRecordProof: unexpected reply: %#v", rep)
+			return nil, nil, err
+		}
+	}
+
+	err = insolar.ErrNotFound
+	return nil, nil, err
+}
+
 // GetDelegate returns provided object's delegate reference for provided prototype.
 //
 // Object delegate should be previously created for this object. If object delegate does not exist, an error will
@@ -715,22 +918,82 @@ func (m *client) RegisterResult(
 		instrumenter.end()
 	}()
 
+	recid, err := m.registerResult(ctx, obj, request, payload, nil)
+	return recid, err
+}
+
+// RegisterResultWithEvents saves VM method call result and events emitted by the call.
+func (m *client) RegisterResultWithEvents(
+	ctx context.Context, obj, request insolar.Reference, payload []byte, events []insolar.Event,
+) (*insolar.ID, error) {
+	var err error
+	ctx, span := instracer.StartSpan(ctx, "artifactmanager.RegisterResultWithEvents")
+	instrumenter := instrument(ctx, "RegisterResultWithEvents").err(&err)
+	defer func() {
+		if err != nil {
+			span.AddAttributes(trace.StringAttribute("error", err.Error()))
+		}
+		span.End()
+		instrumenter.end()
+	}()
+
+	recid, err := m.registerResult(ctx, obj, request, payload, events)
+	return recid, err
+}
+
+func (m *client) registerResult(
+	ctx context.Context, obj, request insolar.Reference, payload []byte, events []insolar.Event,
+) (*insolar.ID, error) {
 	currentPN, err := m.pulse(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	recid, err := m.setRecord(
+	id, err := m.setRecord(
 		ctx,
 		&object.ResultRecord{
 			Object:  *obj.Record(),
 			Request: request,
 			Payload: payload,
+			Events:  events,
 		},
 		request,
 		currentPN,
 	)
-	return recid, err
+	if err != nil || len(events) == 0 {
+		return id, err
+	}
+
+	// result is saved in the jet of the request, events are indexed in the jet of the object to be found by it
+	err = m.setEvents(ctx, obj, *id, events, currentPN)
+	if err != nil {
+		return nil, err
+	}
+	return id, nil
+}
+
+func (m *client) setEvents(
+	ctx context.Context, obj insolar.Reference, result insolar.ID, events []insolar.Event, currentPN insolar.PulseNumber,
+) error {
+	bus := insolar.MessageBusFromContext(ctx, m.DefaultBus)
+	sender := BuildSender(bus.Send, retryJetSender(currentPN, m.JetStorage))
+	genericReply, err := sender(ctx, &message.SetEvents{
+		Object: obj,
+		Result: result,
+		Events: events,
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	switch rep := genericReply.(type) {
+	case *reply.OK:
+		return nil
+	case *reply.Error:
+		return rep.Error()
+	default:
+		return fmt.Errorf("setEvents: unexpected reply: %#v", rep)
+	}
 }
 
 // pulse returns current PulseNumber for artifact manager
@@ -820,6 +1083,8 @@ func (m *client) activateObject(
 		childPointer: o.ChildPointer,
 		memory:       memory,
 		parent:       o.Parent,
+		prevState:    o.PrevState,
+		request:      o.Request,
 	}, nil
 }
 
@@ -885,6 +1150,8 @@ func (m *client) updateObject(
 		childPointer: o.ChildPointer,
 		memory:       memory,
 		parent:       o.Parent,
+		prevState:    o.PrevState,
+		request:      o.Request,
 	}, nil
 }
 
@@ -12,6 +12,8 @@ import (
 
 	"github.com/gojuno/minimock"
 	insolar "github.com/insolar/insolar/insolar"
+	drop "github.com/insolar/insolar/ledger/storage/drop"
+	merkle "github.com/insolar/insolar/network/merkle"
 
 	testify_assert "github.com/stretchr/testify/assert"
 )
@@ -65,6 +67,11 @@ type ClientMock struct {
 	GetDelegatePreCounter uint64
 	GetDelegateMock       mClientMockGetDelegate
 
+	GetEventsFunc       func(p context.Context, p1 insolar.Reference, p2 string, p3 insolar.PulseNumber, p4 insolar.PulseNumber) (r []insolar.EmittedEvent, r1 error)
+	GetEventsCounter    uint64
+	GetEventsPreCounter uint64
+	GetEventsMock       mClientMockGetEvents
+
 	GetObjectFunc       func(p context.Context, p1 insolar.Reference, p2 *insolar.ID, p3 bool) (r ObjectDescriptor, r1 error)
 	GetObjectCounter    uint64
 	GetObjectPreCounter uint64
@@ -75,6 +82,16 @@ type ClientMock struct {
 	GetPendingRequestPreCounter uint64
 	GetPendingRequestMock       mClientMockGetPendingRequest
 
+	GetRecordProofFunc       func(p context.Context, p1 insolar.ID) (r *drop.Drop, r1 *merkle.InclusionProof, r2 error)
+	GetRecordProofCounter    uint64
+	GetRecordProofPreCounter uint64
+	GetRecordProofMock       mClientMockGetRecordProof
+
+	GetRequestResultFunc       func(p context.Context, p1 insolar.Reference) (r *insolar.ID, r1 []byte, r2 error)
+	GetRequestResultCounter    uint64
+	GetRequestResultPreCounter uint64
+	GetRequestResultMock       mClientMockGetRequestResult
+
 	HasPendingRequestsFunc       func(p context.Context, p1 insolar.Reference) (r bool, r1 error)
 	HasPendingRequestsCounter    uint64
 	HasPendingRequestsPreCounter uint64
@@ -90,6 +107,11 @@ type ClientMock struct {
 	RegisterResultPreCounter uint64
 	RegisterResultMock       mClientMockRegisterResult
 
+	RegisterResultWithEventsFunc       func(p context.Context, p1 insolar.Reference, p2 insolar.Reference, p3 []byte, p4 []insolar.Event) (r *insolar.ID, r1 error)
+	RegisterResultWithEventsCounter    uint64
+	RegisterResultWithEventsPreCounter uint64
+	RegisterResultWithEventsMock       mClientMockRegisterResultWithEvents
+
 	RegisterValidationFunc       func(p context.Context, p1 insolar.Reference, p2 insolar.ID, p3 bool, p4 []insolar.Message) (r error)
 	RegisterValidationCounter    uint64
 	RegisterValidationPreCounter uint64
@@ -128,11 +150,15 @@ func NewClientMock(t minimock.Tester) *ClientMock {
 	m.GetChildrenMock = mClientMockGetChildren{mock: m}
 	m.GetCodeMock = mClientMockGetCode{mock: m}
 	m.GetDelegateMock = mClientMockGetDelegate{mock: m}
+	m.GetEventsMock = mClientMockGetEvents{mock: m}
 	m.GetObjectMock = mClientMockGetObject{mock: m}
 	m.GetPendingRequestMock = mClientMockGetPendingRequest{mock: m}
+	m.GetRecordProofMock = mClientMockGetRecordProof{mock: m}
+	m.GetRequestResultMock = mClientMockGetRequestResult{mock: m}
 	m.HasPendingRequestsMock = mClientMockHasPendingRequests{mock: m}
 	m.RegisterRequestMock = mClientMockRegisterRequest{mock: m}
 	m.RegisterResultMock = mClientMockRegisterResult{mock: m}
+	m.RegisterResultWithEventsMock = mClientMockRegisterResultWithEvents{mock: m}
 	m.RegisterValidationMock = mClientMockRegisterValidation{mock: m}
 	m.StateMock = mClientMockState{mock: m}
 	m.UpdateObjectMock = mClientMockUpdateObject{mock: m}
@@ -1501,6 +1527,160 @@ func (m *ClientMock) GetDelegateFinished() bool {
 	return true
 }
 
+type mClientMockGetEvents struct {
+	mock              *ClientMock
+	mainExpectation   *ClientMockGetEventsExpectation
+	expectationSeries []*ClientMockGetEventsExpectation
+}
+
+type ClientMockGetEventsExpectation struct {
+	input  *ClientMockGetEventsInput
+	result *ClientMockGetEventsResult
+}
+
+type ClientMockGetEventsInput struct {
+	p  context.Context
+	p1 insolar.Reference
+	p2 string
+	p3 insolar.PulseNumber
+	p4 insolar.PulseNumber
+}
+
+type ClientMockGetEventsResult struct {
+	r  []insolar.EmittedEvent
+	r1 error
+}
+
+//Expect specifies that invocation of Client.GetEvents is expected from 1 to Infinity times
+func (m *mClientMockGetEvents) Expect(p context.Context, p1 insolar.Reference, p2 string, p3 insolar.PulseNumber, p4 insolar.PulseNumber) *mClientMockGetEvents {
+	m.mock.GetEventsFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ClientMockGetEventsExpectation{}
+	}
+	m.mainExpectation.input = &ClientMockGetEventsInput{p, p1, p2, p3, p4}
+	return m
+}
+
+//Return specifies results of invocation of Client.GetEvents
+func (m *mClientMockGetEvents) Return(r []insolar.EmittedEvent, r1 error) *ClientMock {
+	m.mock.GetEventsFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ClientMockGetEventsExpectation{}
+	}
+	m.mainExpectation.result = &ClientMockGetEventsResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Client.GetEvents is expected once
+func (m *mClientMockGetEvents) ExpectOnce(p context.Context, p1 insolar.Reference, p2 string, p3 insolar.PulseNumber, p4 insolar.PulseNumber) *ClientMockGetEventsExpectation {
+	m.mock.GetEventsFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ClientMockGetEventsExpectation{}
+	expectation.input = &ClientMockGetEventsInput{p, p1, p2, p3, p4}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ClientMockGetEventsExpectation) Return(r []insolar.EmittedEvent, r1 error) {
+	e.result = &ClientMockGetEventsResult{r, r1}
+}
+
+//Set uses given function f as a mock of Client.GetEvents method
+func (m *mClientMockGetEvents) Set(f func(p context.Context, p1 insolar.Reference, p2 string, p3 insolar.PulseNumber, p4 insolar.PulseNumber) (r []insolar.EmittedEvent, r1 error)) *ClientMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.GetEventsFunc = f
+	return m.mock
+}
+
+//GetEvents implements github.com/insolar/insolar/logicrunner/artifacts.Client interface
+func (m *ClientMock) GetEvents(p context.Context, p1 insolar.Reference, p2 string, p3 insolar.PulseNumber, p4 insolar.PulseNumber) (r []insolar.EmittedEvent, r1 error) {
+	counter := atomic.AddUint64(&m.GetEventsPreCounter, 1)
+	defer atomic.AddUint64(&m.GetEventsCounter, 1)
+
+	if len(m.GetEventsMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.GetEventsMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ClientMock.GetEvents. %v %v %v %v %v", p, p1, p2, p3, p4)
+			return
+		}
+
+		input := m.GetEventsMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ClientMockGetEventsInput{p, p1, p2, p3, p4}, "Client.GetEvents got unexpected parameters")
+
+		result := m.GetEventsMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ClientMock.GetEvents")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.GetEventsMock.mainExpectation != nil {
+
+		input := m.GetEventsMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ClientMockGetEventsInput{p, p1, p2, p3, p4}, "Client.GetEvents got unexpected parameters")
+		}
+
+		result := m.GetEventsMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ClientMock.GetEvents")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.GetEventsFunc == nil {
+		m.t.Fatalf("Unexpected call to ClientMock.GetEvents. %v %v %v %v %v", p, p1, p2, p3, p4)
+		return
+	}
+
+	return m.GetEventsFunc(p, p1, p2, p3, p4)
+}
+
+//GetEventsMinimockCounter returns a count of ClientMock.GetEventsFunc invocations
+func (m *ClientMock) GetEventsMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.GetEventsCounter)
+}
+
+//GetEventsMinimockPreCounter returns the value of ClientMock.GetEvents invocations
+func (m *ClientMock) GetEventsMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.GetEventsPreCounter)
+}
+
+//GetEventsFinished returns true if mock invocations count is ok
+func (m *ClientMock) GetEventsFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.GetEventsMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.GetEventsCounter) == uint64(len(m.GetEventsMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.GetEventsMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.GetEventsCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.GetEventsFunc != nil {
+		return atomic.LoadUint64(&m.GetEventsCounter) > 0
+	}
+
+	return true
+}
+
 type mClientMockGetObject struct {
 	mock              *ClientMock
 	mainExpectation   *ClientMockGetObjectExpectation
@@ -1805,6 +1985,314 @@ func (m *ClientMock) GetPendingRequestFinished() bool {
 	return true
 }
 
+type mClientMockGetRecordProof struct {
+	mock              *ClientMock
+	mainExpectation   *ClientMockGetRecordProofExpectation
+	expectationSeries []*ClientMockGetRecordProofExpectation
+}
+
+type ClientMockGetRecordProofExpectation struct {
+	input  *ClientMockGetRecordProofInput
+	result *ClientMockGetRecordProofResult
+}
+
+type ClientMockGetRecordProofInput struct {
+	p  context.Context
+	p1 insolar.ID
+}
+
+type ClientMockGetRecordProofResult struct {
+	r  *drop.Drop
+	r1 *merkle.InclusionProof
+	r2 error
+}
+
+//Expect specifies that invocation of Client.GetRecordProof is expected from 1 to Infinity times
+func (m *mClientMockGetRecordProof) Expect(p context.Context, p1 insolar.ID) *mClientMockGetRecordProof {
+	m.mock.GetRecordProofFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ClientMockGetRecordProofExpectation{}
+	}
+	m.mainExpectation.input = &ClientMockGetRecordProofInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of Client.GetRecordProof
+func (m *mClientMockGetRecordProof) Return(r *drop.Drop, r1 *merkle.InclusionProof, r2 error) *ClientMock {
+	m.mock.GetRecordProofFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ClientMockGetRecordProofExpectation{}
+	}
+	m.mainExpectation.result = &ClientMockGetRecordProofResult{r, r1, r2}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Client.GetRecordProof is expected once
+func (m *mClientMockGetRecordProof) ExpectOnce(p context.Context, p1 insolar.ID) *ClientMockGetRecordProofExpectation {
+	m.mock.GetRecordProofFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ClientMockGetRecordProofExpectation{}
+	expectation.input = &ClientMockGetRecordProofInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ClientMockGetRecordProofExpectation) Return(r *drop.Drop, r1 *merkle.InclusionProof, r2 error) {
+	e.result = &ClientMockGetRecordProofResult{r, r1, r2}
+}
+
+//Set uses given function f as a mock of Client.GetRecordProof method
+func (m *mClientMockGetRecordProof) Set(f func(p context.Context, p1 insolar.ID) (r *drop.Drop, r1 *merkle.InclusionProof, r2 error)) *ClientMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.GetRecordProofFunc = f
+	return m.mock
+}
+
+//GetRecordProof implements github.com/insolar/insolar/logicrunner/artifacts.Client interface
+func (m *ClientMock) GetRecordProof(p context.Context, p1 insolar.ID) (r *drop.Drop, r1 *merkle.InclusionProof, r2 error) {
+	counter := atomic.AddUint64(&m.GetRecordProofPreCounter, 1)
+	defer atomic.AddUint64(&m.GetRecordProofCounter, 1)
+
+	if len(m.GetRecordProofMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.GetRecordProofMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ClientMock.GetRecordProof. %v %v", p, p1)
+			return
+		}
+
+		input := m.GetRecordProofMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ClientMockGetRecordProofInput{p, p1}, "Client.GetRecordProof got unexpected parameters")
+
+		result := m.GetRecordProofMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ClientMock.GetRecordProof")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+		r2 = result.r2
+
+		return
+	}
+
+	if m.GetRecordProofMock.mainExpectation != nil {
+
+		input := m.GetRecordProofMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ClientMockGetRecordProofInput{p, p1}, "Client.GetRecordProof got unexpected parameters")
+		}
+
+		result := m.GetRecordProofMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ClientMock.GetRecordProof")
+		}
+
+		r = result.r
+		r1 = result.r1
+		r2 = result.r2
+
+		return
+	}
+
+	if m.GetRecordProofFunc == nil {
+		m.t.Fatalf("Unexpected call to ClientMock.GetRecordProof. %v %v", p, p1)
+		return
+	}
+
+	return m.GetRecordProofFunc(p, p1)
+}
+
+//GetRecordProofMinimockCounter returns a count of ClientMock.GetRecordProofFunc invocations
+func (m *ClientMock) GetRecordProofMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.GetRecordProofCounter)
+}
+
+//GetRecordProofMinimockPreCounter returns the value of ClientMock.GetRecordProof invocations
+func (m *ClientMock) GetRecordProofMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.GetRecordProofPreCounter)
+}
+
+//GetRecordProofFinished returns true if mock invocations count is ok
+func (m *ClientMock) GetRecordProofFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.GetRecordProofMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.GetRecordProofCounter) == uint64(len(m.GetRecordProofMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.GetRecordProofMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.GetRecordProofCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.GetRecordProofFunc != nil {
+		return atomic.LoadUint64(&m.GetRecordProofCounter) > 0
+	}
+
+	return true
+}
+
+type mClientMockGetRequestResult struct {
+	mock              *ClientMock
+	mainExpectation   *ClientMockGetRequestResultExpectation
+	expectationSeries []*ClientMockGetRequestResultExpectation
+}
+
+type ClientMockGetRequestResultExpectation struct {
+	input  *ClientMockGetRequestResultInput
+	result *ClientMockGetRequestResultResult
+}
+
+type ClientMockGetRequestResultInput struct {
+	p  context.Context
+	p1 insolar.Reference
+}
+
+type ClientMockGetRequestResultResult struct {
+	r  *insolar.ID
+	r1 []byte
+	r2 error
+}
+
+//Expect specifies that invocation of Client.GetRequestResult is expected from 1 to Infinity times
+func (m *mClientMockGetRequestResult) Expect(p context.Context, p1 insolar.Reference) *mClientMockGetRequestResult {
+	m.mock.GetRequestResultFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ClientMockGetRequestResultExpectation{}
+	}
+	m.mainExpectation.input = &ClientMockGetRequestResultInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of Client.GetRequestResult
+func (m *mClientMockGetRequestResult) Return(r *insolar.ID, r1 []byte, r2 error) *ClientMock {
+	m.mock.GetRequestResultFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ClientMockGetRequestResultExpectation{}
+	}
+	m.mainExpectation.result = &ClientMockGetRequestResultResult{r, r1, r2}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Client.GetRequestResult is expected once
+func (m *mClientMockGetRequestResult) ExpectOnce(p context.Context, p1 insolar.Reference) *ClientMockGetRequestResultExpectation {
+	m.mock.GetRequestResultFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ClientMockGetRequestResultExpectation{}
+	expectation.input = &ClientMockGetRequestResultInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ClientMockGetRequestResultExpectation) Return(r *insolar.ID, r1 []byte, r2 error) {
+	e.result = &ClientMockGetRequestResultResult{r, r1, r2}
+}
+
+//Set uses given function f as a mock of Client.GetRequestResult method
+func (m *mClientMockGetRequestResult) Set(f func(p context.Context, p1 insolar.Reference) (r *insolar.ID, r1 []byte, r2 error)) *ClientMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.GetRequestResultFunc = f
+	return m.mock
+}
+
+//GetRequestResult implements github.com/insolar/insolar/logicrunner/artifacts.Client interface
+func (m *ClientMock) GetRequestResult(p context.Context, p1 insolar.Reference) (r *insolar.ID, r1 []byte, r2 error) {
+	counter := atomic.AddUint64(&m.GetRequestResultPreCounter, 1)
+	defer atomic.AddUint64(&m.GetRequestResultCounter, 1)
+
+	if len(m.GetRequestResultMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.GetRequestResultMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ClientMock.GetRequestResult. %v %v", p, p1)
+			return
+		}
+
+		input := m.GetRequestResultMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ClientMockGetRequestResultInput{p, p1}, "Client.GetRequestResult got unexpected parameters")
+
+		result := m.GetRequestResultMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ClientMock.GetRequestResult")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+		r2 = result.r2
+
+		return
+	}
+
+	if m.GetRequestResultMock.mainExpectation != nil {
+
+		input := m.GetRequestResultMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ClientMockGetRequestResultInput{p, p1}, "Client.GetRequestResult got unexpected parameters")
+		}
+
+		result := m.GetRequestResultMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ClientMock.GetRequestResult")
+		}
+
+		r = result.r
+		r1 = result.r1
+		r2 = result.r2
+
+		return
+	}
+
+	if m.GetRequestResultFunc == nil {
+		m.t.Fatalf("Unexpected call to ClientMock.GetRequestResult. %v %v", p, p1)
+		return
+	}
+
+	return m.GetRequestResultFunc(p, p1)
+}
+
+//GetRequestResultMinimockCounter returns a count of ClientMock.GetRequestResultFunc invocations
+func (m *ClientMock) GetRequestResultMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.GetRequestResultCounter)
+}
+
+//GetRequestResultMinimockPreCounter returns the value of ClientMock.GetRequestResult invocations
+func (m *ClientMock) GetRequestResultMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.GetRequestResultPreCounter)
+}
+
+//GetRequestResultFinished returns true if mock invocations count is ok
+func (m *ClientMock) GetRequestResultFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.GetRequestResultMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.GetRequestResultCounter) == uint64(len(m.GetRequestResultMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.GetRequestResultMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.GetRequestResultCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.GetRequestResultFunc != nil {
+		return atomic.LoadUint64(&m.GetRequestResultCounter) > 0
+	}
+
+	return true
+}
+
 type mClientMockHasPendingRequests struct {
 	mock              *ClientMock
 	mainExpectation   *ClientMockHasPendingRequestsExpectation
@@ -2261,6 +2749,160 @@ func (m *ClientMock) RegisterResultFinished() bool {
 	return true
 }
 
+type mClientMockRegisterResultWithEvents struct {
+	mock              *ClientMock
+	mainExpectation   *ClientMockRegisterResultWithEventsExpectation
+	expectationSeries []*ClientMockRegisterResultWithEventsExpectation
+}
+
+type ClientMockRegisterResultWithEventsExpectation struct {
+	input  *ClientMockRegisterResultWithEventsInput
+	result *ClientMockRegisterResultWithEventsResult
+}
+
+type ClientMockRegisterResultWithEventsInput struct {
+	p  context.Context
+	p1 insolar.Reference
+	p2 insolar.Reference
+	p3 []byte
+	p4 []insolar.Event
+}
+
+type ClientMockRegisterResultWithEventsResult struct {
+	r  *insolar.ID
+	r1 error
+}
+
+//Expect specifies that invocation of Client.RegisterResultWithEvents is expected from 1 to Infinity times
+func (m *mClientMockRegisterResultWithEvents) Expect(p context.Context, p1 insolar.Reference, p2 insolar.Reference, p3 []byte, p4 []insolar.Event) *mClientMockRegisterResultWithEvents {
+	m.mock.RegisterResultWithEventsFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ClientMockRegisterResultWithEventsExpectation{}
+	}
+	m.mainExpectation.input = &ClientMockRegisterResultWithEventsInput{p, p1, p2, p3, p4}
+	return m
+}
+
+//Return specifies results of invocation of Client.RegisterResultWithEvents
+func (m *mClientMockRegisterResultWithEvents) Return(r *insolar.ID, r1 error) *ClientMock {
+	m.mock.RegisterResultWithEventsFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ClientMockRegisterResultWithEventsExpectation{}
+	}
+	m.mainExpectation.result = &ClientMockRegisterResultWithEventsResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Client.RegisterResultWithEvents is expected once
+func (m *mClientMockRegisterResultWithEvents) ExpectOnce(p context.Context, p1 insolar.Reference, p2 insolar.Reference, p3 []byte, p4 []insolar.Event) *ClientMockRegisterResultWithEventsExpectation {
+	m.mock.RegisterResultWithEventsFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ClientMockRegisterResultWithEventsExpectation{}
+	expectation.input = &ClientMockRegisterResultWithEventsInput{p, p1, p2, p3, p4}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ClientMockRegisterResultWithEventsExpectation) Return(r *insolar.ID, r1 error) {
+	e.result = &ClientMockRegisterResultWithEventsResult{r, r1}
+}
+
+//Set uses given function f as a mock of Client.RegisterResultWithEvents method
+func (m *mClientMockRegisterResultWithEvents) Set(f func(p context.Context, p1 insolar.Reference, p2 insolar.Reference, p3 []byte, p4 []insolar.Event) (r *insolar.ID, r1 error)) *ClientMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.RegisterResultWithEventsFunc = f
+	return m.mock
+}
+
+//RegisterResultWithEvents implements github.com/insolar/insolar/logicrunner/artifacts.Client interface
+func (m *ClientMock) RegisterResultWithEvents(p context.Context, p1 insolar.Reference, p2 insolar.Reference, p3 []byte, p4 []insolar.Event) (r *insolar.ID, r1 error) {
+	counter := atomic.AddUint64(&m.RegisterResultWithEventsPreCounter, 1)
+	defer atomic.AddUint64(&m.RegisterResultWithEventsCounter, 1)
+
+	if len(m.RegisterResultWithEventsMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.RegisterResultWithEventsMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ClientMock.RegisterResultWithEvents. %v %v %v %v %v", p, p1, p2, p3, p4)
+			return
+		}
+
+		input := m.RegisterResultWithEventsMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ClientMockRegisterResultWithEventsInput{p, p1, p2, p3, p4}, "Client.RegisterResultWithEvents got unexpected parameters")
+
+		result := m.RegisterResultWithEventsMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ClientMock.RegisterResultWithEvents")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.RegisterResultWithEventsMock.mainExpectation != nil {
+
+		input := m.RegisterResultWithEventsMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ClientMockRegisterResultWithEventsInput{p, p1, p2, p3, p4}, "Client.RegisterResultWithEvents got unexpected parameters")
+		}
+
+		result := m.RegisterResultWithEventsMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ClientMock.RegisterResultWithEvents")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.RegisterResultWithEventsFunc == nil {
+		m.t.Fatalf("Unexpected call to ClientMock.RegisterResultWithEvents. %v %v %v %v %v", p, p1, p2, p3, p4)
+		return
+	}
+
+	return m.RegisterResultWithEventsFunc(p, p1, p2, p3, p4)
+}
+
+//RegisterResultWithEventsMinimockCounter returns a count of ClientMock.RegisterResultWithEventsFunc invocations
+func (m *ClientMock) RegisterResultWithEventsMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.RegisterResultWithEventsCounter)
+}
+
+//RegisterResultWithEventsMinimockPreCounter returns the value of ClientMock.RegisterResultWithEvents invocations
+func (m *ClientMock) RegisterResultWithEventsMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.RegisterResultWithEventsPreCounter)
+}
+
+//RegisterResultWithEventsFinished returns true if mock invocations count is ok
+func (m *ClientMock) RegisterResultWithEventsFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.RegisterResultWithEventsMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.RegisterResultWithEventsCounter) == uint64(len(m.RegisterResultWithEventsMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.RegisterResultWithEventsMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.RegisterResultWithEventsCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.RegisterResultWithEventsFunc != nil {
+		return atomic.LoadUint64(&m.RegisterResultWithEventsCounter) > 0
+	}
+
+	return true
+}
+
 type mClientMockRegisterValidation struct {
 	mock              *ClientMock
 	mainExpectation   *ClientMockRegisterValidationExpectation
@@ -2898,6 +3540,10 @@ func (m *ClientMock) ValidateCallCounters() {
 		m.t.Fatal("Expected call to ClientMock.GetDelegate")
 	}
 
+	if !m.GetEventsFinished() {
+		m.t.Fatal("Expected call to ClientMock.GetEvents")
+	}
+
 	if !m.GetObjectFinished() {
 		m.t.Fatal("Expected call to ClientMock.GetObject")
 	}
@@ -2906,6 +3552,14 @@ func (m *ClientMock) ValidateCallCounters() {
 		m.t.Fatal("Expected call to ClientMock.GetPendingRequest")
 	}
 
+	if !m.GetRecordProofFinished() {
+		m.t.Fatal("Expected call to ClientMock.GetRecordProof")
+	}
+
+	if !m.GetRequestResultFinished() {
+		m.t.Fatal("Expected call to ClientMock.GetRequestResult")
+	}
+
 	if !m.HasPendingRequestsFinished() {
 		m.t.Fatal("Expected call to ClientMock.HasPendingRequests")
 	}
@@ -2918,6 +3572,10 @@ func (m *ClientMock) ValidateCallCounters() {
 		m.t.Fatal("Expected call to ClientMock.RegisterResult")
 	}
 
+	if !m.RegisterResultWithEventsFinished() {
+		m.t.Fatal("Expected call to ClientMock.RegisterResultWithEvents")
+	}
+
 	if !m.RegisterValidationFinished() {
 		m.t.Fatal("Expected call to ClientMock.RegisterValidation")
 	}
@@ -2987,6 +3645,10 @@ func (m *ClientMock) MinimockFinish() {
 		m.t.Fatal("Expected call to ClientMock.GetDelegate")
 	}
 
+	if !m.GetEventsFinished() {
+		m.t.Fatal("Expected call to ClientMock.GetEvents")
+	}
+
 	if !m.GetObjectFinished() {
 		m.t.Fatal("Expected call to ClientMock.GetObject")
 	}
@@ -2995,6 +3657,14 @@ func (m *ClientMock) MinimockFinish() {
 		m.t.Fatal("Expected call to ClientMock.GetPendingRequest")
 	}
 
+	if !m.GetRecordProofFinished() {
+		m.t.Fatal("Expected call to ClientMock.GetRecordProof")
+	}
+
+	if !m.GetRequestResultFinished() {
+		m.t.Fatal("Expected call to ClientMock.GetRequestResult")
+	}
+
 	if !m.HasPendingRequestsFinished() {
 		m.t.Fatal("Expected call to ClientMock.HasPendingRequests")
 	}
@@ -3007,6 +3677,10 @@ func (m *ClientMock) MinimockFinish() {
 		m.t.Fatal("Expected call to ClientMock.RegisterResult")
 	}
 
+	if !m.RegisterResultWithEventsFinished() {
+		m.t.Fatal("Expected call to ClientMock.RegisterResultWithEvents")
+	}
+
 	if !m.RegisterValidationFinished() {
 		m.t.Fatal("Expected call to ClientMock.RegisterValidation")
 	}
@@ -3046,11 +3720,15 @@ func (m *ClientMock) MinimockWait(timeout time.Duration) {
 		ok = ok && m.GetChildrenFinished()
 		ok = ok && m.GetCodeFinished()
 		ok = ok && m.GetDelegateFinished()
+		ok = ok && m.GetEventsFinished()
 		ok = ok && m.GetObjectFinished()
 		ok = ok && m.GetPendingRequestFinished()
+		ok = ok && m.GetRecordProofFinished()
+		ok = ok && m.GetRequestResultFinished()
 		ok = ok && m.HasPendingRequestsFinished()
 		ok = ok && m.RegisterRequestFinished()
 		ok = ok && m.RegisterResultFinished()
+		ok = ok && m.RegisterResultWithEventsFinished()
 		ok = ok && m.RegisterValidationFinished()
 		ok = ok && m.StateFinished()
 		ok = ok && m.UpdateObjectFinished()
@@ -3099,6 +3777,10 @@ func (m *ClientMock) MinimockWait(timeout time.Duration) {
 				m.t.Error("Expected call to ClientMock.GetDelegate")
 			}
 
+			if !m.GetEventsFinished() {
+				m.t.Error("Expected call to ClientMock.GetEvents")
+			}
+
 			if !m.GetObjectFinished() {
 				m.t.Error("Expected call to ClientMock.GetObject")
 			}
@@ -3107,6 +3789,14 @@ func (m *ClientMock) MinimockWait(timeout time.Duration) {
 				m.t.Error("Expected call to ClientMock.GetPendingRequest")
 			}
 
+			if !m.GetRecordProofFinished() {
+				m.t.Error("Expected call to ClientMock.GetRecordProof")
+			}
+
+			if !m.GetRequestResultFinished() {
+				m.t.Error("Expected call to ClientMock.GetRequestResult")
+			}
+
 			if !m.HasPendingRequestsFinished() {
 				m.t.Error("Expected call to ClientMock.HasPendingRequests")
 			}
@@ -3119,6 +3809,10 @@ func (m *ClientMock) MinimockWait(timeout time.Duration) {
 				m.t.Error("Expected call to ClientMock.RegisterResult")
 			}
 
+			if !m.RegisterResultWithEventsFinished() {
+				m.t.Error("Expected call to ClientMock.RegisterResultWithEvents")
+			}
+
 			if !m.RegisterValidationFinished() {
 				m.t.Error("Expected call to ClientMock.RegisterValidation")
 			}
@@ -3183,6 +3877,10 @@ func (m *ClientMock) AllMocksCalled() bool {
 		return false
 	}
 
+	if !m.GetEventsFinished() {
+		return false
+	}
+
 	if !m.GetObjectFinished() {
 		return false
 	}
@@ -3191,6 +3889,14 @@ func (m *ClientMock) AllMocksCalled() bool {
 		return false
 	}
 
+	if !m.GetRecordProofFinished() {
+		return false
+	}
+
+	if !m.GetRequestResultFinished() {
+		return false
+	}
+
 	if !m.HasPendingRequestsFinished() {
 		return false
 	}
@@ -3203,6 +3909,10 @@ func (m *ClientMock) AllMocksCalled() bool {
 		return false
 	}
 
+	if !m.RegisterResultWithEventsFinished() {
+		return false
+	}
+
 	if !m.RegisterValidationFinished() {
 		return false
 	}
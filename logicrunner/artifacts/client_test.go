@@ -304,6 +304,47 @@ func (s *amSuite) TestLedgerArtifactManager_RegisterRequest_JetMiss() {
 	})
 }
 
+func (s *amSuite) TestLedgerArtifactManager_RegisterResultWithEvents() {
+	mc := minimock.NewController(s.T())
+	defer mc.Finish()
+
+	obj := testutils.RandomRef()
+	request := testutils.RandomRef()
+	resultID := testutils.RandomID()
+	events := []insolar.Event{{Name: "Transfer", Payload: []byte{1}}}
+
+	pa := pulse.NewAccessorMock(mc)
+	pa.LatestMock.Return(insolar.Pulse{PulseNumber: insolar.FirstPulseNumber}, nil)
+
+	var sent []insolar.Message
+	mb := testutils.NewMessageBusMock(mc)
+	mb.SendFunc = func(c context.Context, m insolar.Message, o *insolar.MessageSendOptions) (insolar.Reply, error) {
+		sent = append(sent, m)
+		if _, ok := m.(*message.SetEvents); ok {
+			return &reply.OK{}, nil
+		}
+		return &reply.ID{ID: resultID}, nil
+	}
+
+	am := NewClient()
+	am.DefaultBus = mb
+	am.PulseAccessor = pa
+	am.JetStorage = s.jetStorage
+
+	id, err := am.RegisterResultWithEvents(s.ctx, obj, request, []byte{2}, events)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), resultID, *id)
+
+	// result is saved in the jet of the request, events are indexed in the jet of the object
+	require.Len(s.T(), sent, 2)
+	assert.Equal(s.T(), request, *sent[0].(*message.SetRecord).DefaultTarget())
+	assert.Equal(s.T(), &message.SetEvents{Object: obj, Result: resultID, Events: events}, sent[1])
+
+	_, err = am.RegisterResultWithEvents(s.ctx, obj, request, []byte{2}, nil)
+	require.NoError(s.T(), err)
+	assert.Len(s.T(), sent, 3, "no events are indexed")
+}
+
 func (s *amSuite) TestLedgerArtifactManager_GetRequest_Success() {
 	// Arrange
 	mc := minimock.NewController(s.T())
@@ -63,6 +63,8 @@ type objectDescriptor struct {
 	childPointer *insolar.ID // can be nil.
 	memory       []byte
 	parent       insolar.Reference
+	prevState    *insolar.ID        // can be nil.
+	request      *insolar.Reference // can be nil.
 }
 
 // IsPrototype determines if the object is a prototype.
@@ -102,6 +104,16 @@ func (d *objectDescriptor) StateID() *insolar.ID {
 	return &d.state
 }
 
+// PrevStateID returns reference to previous object state record.
+func (d *objectDescriptor) PrevStateID() *insolar.ID {
+	return d.prevState
+}
+
+// Request returns reference to request that produced the state.
+func (d *objectDescriptor) Request() *insolar.Reference {
+	return d.request
+}
+
 // ChildPointer returns the latest child for this object.
 func (d *objectDescriptor) ChildPointer() *insolar.ID {
 	return d.childPointer
@@ -54,11 +54,21 @@ type ObjectDescriptorMock struct {
 	ParentPreCounter uint64
 	ParentMock       mObjectDescriptorMockParent
 
+	PrevStateIDFunc       func() (r *insolar.ID)
+	PrevStateIDCounter    uint64
+	PrevStateIDPreCounter uint64
+	PrevStateIDMock       mObjectDescriptorMockPrevStateID
+
 	PrototypeFunc       func() (r *insolar.Reference, r1 error)
 	PrototypeCounter    uint64
 	PrototypePreCounter uint64
 	PrototypeMock       mObjectDescriptorMockPrototype
 
+	RequestFunc       func() (r *insolar.Reference)
+	RequestCounter    uint64
+	RequestPreCounter uint64
+	RequestMock       mObjectDescriptorMockRequest
+
 	StateIDFunc       func() (r *insolar.ID)
 	StateIDCounter    uint64
 	StateIDPreCounter uint64
@@ -80,7 +90,9 @@ func NewObjectDescriptorMock(t minimock.Tester) *ObjectDescriptorMock {
 	m.IsPrototypeMock = mObjectDescriptorMockIsPrototype{mock: m}
 	m.MemoryMock = mObjectDescriptorMockMemory{mock: m}
 	m.ParentMock = mObjectDescriptorMockParent{mock: m}
+	m.PrevStateIDMock = mObjectDescriptorMockPrevStateID{mock: m}
 	m.PrototypeMock = mObjectDescriptorMockPrototype{mock: m}
+	m.RequestMock = mObjectDescriptorMockRequest{mock: m}
 	m.StateIDMock = mObjectDescriptorMockStateID{mock: m}
 
 	return m
@@ -1043,6 +1055,140 @@ func (m *ObjectDescriptorMock) ParentFinished() bool {
 	return true
 }
 
+type mObjectDescriptorMockPrevStateID struct {
+	mock              *ObjectDescriptorMock
+	mainExpectation   *ObjectDescriptorMockPrevStateIDExpectation
+	expectationSeries []*ObjectDescriptorMockPrevStateIDExpectation
+}
+
+type ObjectDescriptorMockPrevStateIDExpectation struct {
+	result *ObjectDescriptorMockPrevStateIDResult
+}
+
+type ObjectDescriptorMockPrevStateIDResult struct {
+	r *insolar.ID
+}
+
+//Expect specifies that invocation of ObjectDescriptor.PrevStateID is expected from 1 to Infinity times
+func (m *mObjectDescriptorMockPrevStateID) Expect() *mObjectDescriptorMockPrevStateID {
+	m.mock.PrevStateIDFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ObjectDescriptorMockPrevStateIDExpectation{}
+	}
+
+	return m
+}
+
+//Return specifies results of invocation of ObjectDescriptor.PrevStateID
+func (m *mObjectDescriptorMockPrevStateID) Return(r *insolar.ID) *ObjectDescriptorMock {
+	m.mock.PrevStateIDFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ObjectDescriptorMockPrevStateIDExpectation{}
+	}
+	m.mainExpectation.result = &ObjectDescriptorMockPrevStateIDResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of ObjectDescriptor.PrevStateID is expected once
+func (m *mObjectDescriptorMockPrevStateID) ExpectOnce() *ObjectDescriptorMockPrevStateIDExpectation {
+	m.mock.PrevStateIDFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ObjectDescriptorMockPrevStateIDExpectation{}
+
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ObjectDescriptorMockPrevStateIDExpectation) Return(r *insolar.ID) {
+	e.result = &ObjectDescriptorMockPrevStateIDResult{r}
+}
+
+//Set uses given function f as a mock of ObjectDescriptor.PrevStateID method
+func (m *mObjectDescriptorMockPrevStateID) Set(f func() (r *insolar.ID)) *ObjectDescriptorMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.PrevStateIDFunc = f
+	return m.mock
+}
+
+//PrevStateID implements github.com/insolar/insolar/logicrunner/artifacts.ObjectDescriptor interface
+func (m *ObjectDescriptorMock) PrevStateID() (r *insolar.ID) {
+	counter := atomic.AddUint64(&m.PrevStateIDPreCounter, 1)
+	defer atomic.AddUint64(&m.PrevStateIDCounter, 1)
+
+	if len(m.PrevStateIDMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.PrevStateIDMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ObjectDescriptorMock.PrevStateID.")
+			return
+		}
+
+		result := m.PrevStateIDMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ObjectDescriptorMock.PrevStateID")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.PrevStateIDMock.mainExpectation != nil {
+
+		result := m.PrevStateIDMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ObjectDescriptorMock.PrevStateID")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.PrevStateIDFunc == nil {
+		m.t.Fatalf("Unexpected call to ObjectDescriptorMock.PrevStateID.")
+		return
+	}
+
+	return m.PrevStateIDFunc()
+}
+
+//PrevStateIDMinimockCounter returns a count of ObjectDescriptorMock.PrevStateIDFunc invocations
+func (m *ObjectDescriptorMock) PrevStateIDMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.PrevStateIDCounter)
+}
+
+//PrevStateIDMinimockPreCounter returns the value of ObjectDescriptorMock.PrevStateID invocations
+func (m *ObjectDescriptorMock) PrevStateIDMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.PrevStateIDPreCounter)
+}
+
+//PrevStateIDFinished returns true if mock invocations count is ok
+func (m *ObjectDescriptorMock) PrevStateIDFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.PrevStateIDMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.PrevStateIDCounter) == uint64(len(m.PrevStateIDMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.PrevStateIDMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.PrevStateIDCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.PrevStateIDFunc != nil {
+		return atomic.LoadUint64(&m.PrevStateIDCounter) > 0
+	}
+
+	return true
+}
+
 type mObjectDescriptorMockPrototype struct {
 	mock              *ObjectDescriptorMock
 	mainExpectation   *ObjectDescriptorMockPrototypeExpectation
@@ -1180,6 +1326,140 @@ func (m *ObjectDescriptorMock) PrototypeFinished() bool {
 	return true
 }
 
+type mObjectDescriptorMockRequest struct {
+	mock              *ObjectDescriptorMock
+	mainExpectation   *ObjectDescriptorMockRequestExpectation
+	expectationSeries []*ObjectDescriptorMockRequestExpectation
+}
+
+type ObjectDescriptorMockRequestExpectation struct {
+	result *ObjectDescriptorMockRequestResult
+}
+
+type ObjectDescriptorMockRequestResult struct {
+	r *insolar.Reference
+}
+
+//Expect specifies that invocation of ObjectDescriptor.Request is expected from 1 to Infinity times
+func (m *mObjectDescriptorMockRequest) Expect() *mObjectDescriptorMockRequest {
+	m.mock.RequestFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ObjectDescriptorMockRequestExpectation{}
+	}
+
+	return m
+}
+
+//Return specifies results of invocation of ObjectDescriptor.Request
+func (m *mObjectDescriptorMockRequest) Return(r *insolar.Reference) *ObjectDescriptorMock {
+	m.mock.RequestFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ObjectDescriptorMockRequestExpectation{}
+	}
+	m.mainExpectation.result = &ObjectDescriptorMockRequestResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of ObjectDescriptor.Request is expected once
+func (m *mObjectDescriptorMockRequest) ExpectOnce() *ObjectDescriptorMockRequestExpectation {
+	m.mock.RequestFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ObjectDescriptorMockRequestExpectation{}
+
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ObjectDescriptorMockRequestExpectation) Return(r *insolar.Reference) {
+	e.result = &ObjectDescriptorMockRequestResult{r}
+}
+
+//Set uses given function f as a mock of ObjectDescriptor.Request method
+func (m *mObjectDescriptorMockRequest) Set(f func() (r *insolar.Reference)) *ObjectDescriptorMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.RequestFunc = f
+	return m.mock
+}
+
+//Request implements github.com/insolar/insolar/logicrunner/artifacts.ObjectDescriptor interface
+func (m *ObjectDescriptorMock) Request() (r *insolar.Reference) {
+	counter := atomic.AddUint64(&m.RequestPreCounter, 1)
+	defer atomic.AddUint64(&m.RequestCounter, 1)
+
+	if len(m.RequestMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.RequestMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ObjectDescriptorMock.Request.")
+			return
+		}
+
+		result := m.RequestMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ObjectDescriptorMock.Request")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.RequestMock.mainExpectation != nil {
+
+		result := m.RequestMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ObjectDescriptorMock.Request")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.RequestFunc == nil {
+		m.t.Fatalf("Unexpected call to ObjectDescriptorMock.Request.")
+		return
+	}
+
+	return m.RequestFunc()
+}
+
+//RequestMinimockCounter returns a count of ObjectDescriptorMock.RequestFunc invocations
+func (m *ObjectDescriptorMock) RequestMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.RequestCounter)
+}
+
+//RequestMinimockPreCounter returns the value of ObjectDescriptorMock.Request invocations
+func (m *ObjectDescriptorMock) RequestMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.RequestPreCounter)
+}
+
+//RequestFinished returns true if mock invocations count is ok
+func (m *ObjectDescriptorMock) RequestFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.RequestMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.RequestCounter) == uint64(len(m.RequestMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.RequestMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.RequestCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.RequestFunc != nil {
+		return atomic.LoadUint64(&m.RequestCounter) > 0
+	}
+
+	return true
+}
+
 type mObjectDescriptorMockStateID struct {
 	mock              *ObjectDescriptorMock
 	mainExpectation   *ObjectDescriptorMockStateIDExpectation
@@ -1346,10 +1626,18 @@ func (m *ObjectDescriptorMock) ValidateCallCounters() {
 		m.t.Fatal("Expected call to ObjectDescriptorMock.Parent")
 	}
 
+	if !m.PrevStateIDFinished() {
+		m.t.Fatal("Expected call to ObjectDescriptorMock.PrevStateID")
+	}
+
 	if !m.PrototypeFinished() {
 		m.t.Fatal("Expected call to ObjectDescriptorMock.Prototype")
 	}
 
+	if !m.RequestFinished() {
+		m.t.Fatal("Expected call to ObjectDescriptorMock.Request")
+	}
+
 	if !m.StateIDFinished() {
 		m.t.Fatal("Expected call to ObjectDescriptorMock.StateID")
 	}
@@ -1399,10 +1687,18 @@ func (m *ObjectDescriptorMock) MinimockFinish() {
 		m.t.Fatal("Expected call to ObjectDescriptorMock.Parent")
 	}
 
+	if !m.PrevStateIDFinished() {
+		m.t.Fatal("Expected call to ObjectDescriptorMock.PrevStateID")
+	}
+
 	if !m.PrototypeFinished() {
 		m.t.Fatal("Expected call to ObjectDescriptorMock.Prototype")
 	}
 
+	if !m.RequestFinished() {
+		m.t.Fatal("Expected call to ObjectDescriptorMock.Request")
+	}
+
 	if !m.StateIDFinished() {
 		m.t.Fatal("Expected call to ObjectDescriptorMock.StateID")
 	}
@@ -1428,7 +1724,9 @@ func (m *ObjectDescriptorMock) MinimockWait(timeout time.Duration) {
 		ok = ok && m.IsPrototypeFinished()
 		ok = ok && m.MemoryFinished()
 		ok = ok && m.ParentFinished()
+		ok = ok && m.PrevStateIDFinished()
 		ok = ok && m.PrototypeFinished()
+		ok = ok && m.RequestFinished()
 		ok = ok && m.StateIDFinished()
 
 		if ok {
@@ -1466,10 +1764,18 @@ func (m *ObjectDescriptorMock) MinimockWait(timeout time.Duration) {
 				m.t.Error("Expected call to ObjectDescriptorMock.Parent")
 			}
 
+			if !m.PrevStateIDFinished() {
+				m.t.Error("Expected call to ObjectDescriptorMock.PrevStateID")
+			}
+
 			if !m.PrototypeFinished() {
 				m.t.Error("Expected call to ObjectDescriptorMock.Prototype")
 			}
 
+			if !m.RequestFinished() {
+				m.t.Error("Expected call to ObjectDescriptorMock.Request")
+			}
+
 			if !m.StateIDFinished() {
 				m.t.Error("Expected call to ObjectDescriptorMock.StateID")
 			}
@@ -1514,10 +1820,18 @@ func (m *ObjectDescriptorMock) AllMocksCalled() bool {
 		return false
 	}
 
+	if !m.PrevStateIDFinished() {
+		return false
+	}
+
 	if !m.PrototypeFinished() {
 		return false
 	}
 
+	if !m.RequestFinished() {
+		return false
+	}
+
 	if !m.StateIDFinished() {
 		return false
 	}
@@ -157,10 +157,15 @@ func TmpLedger(t *testing.T, dir string, c insolar.Components) (*TMPLedger, stor
 	handler.DBContext = tmpDB
 	handler.ObjectStorage = os
 	handler.DropModifier = ds
+	handler.DropAccessor = ds
 	handler.BlobModifier = bs
 	handler.BlobAccessor = bs
 	handler.RecordModifier = recordModifier
 	handler.RecordAccessor = recordAccessor
+	handler.ResultAccessor = recMem
+	handler.EventAccessor = recMem
+	handler.EventModifier = recMem
+	handler.DropRecords = recMem
 
 	idLockerMock := storage.NewIDLockerMock(t)
 	idLockerMock.LockMock.Return()
@@ -0,0 +1,78 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package logicrunner
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+)
+
+// budgets is a set of execution budgets of contract calls, the default one and overrides per prototype
+type budgets struct {
+	def       insolar.ExecutionBudget
+	prototype map[Ref]insolar.ExecutionBudget
+}
+
+func newBudgets(cfg *configuration.LogicRunner) (*budgets, error) {
+	res := &budgets{prototype: make(map[Ref]insolar.ExecutionBudget)}
+	if cfg.Budget != nil {
+		res.def = executionBudget(*cfg.Budget)
+	}
+
+	for _, pb := range cfg.PrototypeBudgets {
+		ref, err := insolar.NewReferenceFromBase58(pb.Prototype)
+		if err != nil {
+			return nil, errors.Wrapf(err, "bad prototype reference %q in execution budget", pb.Prototype)
+		}
+		budget := executionBudget(pb.Budget)
+		if budget.MaxDuration == 0 {
+			budget.MaxDuration = res.def.MaxDuration
+		}
+		if budget.MaxUpcalls == 0 {
+			budget.MaxUpcalls = res.def.MaxUpcalls
+		}
+		if budget.MaxStateSize == 0 {
+			budget.MaxStateSize = res.def.MaxStateSize
+		}
+		res.prototype[*ref] = budget
+	}
+
+	return res, nil
+}
+
+func executionBudget(cfg configuration.ExecutionBudget) insolar.ExecutionBudget {
+	return insolar.ExecutionBudget{
+		MaxDuration:  cfg.MaxDuration,
+		MaxUpcalls:   cfg.MaxUpcalls,
+		MaxStateSize: cfg.MaxStateSize,
+	}
+}
+
+// get returns execution budget of calls on objects of the prototype
+func (b *budgets) get(prototype *Ref) insolar.ExecutionBudget {
+	if b == nil {
+		return insolar.ExecutionBudget{}
+	}
+	if prototype != nil {
+		if budget, ok := b.prototype[*prototype]; ok {
+			return budget
+		}
+	}
+	return b.def
+}
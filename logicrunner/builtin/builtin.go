@@ -19,18 +19,28 @@ package builtin
 
 import (
 	"context"
+	"fmt"
 	"reflect"
-
-	"github.com/insolar/insolar/instrumentation/instracer"
-	"github.com/insolar/insolar/logicrunner/artifacts"
+	"sync"
+	"time"
 
 	"github.com/pkg/errors"
+	"github.com/tylerb/gls"
 	"github.com/ugorji/go/codec"
+	"go.opencensus.io/stats"
 
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/instrumentation/insmetrics"
+	"github.com/insolar/insolar/instrumentation/instracer"
+	"github.com/insolar/insolar/logicrunner/artifacts"
 	"github.com/insolar/insolar/logicrunner/builtin/helloworld"
+	"github.com/insolar/insolar/logicrunner/goplugin/proxyctx"
 )
 
+// glsCallContextKey is a key of call context in goroutine local storage, see foundation.GetContext
+const glsCallContextKey = "callCtx"
+
 // Contract is a interface for builtin contract
 type Contract interface {
 }
@@ -40,23 +50,71 @@ type BuiltIn struct {
 	AM       artifacts.Client
 	EB       insolar.MessageBus
 	Registry map[string]Contract
+	// Wrappers holds contracts with generated wrappers, see `insgocc wrapper --builtin`
+	Wrappers map[string]insolar.ContractWrapper
+
+	// prototypes holds prototype references of hosted contracts proxies, it's nil when they are resolved.
+	// References are package-level variables of application proxies, so they are shared by the whole process.
+	prototypes     map[string]*insolar.Reference
+	prototypesLock sync.Mutex
 }
 
-// NewBuiltIn is an constructor
-func NewBuiltIn(eb insolar.MessageBus, am artifacts.Client) *BuiltIn {
+// NewBuiltIn is an constructor. BuiltIn sets proxyctx.Current and resolves PrototypeReference variables of
+// application proxies, both are process-wide, so only one BuiltIn should run in a process.
+func NewBuiltIn(eb insolar.MessageBus, am artifacts.Client, upstream Upstream) *BuiltIn {
 	bi := BuiltIn{
-		AM:       am,
-		EB:       eb,
-		Registry: make(map[string]Contract),
+		AM:         am,
+		EB:         eb,
+		Registry:   make(map[string]Contract),
+		Wrappers:   make(map[string]insolar.ContractWrapper),
+		prototypes: make(map[string]*insolar.Reference),
 	}
 
 	bi.Registry["helloworld"] = helloworld.NewHelloWorld()
 
+	for name, c := range applicationContracts() {
+		bi.Wrappers[name] = c.wrapper
+		bi.prototypes[name] = c.prototype
+	}
+
+	proxyctx.Current = NewProxyHelper(upstream)
+
 	return &bi
 }
 
-func (bi *BuiltIn) CallConstructor(ctx context.Context, callCtx *insolar.LogicCallContext, code insolar.Reference, name string, args insolar.Arguments) (objectState []byte, err error) {
-	panic("implement me")
+// CallConstructor runs a constructor of contract with generated wrapper
+func (bi *BuiltIn) CallConstructor(ctx context.Context, callCtx *insolar.LogicCallContext, codeRef insolar.Reference, name string, args insolar.Arguments) (objectState []byte, err error) {
+	ctx, span := instracer.StartSpan(ctx, "builtin.CallConstructor")
+	defer span.End()
+
+	contractName, err := bi.codeName(ctx, codeRef)
+	if err != nil {
+		return nil, err
+	}
+	w, ok := bi.Wrappers[contractName]
+	if !ok {
+		return nil, errors.New("Wrong reference for builtin contract")
+	}
+
+	err = bi.resolvePrototypes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	constructor, ok := w.Constructors[name]
+	if !ok {
+		return nil, errors.New("no constructor " + name + " in the contract")
+	}
+
+	defer recoverCall(ctx, &err)
+	defer setCallContext(callCtx)()
+
+	objectState, err = constructor(args)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Can't call constructor %s", name)
+	}
+
+	return objectState, nil
 }
 
 func (bi *BuiltIn) Stop() error {
@@ -65,21 +123,33 @@ func (bi *BuiltIn) Stop() error {
 
 // CallMethod runs a method on contract
 func (bi *BuiltIn) CallMethod(ctx context.Context, callCtx *insolar.LogicCallContext, codeRef insolar.Reference, data []byte, method string, args insolar.Arguments) (newObjectState []byte, methodResults insolar.Arguments, err error) {
-	am := bi.AM
+	ctx = insmetrics.InsertTag(ctx, tagMethodName, method)
+	start := time.Now()
+	defer func() {
+		stats.Record(ctx, statBuiltinContractMethodTime.M(
+			float64(time.Since(start).Nanoseconds())/1e6,
+		))
+	}()
+
 	ctx, span := instracer.StartSpan(ctx, "buildin.CallMethod")
 	defer span.End()
 
-	codeDescriptor, err := am.GetCode(ctx, codeRef)
-
+	name, err := bi.codeName(ctx, codeRef)
 	if err != nil {
-		return nil, nil, errors.Wrap(err, "Can't find code")
+		return nil, nil, err
 	}
-	code, err := codeDescriptor.Code()
-	c, ok := bi.Registry[string(code)]
+
+	if w, ok := bi.Wrappers[name]; ok {
+		return bi.callWrapper(ctx, callCtx, w, data, method, args)
+	}
+
+	c, ok := bi.Registry[name]
 	if !ok {
 		return nil, nil, errors.New("Wrong reference for builtin contract")
 	}
-
+	if callCtx.Mode == insolar.ImmutableMode {
+		return nil, nil, errors.Errorf("Calling non immutable method %s in immutable mode", method)
+	}
 	zv := reflect.New(reflect.TypeOf(c).Elem()).Interface()
 	ch := new(codec.CborHandle)
 
@@ -133,3 +203,139 @@ func (bi *BuiltIn) CallMethod(ctx context.Context, callCtx *insolar.LogicCallCon
 
 	return newObjectState, methodResults, nil
 }
+
+func (bi *BuiltIn) callWrapper(ctx context.Context, callCtx *insolar.LogicCallContext, w insolar.ContractWrapper, data []byte, method string, args insolar.Arguments) (newObjectState []byte, methodResults insolar.Arguments, err error) {
+	if callCtx.Caller == nil || callCtx.Caller.IsEmpty() {
+		if !w.API[method] {
+			return nil, nil, errors.Errorf("Calling non INSATTRAPI method %s", method)
+		}
+	}
+	if callCtx.Mode == insolar.ImmutableMode && method != "Migrate" && !w.Immutable[method] {
+		return nil, nil, errors.Errorf("Calling non immutable method %s in immutable mode", method)
+	}
+
+	m, ok := w.Methods[method]
+	if !ok {
+		return nil, nil, errors.New("no method " + method + " in the contract")
+	}
+
+	err = bi.resolvePrototypes(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer recoverCall(ctx, &err)
+	defer setCallContext(callCtx)()
+
+	newObjectState, methodResults, err = m(data, args)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "Method call returned error")
+	}
+
+	return newObjectState, methodResults, nil
+}
+
+// codeName returns name of builtin contract stored in code record
+func (bi *BuiltIn) codeName(ctx context.Context, codeRef insolar.Reference) (string, error) {
+	codeDescriptor, err := bi.AM.GetCode(ctx, codeRef)
+	if err != nil {
+		return "", errors.Wrap(err, "Can't find code")
+	}
+	code, err := codeDescriptor.Code()
+	if err != nil {
+		return "", errors.Wrap(err, "Can't get code")
+	}
+	return string(code), nil
+}
+
+// resolvePrototypes points proxies of hosted contracts to prototypes deployed by genesis.
+// Prototypes are children of genesis object with builtin code record named after contract.
+// All prototypes are resolved at once before the first call of hosted contracts, calls wait for it on the lock,
+// so proxies are never changed while contracts run. Nothing is changed and calls fail until genesis deploys
+// all prototypes, after resolution genesis isn't looked up anymore.
+func (bi *BuiltIn) resolvePrototypes(ctx context.Context) error {
+	bi.prototypesLock.Lock()
+	defer bi.prototypesLock.Unlock()
+
+	if bi.prototypes == nil {
+		return nil
+	}
+
+	found, err := bi.findPrototypes(ctx)
+	if err != nil {
+		return err
+	}
+	for name := range bi.prototypes {
+		if _, ok := found[name]; !ok {
+			return errors.Errorf("[ resolvePrototypes ] prototype of builtin contract %q is not deployed", name)
+		}
+	}
+
+	for name, proto := range bi.prototypes {
+		*proto = found[name]
+		inslogger.FromContext(ctx).Debugf("builtin contract %q uses prototype %s", name, proto)
+	}
+	bi.prototypes = nil
+	return nil
+}
+
+// findPrototypes returns prototypes with builtin code deployed by genesis by names of contracts.
+func (bi *BuiltIn) findPrototypes(ctx context.Context) (map[string]insolar.Reference, error) {
+	children, err := bi.AM.GetChildren(ctx, *bi.AM.GenesisRef(), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ findPrototypes ] can't get genesis children")
+	}
+
+	found := map[string]insolar.Reference{}
+	for children.HasNext() {
+		ref, err := children.Next()
+		if err != nil {
+			return nil, errors.Wrap(err, "[ findPrototypes ] can't get genesis child")
+		}
+		obj, err := bi.AM.GetObject(ctx, *ref, nil, false)
+		if err != nil {
+			return nil, errors.Wrap(err, "[ findPrototypes ] can't get object")
+		}
+		if !obj.IsPrototype() {
+			continue
+		}
+		codeRef, err := obj.Code()
+		if err != nil {
+			return nil, errors.Wrap(err, "[ findPrototypes ] can't get code reference")
+		}
+		code, err := bi.AM.GetCode(ctx, *codeRef)
+		if err != nil {
+			return nil, errors.Wrap(err, "[ findPrototypes ] can't get code")
+		}
+		if code.MachineType() != insolar.MachineTypeBuiltin {
+			continue
+		}
+		name, err := code.Code()
+		if err != nil {
+			return nil, errors.Wrap(err, "[ findPrototypes ] can't get code")
+		}
+		found[string(name)] = *ref
+	}
+	return found, nil
+}
+
+// setCallContext puts call context into goroutine local storage for foundation and proxies,
+// returned function restores previous state
+func setCallContext(callCtx *insolar.LogicCallContext) func() {
+	prev := gls.Get(glsCallContextKey)
+	gls.Set(glsCallContextKey, callCtx)
+	return func() {
+		if prev == nil {
+			gls.Cleanup()
+		} else {
+			gls.Set(glsCallContextKey, prev)
+		}
+	}
+}
+
+func recoverCall(ctx context.Context, err *error) {
+	if r := recover(); r != nil {
+		*err = errors.New(fmt.Sprint("contract panic: ", r))
+		inslogger.FromContext(ctx).Error("panic in builtin contract: ", r)
+	}
+}
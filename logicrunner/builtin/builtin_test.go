@@ -0,0 +1,220 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package builtin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock"
+	"github.com/stretchr/testify/require"
+	"github.com/tylerb/gls"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/logicrunner/artifacts"
+	"github.com/insolar/insolar/logicrunner/goplugin/foundation"
+	"github.com/insolar/insolar/testutils"
+)
+
+type refIterator struct {
+	refs []insolar.Reference
+}
+
+func (i *refIterator) HasNext() bool {
+	return len(i.refs) > 0
+}
+
+func (i *refIterator) Next() (*insolar.Reference, error) {
+	ref := i.refs[0]
+	i.refs = i.refs[1:]
+	return &ref, nil
+}
+
+func codeDescriptor(mc *minimock.Controller, code string) *artifacts.CodeDescriptorMock {
+	cd := artifacts.NewCodeDescriptorMock(mc)
+	cd.CodeMock.Return([]byte(code), nil)
+	return cd
+}
+
+func TestBuiltIn_CallMethod_Wrapper(t *testing.T) {
+	ctx := context.Background()
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	codeRef := testutils.RandomRef()
+	am := artifacts.NewClientMock(mc)
+	am.GetCodeMock.Return(codeDescriptor(mc, "test"), nil)
+
+	callee := testutils.RandomRef()
+	bi := &BuiltIn{
+		AM: am,
+		Wrappers: map[string]insolar.ContractWrapper{
+			"test": {
+				Methods: map[string]insolar.ContractMethod{
+					"Public": func(object []byte, args []byte) ([]byte, []byte, error) {
+						require.Equal(t, callee, *foundation.GetContext().Callee)
+						return append(object, args...), []byte("result"), nil
+					},
+					"Private": func(object []byte, args []byte) ([]byte, []byte, error) {
+						return object, nil, nil
+					},
+					"Panic": func(object []byte, args []byte) ([]byte, []byte, error) {
+						panic("oops")
+					},
+				},
+				API: map[string]bool{"Public": true},
+			},
+		},
+	}
+
+	t.Run("calls wrapped method", func(t *testing.T) {
+		state, res, err := bi.CallMethod(ctx, &insolar.LogicCallContext{Callee: &callee}, codeRef, []byte("state"), "Public", []byte("+args"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("state+args"), state)
+		require.Equal(t, insolar.Arguments("result"), res)
+		require.Nil(t, gls.Get(glsCallContextKey))
+	})
+
+	t.Run("non API method requires caller", func(t *testing.T) {
+		_, _, err := bi.CallMethod(ctx, &insolar.LogicCallContext{Callee: &callee}, codeRef, nil, "Private", nil)
+		require.Error(t, err)
+
+		caller := testutils.RandomRef()
+		_, _, err = bi.CallMethod(ctx, &insolar.LogicCallContext{Callee: &callee, Caller: &caller}, codeRef, nil, "Private", nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("unknown method", func(t *testing.T) {
+		caller := testutils.RandomRef()
+		_, _, err := bi.CallMethod(ctx, &insolar.LogicCallContext{Callee: &callee, Caller: &caller}, codeRef, nil, "Unknown", nil)
+		require.Error(t, err)
+	})
+
+	t.Run("panic is returned as error", func(t *testing.T) {
+		caller := testutils.RandomRef()
+		_, _, err := bi.CallMethod(ctx, &insolar.LogicCallContext{Callee: &callee, Caller: &caller}, codeRef, nil, "Panic", nil)
+		require.Error(t, err)
+		require.Nil(t, gls.Get(glsCallContextKey))
+	})
+}
+
+func TestBuiltIn_CallConstructor(t *testing.T) {
+	ctx := context.Background()
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	codeRef := testutils.RandomRef()
+	am := artifacts.NewClientMock(mc)
+	am.GetCodeMock.Return(codeDescriptor(mc, "test"), nil)
+
+	bi := &BuiltIn{
+		AM: am,
+		Wrappers: map[string]insolar.ContractWrapper{
+			"test": {
+				Constructors: map[string]insolar.ContractConstructor{
+					"New": func(args []byte) ([]byte, error) {
+						return append([]byte("new"), args...), nil
+					},
+				},
+			},
+		},
+	}
+
+	state, err := bi.CallConstructor(ctx, &insolar.LogicCallContext{}, codeRef, "New", []byte("+args"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("new+args"), state)
+
+	_, err = bi.CallConstructor(ctx, &insolar.LogicCallContext{}, codeRef, "NewUnknown", nil)
+	require.Error(t, err)
+}
+
+func TestBuiltIn_resolvePrototypes(t *testing.T) {
+	ctx := context.Background()
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	genesisRef := testutils.RandomRef()
+	walletProto, memberProto, pluginProto, object := testutils.RandomRef(), testutils.RandomRef(), testutils.RandomRef(), testutils.RandomRef()
+	walletCode, memberCode, pluginCode := testutils.RandomRef(), testutils.RandomRef(), testutils.RandomRef()
+
+	// member prototype is deployed after the first call
+	deployed := []insolar.Reference{walletProto, pluginProto, object}
+	am := artifacts.NewClientMock(mc)
+	am.GenesisRefMock.Return(&genesisRef)
+	am.GetChildrenFunc = func(_ context.Context, parent insolar.Reference, _ *insolar.PulseNumber) (artifacts.RefIterator, error) {
+		require.Equal(t, genesisRef, parent)
+		return &refIterator{refs: deployed}, nil
+	}
+	am.GetObjectFunc = func(_ context.Context, head insolar.Reference, _ *insolar.ID, _ bool) (artifacts.ObjectDescriptor, error) {
+		desc := artifacts.NewObjectDescriptorMock(mc)
+		switch head {
+		case walletProto:
+			desc.IsPrototypeMock.Return(true)
+			desc.CodeMock.Return(&walletCode, nil)
+		case memberProto:
+			desc.IsPrototypeMock.Return(true)
+			desc.CodeMock.Return(&memberCode, nil)
+		case pluginProto:
+			desc.IsPrototypeMock.Return(true)
+			desc.CodeMock.Return(&pluginCode, nil)
+		default:
+			desc.IsPrototypeMock.Return(false)
+		}
+		return desc, nil
+	}
+	am.GetCodeFunc = func(_ context.Context, ref insolar.Reference) (artifacts.CodeDescriptor, error) {
+		switch ref {
+		case walletCode:
+			cd := codeDescriptor(mc, "wallet")
+			cd.MachineTypeMock.Return(insolar.MachineTypeBuiltin)
+			return cd, nil
+		case memberCode:
+			cd := codeDescriptor(mc, "member")
+			cd.MachineTypeMock.Return(insolar.MachineTypeBuiltin)
+			return cd, nil
+		}
+		cd := artifacts.NewCodeDescriptorMock(mc)
+		cd.MachineTypeMock.Return(insolar.MachineTypeGoPlugin)
+		return cd, nil
+	}
+
+	walletRef, memberRef := testutils.RandomRef(), testutils.RandomRef()
+	unresolvedMember := memberRef
+	bi := &BuiltIn{
+		AM:         am,
+		prototypes: map[string]*insolar.Reference{"wallet": &walletRef, "member": &memberRef},
+	}
+
+	// nothing is changed until all prototypes are deployed
+	unresolvedWallet := walletRef
+	err := bi.resolvePrototypes(ctx)
+	require.Error(t, err)
+	require.Equal(t, unresolvedWallet, walletRef)
+	require.Equal(t, unresolvedMember, memberRef)
+
+	deployed = append(deployed, memberProto)
+	err = bi.resolvePrototypes(ctx)
+	require.NoError(t, err)
+	require.Equal(t, walletProto, walletRef)
+	require.Equal(t, memberProto, memberRef)
+	require.Equal(t, uint64(2), am.GetChildrenCounter)
+
+	// resolved only once
+	err = bi.resolvePrototypes(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), am.GetChildrenCounter)
+}
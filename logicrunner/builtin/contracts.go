@@ -0,0 +1,57 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package builtin
+
+import (
+	"github.com/insolar/insolar/application/contract/allowance"
+	"github.com/insolar/insolar/application/contract/assetregistry"
+	"github.com/insolar/insolar/application/contract/member"
+	"github.com/insolar/insolar/application/contract/nodedomain"
+	"github.com/insolar/insolar/application/contract/noderecord"
+	"github.com/insolar/insolar/application/contract/rootdomain"
+	"github.com/insolar/insolar/application/contract/wallet"
+	allowanceproxy "github.com/insolar/insolar/application/proxy/allowance"
+	assetregistryproxy "github.com/insolar/insolar/application/proxy/assetregistry"
+	memberproxy "github.com/insolar/insolar/application/proxy/member"
+	nodedomainproxy "github.com/insolar/insolar/application/proxy/nodedomain"
+	noderecordproxy "github.com/insolar/insolar/application/proxy/noderecord"
+	rootdomainproxy "github.com/insolar/insolar/application/proxy/rootdomain"
+	walletproxy "github.com/insolar/insolar/application/proxy/wallet"
+	"github.com/insolar/insolar/insolar"
+)
+
+// applicationContract is an application contract hosted by builtin machine type
+type applicationContract struct {
+	wrapper insolar.ContractWrapper
+	// prototype reference of contract's proxy, compiled in proxies have reference
+	// calculated from code, it's replaced with one deployed by genesis
+	prototype *insolar.Reference
+}
+
+// applicationContracts returns contracts from application/contract, names
+// match code of builtin code records deployed by genesis
+func applicationContracts() map[string]applicationContract {
+	return map[string]applicationContract{
+		"allowance":     {wrapper: allowance.Initialize(), prototype: allowanceproxy.PrototypeReference},
+		"assetregistry": {wrapper: assetregistry.Initialize(), prototype: assetregistryproxy.PrototypeReference},
+		"member":        {wrapper: member.Initialize(), prototype: memberproxy.PrototypeReference},
+		"nodedomain":    {wrapper: nodedomain.Initialize(), prototype: nodedomainproxy.PrototypeReference},
+		"noderecord":    {wrapper: noderecord.Initialize(), prototype: noderecordproxy.PrototypeReference},
+		"rootdomain":    {wrapper: rootdomain.Initialize(), prototype: rootdomainproxy.PrototypeReference},
+		"wallet":        {wrapper: wallet.Initialize(), prototype: walletproxy.PrototypeReference},
+	}
+}
@@ -0,0 +1,50 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package builtin
+
+import (
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+
+	"github.com/insolar/insolar/instrumentation/insmetrics"
+)
+
+var (
+	tagMethodName = insmetrics.MustTagKey("methodName")
+)
+
+var (
+	statBuiltinContractMethodTime = stats.Float64(
+		"builtin/contract/method/time",
+		"time spent on execution contract, measured in builtin",
+		stats.UnitMilliseconds,
+	)
+)
+
+func init() {
+	err := view.Register(
+		&view.View{
+			Measure:     statBuiltinContractMethodTime,
+			Aggregation: view.Distribution(0.001, 0.01, 0.1, 1, 10, 100, 1000, 5000, 10000, 20000),
+			TagKeys:     []tag.Key{tagMethodName},
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+}
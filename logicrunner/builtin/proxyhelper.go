@@ -0,0 +1,267 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package builtin
+
+import (
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/tylerb/gls"
+	"github.com/ugorji/go/codec"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/logicrunner/goplugin/foundation"
+	"github.com/insolar/insolar/logicrunner/goplugin/proxyctx"
+	"github.com/insolar/insolar/logicrunner/goplugin/rpctypes"
+)
+
+// Upstream is a set of logicrunner calls available to contracts, goplugin runner
+// uses the same calls over RPC
+type Upstream interface {
+	RouteCall(req rpctypes.UpRouteReq, rep *rpctypes.UpRouteResp) error
+	SaveAsChild(req rpctypes.UpSaveAsChildReq, rep *rpctypes.UpSaveAsChildResp) error
+	SaveAsDelegate(req rpctypes.UpSaveAsDelegateReq, rep *rpctypes.UpSaveAsDelegateResp) error
+	GetObjChildrenIterator(req rpctypes.UpGetObjChildrenIteratorReq, rep *rpctypes.UpGetObjChildrenIteratorResp) error
+	GetObjStates(req rpctypes.UpGetObjStatesReq, rep *rpctypes.UpGetObjStatesResp) error
+	GetDelegate(req rpctypes.UpGetDelegateReq, rep *rpctypes.UpGetDelegateResp) error
+	DeactivateObject(req rpctypes.UpDeactivateObjectReq, rep *rpctypes.UpDeactivateObjectResp) error
+	UpgradePrototype(req rpctypes.UpUpgradePrototypeReq, rep *rpctypes.UpUpgradePrototypeResp) error
+	Emit(req rpctypes.UpEmitReq, rep *rpctypes.UpEmitResp) error
+}
+
+// ProxyHelper gives proxies of builtin contracts access to logicrunner
+// without network round trip
+type ProxyHelper struct {
+	Upstream Upstream
+}
+
+// NewProxyHelper creates new ProxyHelper instance
+func NewProxyHelper(upstream Upstream) *ProxyHelper {
+	return &ProxyHelper{Upstream: upstream}
+}
+
+func makeUpBaseReq() rpctypes.UpBaseReq {
+	callCtx, ok := gls.Get(glsCallContextKey).(*insolar.LogicCallContext)
+	if !ok {
+		panic("Wrong or unexistent call context")
+	}
+
+	return rpctypes.UpBaseReq{
+		Mode:      callCtx.Mode,
+		Callee:    *callCtx.Callee,
+		Prototype: *callCtx.Prototype,
+		Request:   *callCtx.Request,
+	}
+}
+
+// RouteCall ...
+func (h *ProxyHelper) RouteCall(ref insolar.Reference, wait bool, method string, args []byte, proxyPrototype insolar.Reference) ([]byte, error) {
+	req := rpctypes.UpRouteReq{
+		UpBaseReq:      makeUpBaseReq(),
+		Wait:           wait,
+		Object:         ref,
+		Method:         method,
+		Arguments:      args,
+		ProxyPrototype: proxyPrototype,
+	}
+
+	res := rpctypes.UpRouteResp{}
+	err := h.Upstream.RouteCall(req, &res)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ RouteCall ] on calling main API")
+	}
+
+	return []byte(res.Result), nil
+}
+
+// SaveAsChild ...
+func (h *ProxyHelper) SaveAsChild(parentRef, classRef insolar.Reference, constructorName string, argsSerialized []byte) (insolar.Reference, error) {
+	req := rpctypes.UpSaveAsChildReq{
+		UpBaseReq:       makeUpBaseReq(),
+		Parent:          parentRef,
+		Prototype:       classRef,
+		ConstructorName: constructorName,
+		ArgsSerialized:  argsSerialized,
+	}
+
+	res := rpctypes.UpSaveAsChildResp{}
+	err := h.Upstream.SaveAsChild(req, &res)
+	if err != nil {
+		return insolar.Reference{}, errors.Wrap(err, "[ SaveAsChild ] on calling main API")
+	}
+
+	return *res.Reference, nil
+}
+
+// GetObjChildrenIterator returns iterator over children of object with specified prototype
+func (h *ProxyHelper) GetObjChildrenIterator(obj insolar.Reference, prototype insolar.Reference, iteratorID string) (*proxyctx.ChildrenTypedIterator, error) {
+	req := rpctypes.UpGetObjChildrenIteratorReq{
+		UpBaseReq: makeUpBaseReq(),
+
+		IteratorID: iteratorID,
+		Obj:        obj,
+		Prototype:  prototype,
+	}
+
+	res := rpctypes.UpGetObjChildrenIteratorResp{}
+	err := h.Upstream.GetObjChildrenIterator(req, &res)
+	if err != nil {
+		return &proxyctx.ChildrenTypedIterator{}, errors.Wrap(err, "[ GetObjChildrenIterator ] on calling main API")
+	}
+
+	return &proxyctx.ChildrenTypedIterator{
+		Parent:         obj,
+		ChildPrototype: prototype,
+		IteratorID:     res.Iterator.ID,
+		Buff:           res.Iterator.Buff,
+		CanFetch:       res.Iterator.CanFetch,
+	}, nil
+}
+
+// GetObjStates returns a page of object states starting from provided one (latest if from is nil),
+// latest first, and id of the state to continue from
+func (h *ProxyHelper) GetObjStates(obj insolar.Reference, from *insolar.ID, limit int) ([]proxyctx.ObjectState, *insolar.ID, error) {
+	req := rpctypes.UpGetObjStatesReq{
+		UpBaseReq: makeUpBaseReq(),
+
+		Obj:   obj,
+		From:  from,
+		Limit: limit,
+	}
+
+	res := rpctypes.UpGetObjStatesResp{}
+	err := h.Upstream.GetObjStates(req, &res)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "[ GetObjStates ] on calling main API")
+	}
+
+	states := make([]proxyctx.ObjectState, 0, len(res.States))
+	for _, s := range res.States {
+		states = append(states, proxyctx.ObjectState{
+			State:   s.State,
+			Request: s.Request,
+			Memory:  s.Memory,
+		})
+	}
+	return states, res.Next, nil
+}
+
+// SaveAsDelegate ...
+func (h *ProxyHelper) SaveAsDelegate(intoRef, classRef insolar.Reference, constructorName string, argsSerialized []byte) (insolar.Reference, error) {
+	req := rpctypes.UpSaveAsDelegateReq{
+		UpBaseReq:       makeUpBaseReq(),
+		Into:            intoRef,
+		Prototype:       classRef,
+		ConstructorName: constructorName,
+		ArgsSerialized:  argsSerialized,
+	}
+
+	res := rpctypes.UpSaveAsDelegateResp{}
+	err := h.Upstream.SaveAsDelegate(req, &res)
+	if err != nil {
+		return insolar.Reference{}, errors.Wrap(err, "[ SaveAsDelegate ] on calling main API")
+	}
+
+	return *res.Reference, nil
+}
+
+// GetDelegate ...
+func (h *ProxyHelper) GetDelegate(object, ofType insolar.Reference) (insolar.Reference, error) {
+	req := rpctypes.UpGetDelegateReq{
+		UpBaseReq: makeUpBaseReq(),
+		Object:    object,
+		OfType:    ofType,
+	}
+
+	res := rpctypes.UpGetDelegateResp{}
+	err := h.Upstream.GetDelegate(req, &res)
+	if err != nil {
+		return insolar.Reference{}, errors.Wrap(err, "[ GetDelegate ] on calling main API")
+	}
+
+	return res.Object, nil
+}
+
+// DeactivateObject ...
+func (h *ProxyHelper) DeactivateObject(object insolar.Reference) error {
+	req := rpctypes.UpDeactivateObjectReq{
+		UpBaseReq: makeUpBaseReq(),
+	}
+
+	res := rpctypes.UpDeactivateObjectResp{}
+	err := h.Upstream.DeactivateObject(req, &res)
+	if err != nil {
+		return errors.Wrap(err, "[ DeactivateObject ] on calling main API")
+	}
+
+	return nil
+}
+
+// UpgradePrototype deploys new code of the prototype, returns reference to the code
+func (h *ProxyHelper) UpgradePrototype(prototype insolar.Reference, code []byte, machineType insolar.MachineType) (insolar.Reference, error) {
+	req := rpctypes.UpUpgradePrototypeReq{
+		UpBaseReq:   makeUpBaseReq(),
+		Prototype:   prototype,
+		Code:        code,
+		MachineType: machineType,
+	}
+
+	res := rpctypes.UpUpgradePrototypeResp{}
+	err := h.Upstream.UpgradePrototype(req, &res)
+	if err != nil {
+		return insolar.Reference{}, errors.Wrap(err, "[ UpgradePrototype ] on calling main API")
+	}
+
+	return res.Code, nil
+}
+
+// Emit saves an event emitted by the current call
+func (h *ProxyHelper) Emit(name string, payload []byte) error {
+	req := rpctypes.UpEmitReq{
+		UpBaseReq: makeUpBaseReq(),
+		Name:      name,
+		Payload:   payload,
+	}
+
+	res := rpctypes.UpEmitResp{}
+	err := h.Upstream.Emit(req, &res)
+	if err != nil {
+		return errors.Wrap(err, "[ Emit ] on calling main API")
+	}
+
+	return nil
+}
+
+// Serialize - CBOR serializer wrapper: `what` -> `to`
+func (h *ProxyHelper) Serialize(what interface{}, to *[]byte) error {
+	ch := new(codec.CborHandle)
+	return codec.NewEncoderBytes(to, ch).Encode(what)
+}
+
+// Deserialize - CBOR de-serializer wrapper: `from` -> `into`
+func (h *ProxyHelper) Deserialize(from []byte, into interface{}) error {
+	ch := new(codec.CborHandle)
+	return codec.NewDecoderBytes(from, ch).Decode(into)
+}
+
+// MakeErrorSerializable converts errors satisfying error interface to foundation.Error
+func (h *ProxyHelper) MakeErrorSerializable(e error) error {
+	if e == nil || e == (*foundation.Error)(nil) || reflect.ValueOf(e).IsNil() {
+		return nil
+	}
+	return &foundation.Error{S: e.Error()}
+}
@@ -102,10 +102,12 @@ func TestBareHelloworld(t *testing.T) {
 	nw := network.GetTestNetwork()
 	scheme := platformpolicy.NewPlatformCryptographyScheme()
 
+	gdp := testutils.NewGenesisDataProviderMock(t)
+
 	cm := &component.Manager{}
 	cm.Register(scheme)
 	cm.Register(l.GetPulseManager(), l.GetArtifactManager(), l.GetJetCoordinator())
-	cm.Inject(db, nk, recent, l, lr, nw, mb, delegationTokenFactory, parcelFactory, mock)
+	cm.Inject(db, nk, recent, l, lr, nw, mb, delegationTokenFactory, parcelFactory, gdp, mock)
 	err = cm.Init(ctx)
 	assert.NoError(t, err)
 	err = cm.Start(ctx)
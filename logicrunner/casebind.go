@@ -36,6 +36,8 @@ type CaseRequest struct {
 	MessageBus insolar.MessageBus
 	Reply      insolar.Reply
 	Error      string
+	// Nonce of execution state before the request, messages sent by the request depend on it
+	Nonce uint64
 }
 
 // CaseBinder is a whole result of executor efforts on every object it seen on this pulse
@@ -108,11 +110,12 @@ func (cb *CaseBind) ToValidateMessage(ctx context.Context, ref Ref, pulse insola
 	return res
 }
 
-func (cb *CaseBind) NewRequest(p insolar.Parcel, request Ref, mb insolar.MessageBus) *CaseRequest {
+func (cb *CaseBind) NewRequest(p insolar.Parcel, request Ref, mb insolar.MessageBus, nonce uint64) *CaseRequest {
 	res := CaseRequest{
 		Parcel:     p,
 		Request:    request,
 		MessageBus: mb,
+		Nonce:      nonce,
 	}
 	cb.Requests = append(cb.Requests, res)
 	return &cb.Requests[len(cb.Requests)-1]
@@ -168,10 +171,13 @@ func (lr *LogicRunner) Validate(ctx context.Context, ref Ref, p insolar.Pulse, c
 		ctx = insolar.ContextWithMessageBus(ctx, request.MessageBus)
 
 		sender := request.Parcel.GetSender()
+		vs.nonce = request.Nonce
 		vs.Current = &CurrentExecution{
 			Context:       ctx,
 			Request:       &request.Request,
 			RequesterNode: &sender,
+			// results are returned to the caller by executor
+			ReturnMode: message.ReturnNoWait,
 		}
 
 		rep, err := func() (insolar.Reply, error) {
@@ -275,8 +281,8 @@ func (vb *ValidationSaver) Mode() string {
 	return "execution"
 }
 
-func (vb *ValidationSaver) NewRequest(p insolar.Parcel, request Ref, mb insolar.MessageBus) {
-	vb.current = vb.caseBind.NewRequest(p, request, mb)
+func (vb *ValidationSaver) NewRequest(p insolar.Parcel, request Ref, mb insolar.MessageBus, nonce uint64) {
+	vb.current = vb.caseBind.NewRequest(p, request, mb, nonce)
 }
 
 func (vb *ValidationSaver) Result(reply insolar.Reply, err error) error {
@@ -314,8 +320,12 @@ func (vb *ValidationChecker) Result(reply insolar.Reply, err error) error {
 	if !reflect.DeepEqual(vb.current.Reply, reply) {
 		return errors.Errorf("replies arn't equal: expected: %+v, got: %+v, err: %+v", vb.current.Reply, reply, err)
 	}
-	if !reflect.DeepEqual(vb.current.Error, err) {
-		return errors.New("errors arn't equal")
+	errstr := ""
+	if err != nil {
+		errstr = err.Error()
+	}
+	if vb.current.Error != errstr {
+		return errors.Errorf("errors arn't equal: expected: %q, got: %q", vb.current.Error, errstr)
 	}
 	return nil
 }
@@ -87,12 +87,35 @@ func (bc *BaseContract) NewChildrenTypedIterator(childPrototype insolar.Referenc
 	return proxyctx.Current.GetObjChildrenIterator(bc.GetReference(), childPrototype, "")
 }
 
+// GetStates returns a page of object states starting from provided one (latest if from is nil), latest first,
+// and id of the state to continue from, nil if there are no more states
+func (bc *BaseContract) GetStates(from *insolar.ID, limit int) ([]proxyctx.ObjectState, *insolar.ID, error) {
+	return proxyctx.Current.GetObjStates(bc.GetReference(), from, limit)
+}
+
 // GetObject create proxy by address
 // unimplemented
 func GetObject(ref insolar.Reference) ProxyInterface {
 	panic("not implemented")
 }
 
+// UpgradePrototype replaces code of the prototype, the new code is in effect starting from the next pulse.
+// Memory of existing objects is converted by Migrate method of the new code on first call.
+func UpgradePrototype(prototype insolar.Reference, code []byte, machineType insolar.MachineType) (insolar.Reference, error) {
+	return proxyctx.Current.UpgradePrototype(prototype, code, machineType)
+}
+
+// Emit emits a structured event, e.g. Emit("Transfer", TransferEvent{From: from, To: to, Amount: amount}).
+// Events are saved alongside the result of the call and can be queried by the emitting object and event name.
+func Emit(name string, payload interface{}) error {
+	var data []byte
+	err := proxyctx.Current.Serialize(payload, &data)
+	if err != nil {
+		return err
+	}
+	return proxyctx.Current.Emit(name, data)
+}
+
 // SelfDestruct contract will be marked as deleted
 func (bc *BaseContract) SelfDestruct() error {
 	return proxyctx.Current.DeactivateObject(bc.GetReference())
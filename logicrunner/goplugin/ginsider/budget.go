@@ -0,0 +1,199 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package ginsider
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tylerb/gls"
+
+	"github.com/insolar/insolar/insolar"
+)
+
+// callBudget tracks resources consumed by a contract call against its execution budget
+type callBudget struct {
+	limits insolar.ExecutionBudget
+
+	lock    sync.Mutex
+	upcalls int
+	err     error
+	// finished is set when the contract returns, abandoned is set when the call is timed out before that
+	finished  bool
+	abandoned bool
+}
+
+func newCallBudget(limits insolar.ExecutionBudget) *callBudget {
+	return &callBudget{limits: limits}
+}
+
+// currentBudget returns budget of the call running in the current goroutine, nil if there is none
+func currentBudget() *callBudget {
+	b, _ := gls.Get("budget").(*callBudget)
+	return b
+}
+
+// exceed marks the budget as exceeded, the first reason wins
+func (b *callBudget) exceed(reason string, args ...interface{}) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.err == nil {
+		b.err = errors.Wrapf(insolar.ErrExecutionBudgetExceeded, reason, args...)
+	}
+	return b.err
+}
+
+// Err returns a reason if the budget was exceeded, nil otherwise
+func (b *callBudget) Err() error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.err
+}
+
+// upcall charges one outgoing call, it fails when the budget is already exceeded,
+// so a contract abandoned after timeout can't make any more calls
+func (b *callBudget) upcall() error {
+	b.lock.Lock()
+	err := b.err
+	b.upcalls++
+	upcalls := b.upcalls
+	b.lock.Unlock()
+
+	if err != nil {
+		return err
+	}
+	if b.limits.MaxUpcalls > 0 && upcalls > b.limits.MaxUpcalls {
+		return b.exceed("more than %d outgoing calls", b.limits.MaxUpcalls)
+	}
+	return nil
+}
+
+// checkState checks size of serialized object state
+func (b *callBudget) checkState(state []byte) error {
+	if b.limits.MaxStateSize > 0 && len(state) > b.limits.MaxStateSize {
+		return b.exceed("state size %d is greater than %d bytes", len(state), b.limits.MaxStateSize)
+	}
+	return nil
+}
+
+// run executes f in a separate goroutine with call context and the budget set, and waits for it
+// no longer than MaxDuration. There is no way to stop a goroutine, so after timeout the contract
+// keeps running, but its result is dropped and all its upcalls fail. Such a call is tracked by runaways
+// until the contract returns, and calls to the same code are refused meanwhile.
+func (b *callBudget) run(
+	ctx context.Context, callCtx *insolar.LogicCallContext, code insolar.Reference, runaways *runaways, f func() error,
+) error {
+	if runaways.running(code) {
+		return b.exceed("previous call of code %s exceeded its budget and is still running", code)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		var err error
+		defer func() { done <- err }()
+		defer b.finish(code, runaways)
+		defer recoverRPC(ctx, &err)
+
+		gls.Set("callCtx", callCtx)
+		gls.Set("budget", b)
+		defer gls.Cleanup()
+
+		err = f()
+	}()
+
+	var timeout <-chan time.Time
+	if b.limits.MaxDuration > 0 {
+		timer := time.NewTimer(b.limits.MaxDuration)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	select {
+	case err := <-done:
+		return err
+	case <-timeout:
+		b.abandon(code, runaways)
+		return b.exceed("call lasts longer than %s", b.limits.MaxDuration)
+	}
+}
+
+// abandon registers the call in runaways unless the contract has already returned
+func (b *callBudget) abandon(code insolar.Reference, runaways *runaways) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if !b.finished {
+		b.abandoned = true
+		runaways.add(code)
+	}
+}
+
+// finish is called when the contract returns, it removes abandoned call from runaways
+func (b *callBudget) finish(code insolar.Reference, runaways *runaways) {
+	b.lock.Lock()
+	b.finished = true
+	abandoned := b.abandoned
+	b.lock.Unlock()
+
+	if abandoned {
+		runaways.remove(code)
+	}
+}
+
+// runaways counts calls which have exceeded their duration budget, but are still running, by code of contracts
+type runaways struct {
+	lock  sync.Mutex
+	codes map[insolar.Reference]int
+}
+
+func newRunaways() *runaways {
+	return &runaways{codes: map[insolar.Reference]int{}}
+}
+
+func (r *runaways) add(code insolar.Reference) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.codes[code]++
+}
+
+func (r *runaways) remove(code insolar.Reference) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.codes[code]--
+	if r.codes[code] <= 0 {
+		delete(r.codes, code)
+	}
+}
+
+// running checks if the code has runaway calls
+func (r *runaways) running(code insolar.Reference) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.codes[code] > 0
+}
+
+// count returns number of all runaway calls
+func (r *runaways) count() int {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	n := 0
+	for _, c := range r.codes {
+		n += c
+	}
+	return n
+}
@@ -0,0 +1,140 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package ginsider
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+)
+
+func TestCallBudget_Duration(t *testing.T) {
+	b := newCallBudget(insolar.ExecutionBudget{MaxDuration: 10 * time.Millisecond})
+	release := make(chan struct{})
+	defer close(release)
+
+	err := b.run(context.Background(), &insolar.LogicCallContext{}, gen.Reference(), newRunaways(), func() error {
+		<-release
+		return nil
+	})
+	require.Error(t, err)
+	assert.Equal(t, insolar.ErrExecutionBudgetExceeded, errors.Cause(err))
+	assert.Equal(t, err, b.Err())
+
+	// abandoned call can't make upcalls
+	assert.Error(t, b.upcall())
+}
+
+func TestCallBudget_Upcalls(t *testing.T) {
+	b := newCallBudget(insolar.ExecutionBudget{MaxUpcalls: 2})
+
+	var upcallErrs []error
+	err := b.run(context.Background(), &insolar.LogicCallContext{}, gen.Reference(), newRunaways(), func() error {
+		assert.Equal(t, b, currentBudget())
+		for i := 0; i < 3; i++ {
+			upcallErrs = append(upcallErrs, currentBudget().upcall())
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.NoError(t, upcallErrs[0])
+	assert.NoError(t, upcallErrs[1])
+	assert.Equal(t, insolar.ErrExecutionBudgetExceeded, errors.Cause(upcallErrs[2]))
+	// contract may ignore the error, the call is failed anyway
+	assert.Equal(t, insolar.ErrExecutionBudgetExceeded, errors.Cause(b.Err()))
+
+	assert.Nil(t, currentBudget())
+}
+
+func TestCallBudget_StateSize(t *testing.T) {
+	b := newCallBudget(insolar.ExecutionBudget{MaxStateSize: 3})
+	assert.NoError(t, b.checkState([]byte{1, 2, 3}))
+	assert.NoError(t, b.Err())
+
+	err := b.checkState([]byte{1, 2, 3, 4})
+	assert.Equal(t, insolar.ErrExecutionBudgetExceeded, errors.Cause(err))
+	assert.Equal(t, err, b.Err())
+}
+
+func TestCallBudget_Unlimited(t *testing.T) {
+	b := newCallBudget(insolar.ExecutionBudget{})
+
+	err := b.run(context.Background(), &insolar.LogicCallContext{}, gen.Reference(), newRunaways(), func() error {
+		for i := 0; i < 100; i++ {
+			if err := b.upcall(); err != nil {
+				return err
+			}
+		}
+		return b.checkState(make([]byte, 1024))
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, b.Err())
+}
+
+func TestCallBudget_Panic(t *testing.T) {
+	b := newCallBudget(insolar.ExecutionBudget{MaxDuration: time.Second})
+
+	err := b.run(context.Background(), &insolar.LogicCallContext{}, gen.Reference(), newRunaways(), func() error {
+		panic("contract panic")
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "contract panic")
+	assert.NoError(t, b.Err())
+}
+
+func TestCallBudget_Runaways(t *testing.T) {
+	runaways := newRunaways()
+	code := gen.Reference()
+	release := make(chan struct{})
+	loop := func() error {
+		<-release
+		return nil
+	}
+
+	goroutines := runtime.NumGoroutine()
+	for i := 0; i < 10; i++ {
+		b := newCallBudget(insolar.ExecutionBudget{MaxDuration: time.Millisecond})
+		err := b.run(context.Background(), &insolar.LogicCallContext{}, code, runaways, loop)
+		assert.Equal(t, insolar.ErrExecutionBudgetExceeded, errors.Cause(err))
+	}
+	// calls are refused while the first one is running, so only one goroutine is leaked
+	assert.Equal(t, 1, runaways.count())
+	assert.True(t, runtime.NumGoroutine() <= goroutines+1)
+
+	// other code isn't affected
+	b := newCallBudget(insolar.ExecutionBudget{MaxDuration: time.Second})
+	err := b.run(context.Background(), &insolar.LogicCallContext{}, gen.Reference(), runaways, func() error { return nil })
+	assert.NoError(t, err)
+
+	close(release)
+	for deadline := time.Now().Add(time.Second); runaways.count() > 0 && time.Now().Before(deadline); {
+		time.Sleep(time.Millisecond)
+	}
+	require.Equal(t, 0, runaways.count())
+
+	b = newCallBudget(insolar.ExecutionBudget{MaxDuration: time.Second})
+	err = b.run(context.Background(), &insolar.LogicCallContext{}, code, runaways, func() error { return nil })
+	assert.NoError(t, err)
+}
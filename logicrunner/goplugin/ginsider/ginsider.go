@@ -58,6 +58,9 @@ type GoInsider struct {
 
 	plugins      map[insolar.Reference]*pluginRec
 	pluginsMutex sync.Mutex
+
+	// runaways tracks calls which exceeded their duration budget, but are still running
+	runaways *runaways
 }
 
 // NewGoInsider creates a new GoInsider instance validating arguments
@@ -65,6 +68,7 @@ func NewGoInsider(path, network, address string) *GoInsider {
 	//TODO: check that path exist, it's a directory and writable
 	res := GoInsider{dir: path, upstreamProtocol: network, upstreamAddress: address}
 	res.plugins = make(map[insolar.Reference]*pluginRec)
+	res.runaways = newRunaways()
 	proxyctx.Current = &res
 	return &res
 }
@@ -96,9 +100,6 @@ func (t *RPC) CallMethod(args rpctypes.DownCallMethodReq, reply *rpctypes.DownCa
 	inslogger.FromContext(ctx).Debugf("Calling method %q on object %q", args.Method, args.Context.Callee)
 	defer recoverRPC(ctx, &err)
 
-	gls.Set("callCtx", args.Context)
-	defer gls.Cleanup()
-
 	p, err := t.GI.Plugin(ctx, args.Code)
 	if err != nil {
 		return errors.Wrapf(err, "Couldn't get plugin by code reference %s", args.Code.String())
@@ -121,6 +122,23 @@ func (t *RPC) CallMethod(args rpctypes.DownCallMethodReq, reply *rpctypes.DownCa
 		}
 	}
 
+	if args.Context.Mode == insolar.ImmutableMode && args.Method != "Migrate" {
+		attr, err := p.Lookup("INSATTR_" + args.Method + "_Immutable")
+		if err != nil {
+			return errors.Wrapf(
+				err, "Calling non immutable method %s in immutable mode (code ref: %s)",
+				args.Method, args.Code.String(),
+			)
+		}
+		immutable, ok := attr.(*bool)
+		if !ok {
+			return errors.Errorf("Immutable attribute for method %s is not boolean", args.Method)
+		}
+		if !*immutable {
+			return errors.Errorf("Calling non immutable method %s in immutable mode", args.Method)
+		}
+	}
+
 	symbol, err := p.Lookup("INSMETHOD_" + args.Method)
 	if err != nil {
 		return errors.Wrapf(
@@ -134,8 +152,21 @@ func (t *RPC) CallMethod(args rpctypes.DownCallMethodReq, reply *rpctypes.DownCa
 		return errors.New("Wrapper with wrong signature")
 	}
 
-	state, result, err := wrapper(args.Data, args.Arguments) // may be entire args???
-
+	budget := newCallBudget(args.Context.Budget)
+	var state, result []byte
+	err = budget.run(ctx, args.Context, args.Code, t.GI.runaways, func() error {
+		var err error
+		state, result, err = wrapper(args.Data, args.Arguments) // may be entire args???
+		if err != nil {
+			return err
+		}
+		return budget.checkState(state)
+	})
+	if budgetErr := budget.Err(); budgetErr != nil {
+		inslogger.FromContext(ctx).Warnf("Method %q on object %q aborted: %s", args.Method, args.Context.Callee, budgetErr)
+		reply.BudgetExceeded = true
+		return nil
+	}
 	if err != nil {
 		return errors.Wrapf(err, "Method call returned error")
 	}
@@ -155,9 +186,6 @@ func (t *RPC) CallConstructor(args rpctypes.DownCallConstructorReq, reply *rpcty
 	inslogger.FromContext(ctx).Debugf("Calling constructor %q in code %q", args.Name, args.Code)
 	defer recoverRPC(ctx, &err)
 
-	gls.Set("callCtx", args.Context)
-	defer gls.Cleanup()
-
 	p, err := t.GI.Plugin(ctx, args.Code)
 	if err != nil {
 		return err
@@ -173,7 +201,21 @@ func (t *RPC) CallConstructor(args rpctypes.DownCallConstructorReq, reply *rpcty
 		return errors.New("Wrapper with wrong signature")
 	}
 
-	resValues, err := f(args.Arguments)
+	budget := newCallBudget(args.Context.Budget)
+	var resValues []byte
+	err = budget.run(ctx, args.Context, args.Code, t.GI.runaways, func() error {
+		var err error
+		resValues, err = f(args.Arguments)
+		if err != nil {
+			return err
+		}
+		return budget.checkState(resValues)
+	})
+	if budgetErr := budget.Err(); budgetErr != nil {
+		inslogger.FromContext(ctx).Warnf("Constructor %q in code %q aborted: %s", args.Name, args.Code, budgetErr)
+		reply.BudgetExceeded = true
+		return nil
+	}
 	if err != nil {
 		return errors.Wrapf(err, "Can't call constructor %s", args.Name)
 	}
@@ -299,6 +341,12 @@ func MakeUpBaseReq() rpctypes.UpBaseReq {
 
 // RouteCall ...
 func (gi *GoInsider) RouteCall(ref insolar.Reference, wait bool, method string, args []byte, proxyPrototype insolar.Reference) ([]byte, error) {
+	if budget := currentBudget(); budget != nil {
+		if err := budget.upcall(); err != nil {
+			return nil, errors.Wrap(err, "[ RouteCall ]")
+		}
+	}
+
 	client, err := gi.Upstream()
 	if err != nil {
 		return nil, err
@@ -327,6 +375,12 @@ func (gi *GoInsider) RouteCall(ref insolar.Reference, wait bool, method string,
 
 // SaveAsChild ...
 func (gi *GoInsider) SaveAsChild(parentRef, classRef insolar.Reference, constructorName string, argsSerialized []byte) (insolar.Reference, error) {
+	if budget := currentBudget(); budget != nil {
+		if err := budget.upcall(); err != nil {
+			return insolar.Reference{}, errors.Wrap(err, "[ SaveAsChild ]")
+		}
+	}
+
 	client, err := gi.Upstream()
 	if err != nil {
 		return insolar.Reference{}, err
@@ -388,6 +442,42 @@ func (gi *GoInsider) GetObjChildrenIterator(obj insolar.Reference, prototype ins
 	}, nil
 }
 
+// GetObjStates rpc call to insolard service, returns a page of object states starting from provided
+// one (latest if from is nil), latest first, and id of the state to continue from
+func (gi *GoInsider) GetObjStates(obj insolar.Reference, from *insolar.ID, limit int) ([]proxyctx.ObjectState, *insolar.ID, error) {
+	client, err := gi.Upstream()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res := rpctypes.UpGetObjStatesResp{}
+	req := rpctypes.UpGetObjStatesReq{
+		UpBaseReq: MakeUpBaseReq(),
+
+		Obj:   obj,
+		From:  from,
+		Limit: limit,
+	}
+	err = client.Call("RPC.GetObjStates", req, &res)
+	if err != nil {
+		if err == rpc.ErrShutdown {
+			log.Error("Insgorund can't connect to Insolard")
+			os.Exit(0)
+		}
+		return nil, nil, errors.Wrap(err, "[ GetObjStates ] on calling main API")
+	}
+
+	states := make([]proxyctx.ObjectState, 0, len(res.States))
+	for _, s := range res.States {
+		states = append(states, proxyctx.ObjectState{
+			State:   s.State,
+			Request: s.Request,
+			Memory:  s.Memory,
+		})
+	}
+	return states, res.Next, nil
+}
+
 // SaveAsDelegate ...
 func (gi *GoInsider) SaveAsDelegate(intoRef, classRef insolar.Reference, constructorName string, argsSerialized []byte) (insolar.Reference, error) {
 	client, err := gi.Upstream()
@@ -466,6 +556,60 @@ func (gi *GoInsider) DeactivateObject(object insolar.Reference) error {
 	return nil
 }
 
+// UpgradePrototype rpc call to insolard service, deploys new code of the prototype
+// and returns reference to the code
+func (gi *GoInsider) UpgradePrototype(prototype insolar.Reference, code []byte, machineType insolar.MachineType) (insolar.Reference, error) {
+	client, err := gi.Upstream()
+	if err != nil {
+		return insolar.Reference{}, err
+	}
+
+	req := rpctypes.UpUpgradePrototypeReq{
+		UpBaseReq:   MakeUpBaseReq(),
+		Prototype:   prototype,
+		Code:        code,
+		MachineType: machineType,
+	}
+
+	res := rpctypes.UpUpgradePrototypeResp{}
+	err = client.Call("RPC.UpgradePrototype", req, &res)
+	if err != nil {
+		if err == rpc.ErrShutdown {
+			log.Error("Insgorund can't connect to Insolard")
+			os.Exit(0)
+		}
+		return insolar.Reference{}, errors.Wrap(err, "[ UpgradePrototype ] on calling main API")
+	}
+
+	return res.Code, nil
+}
+
+// Emit rpc call to insolard service, saves an event emitted by the current call
+func (gi *GoInsider) Emit(name string, payload []byte) error {
+	client, err := gi.Upstream()
+	if err != nil {
+		return err
+	}
+
+	req := rpctypes.UpEmitReq{
+		UpBaseReq: MakeUpBaseReq(),
+		Name:      name,
+		Payload:   payload,
+	}
+
+	res := rpctypes.UpEmitResp{}
+	err = client.Call("RPC.Emit", req, &res)
+	if err != nil {
+		if err == rpc.ErrShutdown {
+			log.Error("Insgorund can't connect to Insolard")
+			os.Exit(0)
+		}
+		return errors.Wrap(err, "[ Emit ] on calling main API")
+	}
+
+	return nil
+}
+
 // Serialize - CBOR serializer wrapper: `what` -> `to`
 func (gi *GoInsider) Serialize(what interface{}, to *[]byte) error {
 	ch := new(codec.CborHandle)
@@ -181,6 +181,9 @@ func (gp *GoPlugin) CallMethod(
 		if callResult.Error != nil {
 			return nil, nil, errors.Wrap(callResult.Error, "problem with API call")
 		}
+		if callResult.Response.BudgetExceeded {
+			return nil, nil, insolar.ErrExecutionBudgetExceeded
+		}
 		return callResult.Response.Data, callResult.Response.Ret, nil
 	case <-time.After(timeout):
 		return nil, nil, errors.New("logicrunner execution timeout")
@@ -222,6 +225,9 @@ func (gp *GoPlugin) CallConstructor(
 		if callResult.Error != nil {
 			return nil, errors.Wrap(callResult.Error, "problem with API call")
 		}
+		if callResult.Response.BudgetExceeded {
+			return nil, insolar.ErrExecutionBudgetExceeded
+		}
 		return callResult.Response.Ret, nil
 	case <-time.After(timeout):
 		return nil, errors.New("logicrunner execution timeout")
@@ -28,8 +28,10 @@ import (
 
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/ledger/storage/drop"
 	"github.com/insolar/insolar/log"
 	"github.com/insolar/insolar/logicrunner/artifacts"
+	"github.com/insolar/insolar/network/merkle"
 	"github.com/insolar/insolar/testutils"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
@@ -85,6 +87,8 @@ type TestObjectDescriptor struct {
 	ARef              *insolar.Reference
 	Data              []byte
 	State             *insolar.ID
+	PrevState         *insolar.ID
+	RequestRef        *insolar.Reference
 	PrototypeRef      *insolar.Reference
 	Delegates         map[insolar.Reference]insolar.Reference
 	ChildrenContainer []insolar.Reference
@@ -114,6 +118,16 @@ func (t *TestObjectDescriptor) StateID() *insolar.ID {
 	return t.State
 }
 
+// PrevStateID implementation for tests
+func (t *TestObjectDescriptor) PrevStateID() *insolar.ID {
+	return t.PrevState
+}
+
+// Request implementation for tests
+func (t *TestObjectDescriptor) Request() *insolar.Reference {
+	return t.RequestRef
+}
+
 // Memory implementation for tests
 func (t *TestObjectDescriptor) Memory() []byte {
 	return t.Data
@@ -161,6 +175,22 @@ func (t *TestArtifactManager) HasPendingRequests(ctx context.Context, object ins
 	panic("implement me")
 }
 
+func (t *TestArtifactManager) GetRequestResult(ctx context.Context, request insolar.Reference) (*insolar.ID, []byte, error) {
+	panic("implement me")
+}
+
+func (t *TestArtifactManager) GetEvents(
+	ctx context.Context, object insolar.Reference, name string, from, to insolar.PulseNumber,
+) ([]insolar.EmittedEvent, error) {
+	panic("implement me")
+}
+
+func (t *TestArtifactManager) GetRecordProof(
+	ctx context.Context, id insolar.ID,
+) (*drop.Drop, *merkle.InclusionProof, error) {
+	panic("implement me")
+}
+
 // State implementation for tests
 func (t *TestArtifactManager) State() ([]byte, error) {
 	panic("implement me")
@@ -196,6 +226,13 @@ func (t *TestArtifactManager) RegisterResult(
 	panic("implement me")
 }
 
+// RegisterResultWithEvents saves VM method call result and events emitted by the call.
+func (t *TestArtifactManager) RegisterResultWithEvents(
+	ctx context.Context, object, request insolar.Reference, payload []byte, events []insolar.Event,
+) (*insolar.ID, error) {
+	panic("implement me")
+}
+
 // GetObject implementation for tests
 func (t *TestArtifactManager) GetObject(ctx context.Context, object insolar.Reference, state *insolar.ID, approved bool) (artifacts.ObjectDescriptor, error) {
 	res, ok := t.Objects[object]
@@ -260,6 +297,7 @@ func (t *TestArtifactManager) ActivatePrototype(
 		ARef:         &request,
 		Data:         memory,
 		State:        &id,
+		RequestRef:   &request,
 		PrototypeRef: &code,
 		Delegates:    make(map[insolar.Reference]insolar.Reference),
 	}
@@ -281,6 +319,7 @@ func (t *TestArtifactManager) ActivateObject(
 		ARef:         &request,
 		Data:         memory,
 		State:        &id,
+		RequestRef:   &request,
 		PrototypeRef: &prototype,
 		Delegates:    make(map[insolar.Reference]insolar.Reference),
 	}
@@ -337,7 +376,11 @@ func (t *TestArtifactManager) UpdateObject(
 		return nil, errors.New("No object to update")
 	}
 
+	id := testutils.RandomID()
 	objDesc.Data = memory
+	objDesc.PrevState = objDesc.State
+	objDesc.State = &id
+	objDesc.RequestRef = &request
 
 	// TODO: return real exact "ref"
 	return objDesc, nil
@@ -417,6 +460,8 @@ type ContractsBuilder struct {
 	IccPath         string
 	Prototypes      map[string]*insolar.Reference
 	Codes           map[string]*insolar.Reference
+	// SandboxAllow is a list of packages and functions contracts can use in addition to default sandbox rules
+	SandboxAllow []string
 }
 
 // NewContractBuilder returns a new `ContractsBuilder`, takes in: path to tmp directory,
@@ -542,12 +587,12 @@ func (cb *ContractsBuilder) proxy(name string) error {
 
 	contractPath := filepath.Join(cb.root, "src/contract", name, "main.go")
 
-	out, err := exec.Command(
-		cb.IccPath, "proxy",
+	args := append([]string{
+		"proxy",
 		"-o", filepath.Join(dstDir, "main.go"),
 		"--code-reference", cb.Prototypes[name].String(),
-		contractPath,
-	).CombinedOutput()
+	}, cb.sandboxFlags()...)
+	out, err := exec.Command(cb.IccPath, append(args, contractPath)...).CombinedOutput()
 	if err != nil {
 		return errors.Wrap(err, "can't generate proxy: "+string(out))
 	}
@@ -558,13 +603,22 @@ func (cb *ContractsBuilder) wrapper(name string) error {
 	contractPath := filepath.Join(cb.root, "src/contract", name, "main.go")
 	wrapperPath := filepath.Join(cb.root, "src/contract", name, "main_wrapper.go")
 
-	out, err := exec.Command(cb.IccPath, "wrapper", "-o", wrapperPath, contractPath).CombinedOutput()
+	args := append([]string{"wrapper", "-o", wrapperPath}, cb.sandboxFlags()...)
+	out, err := exec.Command(cb.IccPath, append(args, contractPath)...).CombinedOutput()
 	if err != nil {
 		return errors.Wrap(err, "can't generate wrapper for contract '"+name+"': "+string(out))
 	}
 	return nil
 }
 
+func (cb *ContractsBuilder) sandboxFlags() []string {
+	var flags []string
+	for _, allow := range cb.SandboxAllow {
+		flags = append(flags, "--allow", allow)
+	}
+	return flags
+}
+
 // Plugin ...
 func (cb *ContractsBuilder) plugin(name string) error {
 	dstDir := filepath.Join(cb.root, "plugins")
@@ -47,6 +47,12 @@ var foundationPath = "github.com/insolar/insolar/logicrunner/goplugin/foundation
 var proxyctxPath = "github.com/insolar/insolar/logicrunner/goplugin/proxyctx"
 var corePath = "github.com/insolar/insolar/insolar"
 
+var apiAttrRegexp = regexp.MustCompile("^INSATTR_([A-Za-z0-9_]+)_API$")
+var immutableAttrRegexp = regexp.MustCompile("^INSATTR_([A-Za-z0-9_]+)_Immutable$")
+
+// migrateMethod converts memory of an object written by previous code of its prototype
+const migrateMethod = "Migrate"
+
 // ParsedFile struct with prepared info we extract from source code
 type ParsedFile struct {
 	name    string
@@ -57,12 +63,19 @@ type ParsedFile struct {
 	types        map[string]*ast.TypeSpec
 	methods      map[string][]*ast.FuncDecl
 	constructors map[string][]*ast.FuncDecl
+	migrations   map[string]bool
 	contract     string
 }
 
 // ParseFile parses a file as Go source code of a smart contract
-// and returns it as `ParsedFile`
+// and returns it as `ParsedFile`, code is checked against rules of default sandbox
 func ParseFile(fileName string) (*ParsedFile, error) {
+	return ParseFileWithSandbox(fileName, DefaultSandbox())
+}
+
+// ParseFileWithSandbox parses a file as Go source code of a smart contract
+// and checks it against rules of the sandbox, nil sandbox disables checks
+func ParseFileWithSandbox(fileName string, sandbox *Sandbox) (*ParsedFile, error) {
 	res := &ParsedFile{
 		name: fileName,
 	}
@@ -92,6 +105,18 @@ func ParseFile(fileName string) (*ParsedFile, error) {
 		return nil, errors.New("Only one smart contract must exist")
 	}
 
+	err = res.checkImmutableMethods()
+	if err != nil {
+		return nil, err
+	}
+
+	if sandbox != nil {
+		err = res.CheckSandbox(sandbox)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return res, nil
 }
 
@@ -132,6 +157,7 @@ func (pf *ParsedFile) parseTypeSpec(typeSpec *ast.TypeSpec) error {
 func (pf *ParsedFile) parseFunctionsAndMethods() error {
 	pf.methods = make(map[string][]*ast.FuncDecl)
 	pf.constructors = make(map[string][]*ast.FuncDecl)
+	pf.migrations = make(map[string]bool)
 	for _, decl := range pf.node.Decls {
 		fd, ok := decl.(*ast.FuncDecl)
 		if !ok || !fd.Name.IsExported() {
@@ -191,11 +217,24 @@ func (pf *ParsedFile) parseMethod(fd *ast.FuncDecl) error {
 	}
 
 	typename := pf.typeName(fd.Recv.List[0].Type)
+	if name == migrateMethod {
+		return pf.parseMigrate(typename, fd)
+	}
 	pf.methods[typename] = append(pf.methods[typename], fd)
 
 	return nil
 }
 
+// parseMigrate checks signature of Migrate method, it's called by logicrunner only, so it's not a part of proxy
+func (pf *ParsedFile) parseMigrate(typename string, fd *ast.FuncDecl) error {
+	params := fd.Type.Params
+	if params.NumFields() != 1 || pf.codeOfNode(params.List[0].Type) != "[]byte" || fd.Type.Results.NumFields() != 1 {
+		return errors.Errorf("Method %q should have signature 'Migrate(oldState []byte) error'", migrateMethod)
+	}
+	pf.migrations[typename] = true
+	return nil
+}
+
 // ProxyPackageName guesses user friendly contract "name" from file name
 // and/or package in the file
 func (pf *ParsedFile) ProxyPackageName() (string, error) {
@@ -224,21 +263,57 @@ func (pf *ParsedFile) ContractName() string {
 // WriteWrapper generates and writes into `out` source code
 // of wrapper for the contract
 func (pf *ParsedFile) WriteWrapper(out io.Writer) error {
-	packageName := pf.node.Name.Name
+	return pf.writeWrapper(out, "main", false)
+}
+
+// WriteBuiltinWrapper generates and writes into `out` source code of wrapper
+// that allows to register the contract in builtin machine type, wrapper
+// belongs to the package of the contract
+func (pf *ParsedFile) WriteBuiltinWrapper(out io.Writer) error {
+	var buff bytes.Buffer
+
+	err := pf.writeWrapper(&buff, pf.node.Name.Name, true)
+	if err != nil {
+		return err
+	}
+
+	fmtOut, err := format.Source(buff.Bytes())
+	if err != nil {
+		return errors.Wrap(err, "couldn't format code")
+	}
+
+	_, err = out.Write(fmtOut)
+	if err != nil {
+		return errors.Wrap(err, "couldn't write code to output")
+	}
+
+	return nil
+}
 
+func (pf *ParsedFile) writeWrapper(out io.Writer, packageName string, builtin bool) error {
 	tmpl, err := openTemplate("templates/wrapper.go.tpl")
 	if err != nil {
 		return errors.Wrap(err, "couldn't open template file for wrapper")
 	}
 
+	imports := pf.generateImports(true)
+	if builtin {
+		imports[fmt.Sprintf(`"%s"`, corePath)] = true
+	}
+
 	data := map[string]interface{}{
-		"PackageName":    packageName,
+		"Package":        packageName,
+		"PackageName":    pf.node.Name.Name,
 		"ContractType":   pf.contract,
 		"Methods":        pf.functionInfoForWrapper(pf.methods[pf.contract]),
 		"Functions":      pf.functionInfoForWrapper(pf.constructors[pf.contract]),
 		"ParsedCode":     pf.code,
 		"FoundationPath": foundationPath,
-		"Imports":        pf.generateImports(true),
+		"Imports":        imports,
+		"Builtin":        builtin,
+		"API":            pf.apiMethods(),
+		"Immutable":      pf.immutableMethods(),
+		"Migrate":        pf.migrations[pf.contract],
 	}
 	err = tmpl.Execute(out, data)
 	if err != nil {
@@ -248,6 +323,120 @@ func (pf *ParsedFile) WriteWrapper(out io.Writer) error {
 	return nil
 }
 
+// apiMethods returns names of methods marked with INSATTR_<method>_API variables
+func (pf *ParsedFile) apiMethods() []string {
+	return pf.attrMethods(apiAttrRegexp)
+}
+
+// immutableMethods returns names of methods marked with INSATTR_<method>_Immutable variables, such methods
+// don't change state and can be called without registration in ledger
+func (pf *ParsedFile) immutableMethods() []string {
+	return pf.attrMethods(immutableAttrRegexp)
+}
+
+// checkImmutableMethods checks that immutable attributes are set for methods of the contract
+func (pf *ParsedFile) checkImmutableMethods() error {
+	methods := make(map[string]bool)
+	for _, fd := range pf.methods[pf.contract] {
+		methods[fd.Name.Name] = true
+	}
+	for _, name := range pf.immutableMethods() {
+		if !methods[name] {
+			return errors.Errorf("INSATTR_%s_Immutable is set for unknown method %q", name, name)
+		}
+	}
+	return nil
+}
+
+// attrMethods returns names of methods captured by re from names of package level variables
+func (pf *ParsedFile) attrMethods(re *regexp.Regexp) []string {
+	var res []string
+	for _, decl := range pf.node.Decls {
+		vDecl, ok := decl.(*ast.GenDecl)
+		if !ok || vDecl.Tok != token.VAR {
+			continue
+		}
+
+		for _, spec := range vDecl.Specs {
+			for _, name := range spec.(*ast.ValueSpec).Names {
+				match := re.FindStringSubmatch(name.Name)
+				if match != nil {
+					res = append(res, match[1])
+				}
+			}
+		}
+	}
+	return res
+}
+
+// CheckUpgrade checks that the contract can replace code of the previous one, every public method
+// of the previous contract should be kept with the same signature
+func (pf *ParsedFile) CheckUpgrade(previous *ParsedFile) error {
+	methods := make(map[string]string)
+	for _, fd := range pf.methods[pf.contract] {
+		methods[fd.Name.Name] = pf.signature(fd)
+	}
+	api := make(map[string]bool)
+	for _, name := range pf.apiMethods() {
+		api[name] = true
+	}
+	immutable := make(map[string]bool)
+	for _, name := range pf.immutableMethods() {
+		immutable[name] = true
+	}
+
+	var problems []string
+	for _, fd := range previous.methods[previous.contract] {
+		name := fd.Name.Name
+		signature, ok := methods[name]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("method %s is removed", name))
+			continue
+		}
+		if previousSignature := previous.signature(fd); signature != previousSignature {
+			problems = append(problems, fmt.Sprintf(
+				"signature of method %s is changed from %q to %q", name, previousSignature, signature,
+			))
+		}
+	}
+	for _, name := range previous.apiMethods() {
+		if _, ok := methods[name]; ok && !api[name] {
+			problems = append(problems, fmt.Sprintf("method %s is not available through API anymore", name))
+		}
+	}
+	for _, name := range previous.immutableMethods() {
+		if _, ok := methods[name]; ok && !immutable[name] {
+			problems = append(problems, fmt.Sprintf("method %s is not immutable anymore", name))
+		}
+	}
+
+	if len(problems) > 0 {
+		return errors.New("incompatible upgrade: " + strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// signature returns types of parameters and results of a function, e.g. "(string, int) (bool, error)"
+func (pf *ParsedFile) signature(fd *ast.FuncDecl) string {
+	return "(" + strings.Join(pf.fieldTypes(fd.Type.Params), ", ") + ") (" +
+		strings.Join(pf.fieldTypes(fd.Type.Results), ", ") + ")"
+}
+
+func (pf *ParsedFile) fieldTypes(list *ast.FieldList) []string {
+	var res []string
+	if list == nil {
+		return res
+	}
+	for _, field := range list.List {
+		t := pf.codeOfNode(field.Type)
+		res = append(res, t)
+		for i := 1; i < len(field.Names); i++ {
+			res = append(res, t)
+		}
+	}
+	return res
+}
+
 func (pf *ParsedFile) functionInfoForWrapper(list []*ast.FuncDecl) []map[string]interface{} {
 	var res []map[string]interface{}
 	for _, fun := range list {
@@ -37,6 +37,13 @@ type PreprocessorSuite struct {
 	suite.Suite
 }
 
+// testSandbox allows imports of fake packages used in tests
+func testSandbox() *Sandbox {
+	sandbox := DefaultSandbox()
+	sandbox.Allow("some/test/import/...")
+	return sandbox
+}
+
 var randomTestCode = `
 package main
 
@@ -445,7 +452,7 @@ func ( A ) GetPointer(i *pointerPath.SomeType) error {
 `)
 	s.NoError(err)
 
-	parsed, err := ParseFile(tmpDir + testContract)
+	parsed, err := ParseFileWithSandbox(tmpDir+testContract, testSandbox())
 	s.NoError(err)
 
 	var bufProxy bytes.Buffer
@@ -488,7 +495,7 @@ func ( A ) Get(i someAlias.SomeType) error {
 `)
 	s.NoError(err)
 
-	parsed, err := ParseFile(tmpDir + testContract)
+	parsed, err := ParseFileWithSandbox(tmpDir+testContract, testSandbox())
 	s.NoError(err)
 
 	var bufProxy bytes.Buffer
@@ -531,7 +538,7 @@ func ( A ) Get() error {
 `)
 	s.NoError(err)
 
-	parsed, err := ParseFile(tmpDir + testContract)
+	parsed, err := ParseFileWithSandbox(tmpDir+testContract, testSandbox())
 	s.NoError(err)
 
 	var bufProxy bytes.Buffer
@@ -572,7 +579,7 @@ func ( A ) Get() (path.SomeValue, error) {
 `)
 	s.NoError(err)
 
-	parsed, err := ParseFile(tmpDir + testContract)
+	parsed, err := ParseFileWithSandbox(tmpDir+testContract, testSandbox())
 	s.NoError(err)
 
 	var bufProxy bytes.Buffer
@@ -653,6 +660,402 @@ func (s *PreprocessorSuite) TestProxyGeneration() {
 	}
 }
 
+func (s *PreprocessorSuite) TestBuiltinWrapperGeneration() {
+	contracts, err := GetRealContractsNames()
+	s.Require().NoError(err)
+
+	contractDir, err := GetRealApplicationDir("contract")
+	s.Require().NoError(err)
+
+	for _, contract := range contracts {
+		// Make a copy for proper work of closure inside gorutine
+		contract := contract
+
+		s.T().Run(contract, func(t *testing.T) {
+			t.Parallel()
+			a, r := assert.New(t), require.New(t)
+
+			parsed, err := ParseFile(path.Join(contractDir, contract, contract+".go"))
+			r.NoError(err)
+
+			wrapper := path.Join(contractDir, contract, contract+".wrapper.go")
+			_, err = os.Stat(wrapper)
+			a.NoError(err)
+
+			buff := bytes.NewBufferString("")
+			err = parsed.WriteBuiltinWrapper(buff)
+			r.NoError(err)
+
+			cmd := exec.Command("diff", "-u", wrapper, "-")
+			cmd.Stdin = buff
+			out, err := cmd.CombinedOutput()
+			a.NoError(err, string(out))
+		})
+	}
+}
+
+func (s *PreprocessorSuite) TestBuiltinWrapperAPIMethods() {
+	tmpDir, err := ioutil.TempDir("", "test-")
+	s.NoError(err)
+	defer os.RemoveAll(tmpDir) //nolint: errcheck
+
+	testContract := "/test.go"
+
+	err = goplugintestutils.WriteFile(tmpDir, testContract, `
+package test
+
+type A struct{
+	foundation.BaseContract
+}
+
+var INSATTR_Get_API = true
+
+func (a *A) Get() (int, error) {
+	return 0, nil
+}
+
+func (a *A) Set(i int) error {
+	return nil
+}
+`)
+	s.NoError(err)
+
+	parsed, err := ParseFile(tmpDir + testContract)
+	s.NoError(err)
+
+	var bufWrapper bytes.Buffer
+	err = parsed.WriteBuiltinWrapper(&bufWrapper)
+	s.NoError(err)
+	s.Contains(bufWrapper.String(), "package test")
+	s.Contains(bufWrapper.String(), `"Set":          INSMETHOD_Set,`)
+	s.Contains(bufWrapper.String(), `"Get": INSATTR_Get_API,`)
+	s.NotContains(bufWrapper.String(), "INSATTR_Set_API")
+}
+
+func (s *PreprocessorSuite) TestBuiltinWrapperImmutableMethods() {
+	tmpDir, err := ioutil.TempDir("", "test-")
+	s.NoError(err)
+	defer os.RemoveAll(tmpDir) //nolint: errcheck
+
+	testContract := "/test.go"
+
+	err = goplugintestutils.WriteFile(tmpDir, testContract, `
+package test
+
+type A struct{
+	foundation.BaseContract
+}
+
+var INSATTR_Get_Immutable = true
+
+func (a *A) Get() (int, error) {
+	return 0, nil
+}
+
+func (a *A) Set(i int) error {
+	return nil
+}
+`)
+	s.NoError(err)
+
+	parsed, err := ParseFile(tmpDir + testContract)
+	s.NoError(err)
+
+	var bufWrapper bytes.Buffer
+	err = parsed.WriteBuiltinWrapper(&bufWrapper)
+	s.NoError(err)
+	s.Contains(bufWrapper.String(), `"Get": INSATTR_Get_Immutable,`)
+	s.NotContains(bufWrapper.String(), "INSATTR_Set_Immutable")
+}
+
+func (s *PreprocessorSuite) TestImmutableAttributeOfUnknownMethod() {
+	tmpDir, err := ioutil.TempDir("", "test-")
+	s.NoError(err)
+	defer os.RemoveAll(tmpDir) //nolint: errcheck
+
+	testContract := "/test.go"
+
+	err = goplugintestutils.WriteFile(tmpDir, testContract, `
+package test
+
+type A struct{
+	foundation.BaseContract
+}
+
+var INSATTR_Migrate_Immutable = true
+
+func (a *A) Migrate(oldState []byte) error {
+	return nil
+}
+`)
+	s.NoError(err)
+
+	_, err = ParseFile(tmpDir + testContract)
+	s.Error(err)
+	s.Contains(err.Error(), `INSATTR_Migrate_Immutable is set for unknown method "Migrate"`)
+}
+
+func (s *PreprocessorSuite) TestMigrateMethod() {
+	tmpDir, err := ioutil.TempDir("", "test-")
+	s.NoError(err)
+	defer os.RemoveAll(tmpDir) //nolint: errcheck
+
+	testContract := "/test.go"
+
+	err = goplugintestutils.WriteFile(tmpDir, testContract, `
+package test
+
+type A struct{
+	foundation.BaseContract
+}
+
+func (a *A) Get() (int, error) {
+	return 0, nil
+}
+
+func (a *A) Migrate(oldState []byte) error {
+	return nil
+}
+`)
+	s.NoError(err)
+
+	parsed, err := ParseFile(tmpDir + testContract)
+	s.NoError(err)
+
+	var bufProxy bytes.Buffer
+	err = parsed.WriteProxy(testutils.RandomRef().String(), &bufProxy)
+	s.NoError(err)
+	s.NotContains(bufProxy.String(), "Migrate")
+
+	var bufWrapper bytes.Buffer
+	err = parsed.WriteBuiltinWrapper(&bufWrapper)
+	s.NoError(err)
+	s.Contains(bufWrapper.String(), "err := self.Migrate(object)")
+	s.Contains(bufWrapper.String(), `"Migrate":      INSMETHOD_Migrate,`)
+}
+
+func (s *PreprocessorSuite) TestMigrateMethodBadSignature() {
+	tmpDir, err := ioutil.TempDir("", "test-")
+	s.NoError(err)
+	defer os.RemoveAll(tmpDir) //nolint: errcheck
+
+	testContract := "/test.go"
+
+	err = goplugintestutils.WriteFile(tmpDir, testContract, `
+package test
+
+type A struct{
+	foundation.BaseContract
+}
+
+func (a *A) Migrate(oldState string) error {
+	return nil
+}
+`)
+	s.NoError(err)
+
+	_, err = ParseFile(tmpDir + testContract)
+	s.Error(err)
+	s.Contains(err.Error(), "Migrate(oldState []byte) error")
+}
+
+func (s *PreprocessorSuite) TestCheckUpgrade() {
+	tmpDir, err := ioutil.TempDir("", "test-")
+	s.NoError(err)
+	defer os.RemoveAll(tmpDir) //nolint: errcheck
+
+	err = goplugintestutils.WriteFile(tmpDir, "/previous.go", `
+package test
+
+type A struct{
+	foundation.BaseContract
+}
+
+var INSATTR_Get_API = true
+var INSATTR_Get_Immutable = true
+
+func (a *A) Get() (int, error) {
+	return 0, nil
+}
+
+func (a *A) Set(i, j int) error {
+	return nil
+}
+
+func (a *A) Drop() error {
+	return nil
+}
+`)
+	s.NoError(err)
+	previous, err := ParseFile(tmpDir + "/previous.go")
+	s.NoError(err)
+
+	err = goplugintestutils.WriteFile(tmpDir, "/compatible.go", `
+package test
+
+type A struct{
+	foundation.BaseContract
+	Value int
+}
+
+var INSATTR_Get_API = true
+var INSATTR_Get_Immutable = true
+
+func (a *A) Get() (value int, err error) {
+	return a.Value, nil
+}
+
+func (a *A) Set(i int, j int) error {
+	return nil
+}
+
+func (a *A) Drop() error {
+	return nil
+}
+
+func (a *A) Reset() error {
+	return nil
+}
+`)
+	s.NoError(err)
+	compatible, err := ParseFile(tmpDir + "/compatible.go")
+	s.NoError(err)
+	s.NoError(compatible.CheckUpgrade(previous))
+
+	err = goplugintestutils.WriteFile(tmpDir, "/incompatible.go", `
+package test
+
+type A struct{
+	foundation.BaseContract
+}
+
+func (a *A) Get() (int, error) {
+	return 0, nil
+}
+
+func (a *A) Set(i string) error {
+	return nil
+}
+`)
+	s.NoError(err)
+	incompatible, err := ParseFile(tmpDir + "/incompatible.go")
+	s.NoError(err)
+
+	err = incompatible.CheckUpgrade(previous)
+	s.Error(err)
+	s.Contains(err.Error(), `signature of method Set is changed from "(int, int) (error)" to "(string) (error)"`)
+	s.Contains(err.Error(), "method Drop is removed")
+	s.Contains(err.Error(), "method Get is not available through API anymore")
+	s.Contains(err.Error(), "method Get is not immutable anymore")
+}
+
+func (s *PreprocessorSuite) TestSandbox() {
+	tmpDir, err := ioutil.TempDir("", "test-")
+	s.NoError(err)
+	defer os.RemoveAll(tmpDir) // nolint: errcheck
+
+	err = goplugintestutils.WriteFile(tmpDir, "/test.go", `
+package main
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/insolar/insolar/logicrunner/goplugin/foundation"
+)
+
+var counter int
+
+type A struct {
+	foundation.BaseContract
+	Balances map[string]int
+}
+
+func (a *A) Get() (int, error) {
+	go func() {}()
+	counter++
+	sum := 0
+	for _, balance := range a.Balances {
+		sum += balance
+	}
+	return sum + rand.Intn(time.Now().Second()), nil
+}
+`)
+	s.NoError(err)
+
+	_, err = ParseFile(tmpDir + "/test.go")
+	s.Require().Error(err)
+	sandboxErr, ok := err.(*SandboxError)
+	s.Require().True(ok, "error should be SandboxError, got %T", err)
+
+	var lines []int
+	for _, d := range sandboxErr.Diagnostics {
+		s.Equal(tmpDir+"/test.go", d.Pos.Filename)
+		lines = append(lines, d.Pos.Line)
+	}
+	s.Equal([]int{5, 19, 20, 22, 25}, lines)
+	s.Contains(err.Error(), "/test.go:5:2: import of \"math/rand\" isn't allowed")
+	s.Contains(err.Error(), "/test.go:19:2: goroutines aren't allowed")
+	s.Contains(err.Error(), "/test.go:20:2: package level variable counter can't be changed")
+	s.Contains(err.Error(), "/test.go:22:2: iteration over map has random order")
+	s.Contains(err.Error(), "/test.go:25:25: time.Now isn't allowed")
+
+	sandbox := DefaultSandbox()
+	sandbox.Allow("math/rand", "time.Now")
+	_, err = ParseFileWithSandbox(tmpDir+"/test.go", sandbox)
+	s.Require().Error(err)
+	s.NotContains(err.Error(), "math/rand")
+	s.NotContains(err.Error(), "time.Now")
+
+	_, err = ParseFileWithSandbox(tmpDir+"/test.go", nil)
+	s.NoError(err)
+}
+
+func (s *PreprocessorSuite) TestSandboxOrderIndependentMapIteration() {
+	tmpDir, err := ioutil.TempDir("", "test-")
+	s.NoError(err)
+	defer os.RemoveAll(tmpDir) // nolint: errcheck
+
+	err = goplugintestutils.WriteFile(tmpDir, "/test.go", `
+package main
+
+import (
+	"sort"
+
+	"github.com/insolar/insolar/logicrunner/goplugin/foundation"
+)
+
+type Balances map[string]int
+
+type A struct {
+	foundation.BaseContract
+	Balances Balances
+}
+
+func (a *A) Names() ([]string, error) {
+	names := []string{}
+	for name := range a.Balances {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func (a *A) Clean() error {
+	for name, balance := range a.Balances {
+		if balance == 0 {
+			delete(a.Balances, name)
+		}
+	}
+	return nil
+}
+`)
+	s.NoError(err)
+
+	_, err = ParseFile(tmpDir + "/test.go")
+	s.NoError(err)
+}
+
 func TestPreprocessor(t *testing.T) {
 	t.Parallel()
 	suite.Run(t, new(PreprocessorSuite))
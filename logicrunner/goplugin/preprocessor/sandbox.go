@@ -0,0 +1,496 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package preprocessor
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Sandbox is a set of rules code of contracts is checked against before compilation. Every call of a contract
+// is repeated by validators, so code must give the same results on all nodes.
+type Sandbox struct {
+	// AllowedImports is a list of packages contracts can import, "/..." suffix allows all subpackages
+	AllowedImports []string
+	// DeniedFuncs is a list of functions of allowed packages with results depending on node, e.g. "time.Now"
+	DeniedFuncs []string
+}
+
+// DefaultSandbox returns rules contracts are checked against by default
+func DefaultSandbox() *Sandbox {
+	return &Sandbox{
+		AllowedImports: []string{
+			"bytes",
+			"encoding/base64",
+			"encoding/hex",
+			"encoding/json",
+			"errors",
+			"fmt",
+			"math",
+			"math/big",
+			"sort",
+			"strconv",
+			"strings",
+			"time",
+			"unicode",
+			"unicode/utf8",
+			corePath,
+			foundationPath,
+			proxyctxPath,
+			"github.com/insolar/insolar/application/proxy/...",
+			"github.com/insolar/insolar/application/contract/...",
+			"github.com/jbenet/go-base58",
+		},
+		DeniedFuncs: []string{
+			"time.After",
+			"time.AfterFunc",
+			"time.LoadLocation",
+			"time.NewTicker",
+			"time.NewTimer",
+			"time.Now",
+			"time.Since",
+			"time.Sleep",
+			"time.Tick",
+			"time.Until",
+		},
+	}
+}
+
+// Allow extends rules of the sandbox, every entry is either a denied function or a package to import
+func (s *Sandbox) Allow(entries ...string) {
+	for _, entry := range entries {
+		denied := s.DeniedFuncs[:0]
+		for _, f := range s.DeniedFuncs {
+			if f != entry {
+				denied = append(denied, f)
+			}
+		}
+		if len(denied) == len(s.DeniedFuncs) {
+			s.AllowedImports = append(s.AllowedImports, entry)
+		}
+		s.DeniedFuncs = denied
+	}
+}
+
+func (s *Sandbox) importAllowed(importPath string) bool {
+	for _, allowed := range s.AllowedImports {
+		if allowed == importPath {
+			return true
+		}
+		if prefix := strings.TrimSuffix(allowed, "..."); prefix != allowed && strings.HasPrefix(importPath, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Sandbox) funcDenied(name string) bool {
+	for _, f := range s.DeniedFuncs {
+		if f == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Diagnostic is a problem found in code of a contract
+type Diagnostic struct {
+	Pos     token.Position
+	Message string
+}
+
+func (d Diagnostic) String() string {
+	return d.Pos.String() + ": " + d.Message
+}
+
+// SandboxError is returned if code of a contract breaks rules of sandbox
+type SandboxError struct {
+	Diagnostics []Diagnostic
+}
+
+func (e *SandboxError) Error() string {
+	lines := []string{"contract code isn't deterministic:"}
+	for _, d := range e.Diagnostics {
+		lines = append(lines, d.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// CheckSandbox statically checks that code of the contract doesn't use non-deterministic packages and constructs:
+// goroutines, select statements, changes of package level variables and iteration over maps unless order of
+// iteration doesn't matter. Checks are syntactic, so only maps with types declared in the file are recognized.
+func (pf *ParsedFile) CheckSandbox(sandbox *Sandbox) error {
+	c := &sandboxChecker{
+		pf:      pf,
+		sandbox: sandbox,
+		imports: make(map[string]string),
+		types:   make(map[string]*ast.TypeSpec),
+		globals: make(map[*ast.Object]bool),
+	}
+	c.collect()
+	c.checkImports()
+	for _, decl := range pf.node.Decls {
+		if fd, ok := decl.(*ast.FuncDecl); ok {
+			c.function = fd.Body
+		} else {
+			c.function = nil
+		}
+		ast.Inspect(decl, c.visit)
+	}
+
+	if len(c.diagnostics) == 0 {
+		return nil
+	}
+	sort.SliceStable(c.diagnostics, func(i, j int) bool {
+		return c.diagnostics[i].Pos.Offset < c.diagnostics[j].Pos.Offset
+	})
+	return &SandboxError{Diagnostics: c.diagnostics}
+}
+
+type sandboxChecker struct {
+	pf      *ParsedFile
+	sandbox *Sandbox
+
+	imports map[string]string
+	types   map[string]*ast.TypeSpec
+	globals map[*ast.Object]bool
+
+	function    *ast.BlockStmt
+	diagnostics []Diagnostic
+}
+
+func (c *sandboxChecker) report(n ast.Node, format string, args ...interface{}) {
+	c.diagnostics = append(c.diagnostics, Diagnostic{
+		Pos:     c.pf.fileSet.Position(n.Pos()),
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (c *sandboxChecker) collect() {
+	for _, imp := range c.pf.node.Imports {
+		importPath, err := strconv.Unquote(imp.Path.Value)
+		if err != nil {
+			continue
+		}
+		// name of a package can differ from last element of path, but denied functions are in standard packages
+		name := path.Base(importPath)
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		c.imports[name] = importPath
+	}
+
+	for _, decl := range c.pf.node.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			switch s := spec.(type) {
+			case *ast.TypeSpec:
+				c.types[s.Name.Name] = s
+			case *ast.ValueSpec:
+				if gd.Tok != token.VAR {
+					continue
+				}
+				for _, name := range s.Names {
+					if name.Obj != nil {
+						c.globals[name.Obj] = true
+					}
+				}
+			}
+		}
+	}
+}
+
+func (c *sandboxChecker) checkImports() {
+	for _, imp := range c.pf.node.Imports {
+		importPath, err := strconv.Unquote(imp.Path.Value)
+		if err != nil || !c.sandbox.importAllowed(importPath) {
+			c.report(imp, "import of %s isn't allowed", imp.Path.Value)
+		}
+	}
+}
+
+func (c *sandboxChecker) visit(n ast.Node) bool {
+	switch n := n.(type) {
+	case *ast.GoStmt:
+		c.report(n, "goroutines aren't allowed")
+	case *ast.SelectStmt:
+		c.report(n, "select statement isn't allowed")
+	case *ast.SelectorExpr:
+		if x, ok := n.X.(*ast.Ident); ok && x.Obj == nil {
+			if importPath, ok := c.imports[x.Name]; ok && c.sandbox.funcDenied(importPath+"."+n.Sel.Name) {
+				c.report(n, "%s.%s isn't allowed", importPath, n.Sel.Name)
+			}
+		}
+	case *ast.AssignStmt:
+		if n.Tok != token.DEFINE {
+			for _, lhs := range n.Lhs {
+				c.checkGlobalChange(lhs)
+			}
+		}
+	case *ast.IncDecStmt:
+		c.checkGlobalChange(n.X)
+	case *ast.UnaryExpr:
+		if n.Op == token.AND {
+			c.checkGlobalChange(n.X)
+		}
+	case *ast.CallExpr:
+		if fun, ok := n.Fun.(*ast.Ident); ok && fun.Obj == nil && fun.Name == "delete" && len(n.Args) > 0 {
+			c.checkGlobalChange(n.Args[0])
+		}
+	case *ast.RangeStmt:
+		if n.Tok == token.ASSIGN {
+			c.checkGlobalChange(n.Key)
+			c.checkGlobalChange(n.Value)
+		}
+		if c.isMap(n.X, 0) && !c.orderIndependent(n) {
+			c.report(n, "iteration over map has random order, collect keys and sort them first")
+		}
+	}
+	return true
+}
+
+func (c *sandboxChecker) checkGlobalChange(expr ast.Expr) {
+	if id := rootIdent(expr); id != nil && id.Obj != nil && c.globals[id.Obj] {
+		c.report(expr, "package level variable %s can't be changed", id.Name)
+	}
+}
+
+func rootIdent(expr ast.Expr) *ast.Ident {
+	for {
+		switch e := expr.(type) {
+		case *ast.Ident:
+			return e
+		case *ast.SelectorExpr:
+			expr = e.X
+		case *ast.IndexExpr:
+			expr = e.X
+		case *ast.StarExpr:
+			expr = e.X
+		case *ast.ParenExpr:
+			expr = e.X
+		default:
+			return nil
+		}
+	}
+}
+
+// maxResolveDepth limits resolution of variables and types declared through each other
+const maxResolveDepth = 8
+
+func (c *sandboxChecker) isMap(expr ast.Expr, depth int) bool {
+	if depth > maxResolveDepth {
+		return false
+	}
+	switch e := expr.(type) {
+	case *ast.ParenExpr:
+		return c.isMap(e.X, depth+1)
+	case *ast.CompositeLit:
+		return c.isMapType(e.Type, depth+1)
+	case *ast.CallExpr:
+		fun, ok := e.Fun.(*ast.Ident)
+		return ok && fun.Obj == nil && fun.Name == "make" && len(e.Args) > 0 && c.isMapType(e.Args[0], depth+1)
+	case *ast.Ident:
+		return c.isMapVar(e, depth+1)
+	case *ast.SelectorExpr:
+		x, ok := e.X.(*ast.Ident)
+		if !ok {
+			return false
+		}
+		st := c.structOf(c.varType(x), depth+1)
+		if st == nil {
+			return false
+		}
+		for _, field := range st.Fields.List {
+			for _, name := range field.Names {
+				if name.Name == e.Sel.Name {
+					return c.isMapType(field.Type, depth+1)
+				}
+			}
+		}
+	}
+	return false
+}
+
+func (c *sandboxChecker) isMapVar(id *ast.Ident, depth int) bool {
+	if t := c.varType(id); t != nil {
+		return c.isMapType(t, depth)
+	}
+	if id.Obj == nil {
+		return false
+	}
+	switch decl := id.Obj.Decl.(type) {
+	case *ast.ValueSpec:
+		for i, name := range decl.Names {
+			if name.Name == id.Name && len(decl.Values) == len(decl.Names) {
+				return c.isMap(decl.Values[i], depth)
+			}
+		}
+	case *ast.AssignStmt:
+		for i, lhs := range decl.Lhs {
+			if name, ok := lhs.(*ast.Ident); ok && name.Name == id.Name && len(decl.Rhs) == len(decl.Lhs) {
+				return c.isMap(decl.Rhs[i], depth)
+			}
+		}
+	}
+	return false
+}
+
+// varType returns declared type of a variable, parameter or receiver
+func (c *sandboxChecker) varType(id *ast.Ident) ast.Expr {
+	if id.Obj == nil {
+		return nil
+	}
+	switch decl := id.Obj.Decl.(type) {
+	case *ast.Field:
+		return decl.Type
+	case *ast.ValueSpec:
+		return decl.Type
+	}
+	return nil
+}
+
+func (c *sandboxChecker) isMapType(t ast.Expr, depth int) bool {
+	if depth > maxResolveDepth {
+		return false
+	}
+	switch t := t.(type) {
+	case *ast.MapType:
+		return true
+	case *ast.ParenExpr:
+		return c.isMapType(t.X, depth+1)
+	case *ast.Ident:
+		if spec, ok := c.types[t.Name]; ok {
+			return c.isMapType(spec.Type, depth+1)
+		}
+	}
+	return false
+}
+
+func (c *sandboxChecker) structOf(t ast.Expr, depth int) *ast.StructType {
+	if depth > maxResolveDepth || t == nil {
+		return nil
+	}
+	switch t := t.(type) {
+	case *ast.StarExpr:
+		return c.structOf(t.X, depth+1)
+	case *ast.StructType:
+		return t
+	case *ast.Ident:
+		if spec, ok := c.types[t.Name]; ok {
+			return c.structOf(spec.Type, depth+1)
+		}
+	}
+	return nil
+}
+
+// orderIndependent recognizes loops over maps which results don't depend on order of iteration:
+// loops deleting entries and loops collecting keys into a slice sorted after the loop
+func (c *sandboxChecker) orderIndependent(rs *ast.RangeStmt) bool {
+	key, _ := rs.Key.(*ast.Ident)
+	return c.orderIndependentList(rs, key, rs.Body.List)
+}
+
+func (c *sandboxChecker) orderIndependentList(rs *ast.RangeStmt, key *ast.Ident, list []ast.Stmt) bool {
+	for _, stmt := range list {
+		if !c.orderIndependentStmt(rs, key, stmt) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *sandboxChecker) orderIndependentStmt(rs *ast.RangeStmt, key *ast.Ident, stmt ast.Stmt) bool {
+	switch s := stmt.(type) {
+	case *ast.ExprStmt:
+		call, ok := s.X.(*ast.CallExpr)
+		if !ok {
+			return false
+		}
+		fun, ok := call.Fun.(*ast.Ident)
+		return ok && fun.Obj == nil && fun.Name == "delete"
+	case *ast.BlockStmt:
+		return c.orderIndependentList(rs, key, s.List)
+	case *ast.IfStmt:
+		if s.Init != nil || !c.orderIndependentList(rs, key, s.Body.List) {
+			return false
+		}
+		return s.Else == nil || c.orderIndependentStmt(rs, key, s.Else)
+	case *ast.AssignStmt:
+		if key == nil || key.Obj == nil || s.Tok != token.ASSIGN || len(s.Lhs) != 1 || len(s.Rhs) != 1 {
+			return false
+		}
+		slice, ok := s.Lhs[0].(*ast.Ident)
+		if !ok || slice.Obj == nil {
+			return false
+		}
+		call, ok := s.Rhs[0].(*ast.CallExpr)
+		if !ok || len(call.Args) != 2 {
+			return false
+		}
+		fun, ok := call.Fun.(*ast.Ident)
+		if !ok || fun.Obj != nil || fun.Name != "append" {
+			return false
+		}
+		first, ok := call.Args[0].(*ast.Ident)
+		if !ok || first.Obj != slice.Obj {
+			return false
+		}
+		second, ok := call.Args[1].(*ast.Ident)
+		return ok && second.Obj == key.Obj && c.sortedAfter(slice, rs)
+	}
+	return false
+}
+
+// sortedAfter checks that the slice is passed to a function of sort package after the loop
+func (c *sandboxChecker) sortedAfter(slice *ast.Ident, rs *ast.RangeStmt) bool {
+	if c.function == nil {
+		return false
+	}
+	found := false
+	ast.Inspect(c.function, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if found || !ok || call.Pos() < rs.End() {
+			return !found
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		pkg, ok := sel.X.(*ast.Ident)
+		if !ok || pkg.Obj != nil || c.imports[pkg.Name] != "sort" {
+			return true
+		}
+		for _, arg := range call.Args {
+			ast.Inspect(arg, func(n ast.Node) bool {
+				if id, ok := n.(*ast.Ident); ok && id.Obj == slice.Obj {
+					found = true
+				}
+				return !found
+			})
+		}
+		return !found
+	})
+	return found
+}
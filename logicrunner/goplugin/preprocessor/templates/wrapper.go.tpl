@@ -14,7 +14,7 @@
 // limitations under the License.
 //
 
-package main
+package {{ .Package }}
 
 import (
     {{- range $import, $i := .Imports }}
@@ -82,6 +82,29 @@ func INSMETHOD_GetPrototype(object []byte, data []byte) ([]byte, []byte, error)
 	return state, ret, err
 }
 
+func INSMETHOD_Migrate(object []byte, data []byte) ([]byte, []byte, error) {
+{{- if .Migrate }}
+    ph := proxyctx.Current
+    self := new({{ $.ContractType }})
+
+    err := self.Migrate(object)
+    if err != nil {
+        e := &ExtendableError{ S: "[ FakeMigrate ] ( Generated Method ) Can't migrate object: " + err.Error() }
+        return nil, nil, e
+    }
+
+    state := []byte{}
+    err = ph.Serialize(self, &state)
+    if err != nil {
+        return nil, nil, err
+    }
+
+    return state, []byte{}, nil
+{{- else }}
+    return object, []byte{}, nil
+{{- end }}
+}
+
 {{ range $method := .Methods }}
 func INSMETHOD_{{ $method.Name }}(object []byte, data []byte) ([]byte, []byte, error) {
     ph := proxyctx.Current
@@ -158,3 +181,33 @@ func INSCONSTRUCTOR_{{ $f.Name }}(data []byte) ([]byte, error) {
     return ret, err
 }
 {{ end }}
+{{ if .Builtin }}
+// Initialize returns wrappers of the contract for builtin machine type
+func Initialize() insolar.ContractWrapper {
+    return insolar.ContractWrapper{
+        Methods: map[string]insolar.ContractMethod{
+            "GetCode": INSMETHOD_GetCode,
+            "GetPrototype": INSMETHOD_GetPrototype,
+            "Migrate": INSMETHOD_Migrate,
+{{- range $method := .Methods }}
+            "{{ $method.Name }}": INSMETHOD_{{ $method.Name }},
+{{- end }}
+        },
+        Constructors: map[string]insolar.ContractConstructor{
+{{- range $f := .Functions }}
+            "{{ $f.Name }}": INSCONSTRUCTOR_{{ $f.Name }},
+{{- end }}
+        },
+        API: map[string]bool{
+{{- range $name := .API }}
+            "{{ $name }}": INSATTR_{{ $name }}_API,
+{{- end }}
+        },
+        Immutable: map[string]bool{
+{{- range $name := .Immutable }}
+            "{{ $name }}": INSATTR_{{ $name }}_Immutable,
+{{- end }}
+        },
+    }
+}
+{{ end }}
@@ -25,9 +25,12 @@ type ProxyHelper interface {
 	RouteCall(ref insolar.Reference, wait bool, method string, args []byte, proxyPrototype insolar.Reference) ([]byte, error)
 	SaveAsChild(parentRef, classRef insolar.Reference, constructorName string, argsSerialized []byte) (insolar.Reference, error)
 	GetObjChildrenIterator(head insolar.Reference, prototype insolar.Reference, iteratorID string) (*ChildrenTypedIterator, error)
+	GetObjStates(head insolar.Reference, from *insolar.ID, limit int) ([]ObjectState, *insolar.ID, error)
 	SaveAsDelegate(parentRef, classRef insolar.Reference, constructorName string, argsSerialized []byte) (insolar.Reference, error)
 	GetDelegate(object, ofType insolar.Reference) (insolar.Reference, error)
 	DeactivateObject(object insolar.Reference) error
+	UpgradePrototype(prototype insolar.Reference, code []byte, machineType insolar.MachineType) (insolar.Reference, error)
+	Emit(name string, payload []byte) error
 	Serialize(what interface{}, to *[]byte) error
 	Deserialize(from []byte, into interface{}) error
 	MakeErrorSerializable(error) error
@@ -36,6 +39,13 @@ type ProxyHelper interface {
 // Current - hackish way to give proxies access to the current environment
 var Current ProxyHelper
 
+// ObjectState is a single state from object lifeline
+type ObjectState struct {
+	State   insolar.ID
+	Request insolar.Reference // request that produced the state
+	Memory  []byte
+}
+
 // ChildrenTypedIterator iterator over children of object with specified type
 // it uses cache on insolard service side, provided by IteratorID
 type ChildrenTypedIterator struct {
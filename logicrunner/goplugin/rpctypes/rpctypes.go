@@ -37,6 +37,8 @@ type DownCallMethodReq struct { // todo it may use foundation.Context
 type DownCallMethodResp struct {
 	Data []byte
 	Ret  insolar.Arguments
+	// BudgetExceeded is set when the call was aborted because of exceeded execution budget
+	BudgetExceeded bool
 }
 
 // DownCallConstructorReq is a set of arguments for CallConstructor RPC
@@ -51,6 +53,8 @@ type DownCallConstructorReq struct {
 // DownCallConstructorResp is response from CallConstructor RPC in the runner
 type DownCallConstructorResp struct {
 	Ret insolar.Arguments
+	// BudgetExceeded is set when the call was aborted because of exceeded execution budget
+	BudgetExceeded bool
 }
 
 // UpBaseReq  is a base type for all insgorund -> logicrunner requests
@@ -125,6 +129,27 @@ type ChildIterator struct {
 	CanFetch bool
 }
 
+// UpGetObjStatesReq is a set of arguments for GetObjStates RPC in goplugin
+type UpGetObjStatesReq struct {
+	UpBaseReq
+	Obj   insolar.Reference
+	From  *insolar.ID
+	Limit int
+}
+
+// UpGetObjStatesResp is response from GetObjStates RPC in goplugin
+type UpGetObjStatesResp struct {
+	States []ObjectState
+	Next   *insolar.ID
+}
+
+// ObjectState hold a single object state of GetObjStates method
+type ObjectState struct {
+	State   insolar.ID
+	Request insolar.Reference
+	Memory  []byte
+}
+
 // UpSaveAsDelegateReq is a set of arguments for SaveAsDelegate RPC in goplugin
 type UpSaveAsDelegateReq struct {
 	UpBaseReq
@@ -159,3 +184,27 @@ type UpDeactivateObjectReq struct {
 // UpDeactivateObjectResp is response from DeactivateObject RPC in goplugin
 type UpDeactivateObjectResp struct {
 }
+
+// UpUpgradePrototypeReq is a set of arguments for UpgradePrototype RPC in goplugin
+type UpUpgradePrototypeReq struct {
+	UpBaseReq
+	Prototype   insolar.Reference
+	Code        []byte
+	MachineType insolar.MachineType
+}
+
+// UpUpgradePrototypeResp is response from UpgradePrototype RPC in goplugin
+type UpUpgradePrototypeResp struct {
+	Code insolar.Reference
+}
+
+// UpEmitReq is a set of arguments for Emit RPC in goplugin
+type UpEmitReq struct {
+	UpBaseReq
+	Name    string
+	Payload []byte
+}
+
+// UpEmitResp is response from Emit RPC in goplugin
+type UpEmitResp struct {
+}
@@ -0,0 +1,173 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package logicrunner
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/instrumentation/instracer"
+)
+
+// immutableCalls holds states of immutable calls in progress. Immutable calls of an object aren't queued, so they
+// are found by temporary request references instead of object references when contracts call logicrunner.
+type immutableCalls struct {
+	lock  sync.RWMutex
+	seq   uint64
+	calls map[Ref]*ExecutionState
+}
+
+func newImmutableCalls() *immutableCalls {
+	return &immutableCalls{calls: make(map[Ref]*ExecutionState)}
+}
+
+// start creates state of an immutable call of the object with a temporary request reference,
+// the reference isn't registered in ledger
+func (ic *immutableCalls) start(ctx context.Context, object Ref, pulse insolar.PulseNumber) *ExecutionState {
+	ic.lock.Lock()
+	defer ic.lock.Unlock()
+
+	ic.seq++
+	hash := make([]byte, 8)
+	binary.BigEndian.PutUint64(hash, ic.seq)
+	request := insolar.NewReference(*object.Domain(), *insolar.NewID(pulse, hash))
+
+	es := &ExecutionState{
+		Ref: object,
+		Current: &CurrentExecution{
+			Context:    ctx,
+			Request:    request,
+			ReturnMode: message.ReturnResult,
+		},
+	}
+	ic.calls[*request] = es
+	return es
+}
+
+func (ic *immutableCalls) finish(es *ExecutionState) {
+	ic.lock.Lock()
+	defer ic.lock.Unlock()
+
+	delete(ic.calls, *es.Current.Request)
+}
+
+func (ic *immutableCalls) mustGet(request Ref) *ExecutionState {
+	ic.lock.RLock()
+	defer ic.lock.RUnlock()
+
+	es, ok := ic.calls[request]
+	if !ok {
+		panic("No immutable call in progress. request: " + request.String())
+	}
+	return es
+}
+
+// executeImmutable runs immutable method on the latest state of the object. The call isn't queued with other calls
+// of the object and nothing is written to ledger, so the method fails if it changes state of the object or tries
+// to change other objects.
+func (lr *LogicRunner) executeImmutable(ctx context.Context, m *message.CallMethod) (insolar.Reply, error) {
+	ctx, span := instracer.StartSpan(ctx, "LogicRunner.executeImmutable")
+	defer span.End()
+
+	if m.Method == migrateMethod {
+		return nil, errors.New("method Migrate can't be called directly")
+	}
+
+	pulse := *lr.pulse(ctx)
+	objDesc, protoDesc, codeDesc, err := lr.getDescriptorsByObjectRef(ctx, m.ObjectRef, pulse.PulseNumber)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get descriptors by object reference")
+	}
+	// it's needed to assure that we call method on ref, that has same prototype as proxy, that we import in contract code
+	if !m.ProxyPrototype.IsEmpty() && !m.ProxyPrototype.Equal(*protoDesc.HeadRef()) {
+		return nil, errors.New("proxy call error: try to call method of prototype as method of another prototype")
+	}
+
+	executor, err := lr.GetExecutor(codeDesc.MachineType())
+	if err != nil {
+		return nil, errors.Wrap(err, "no executor registered")
+	}
+
+	es := lr.immutables.start(ctx, m.ObjectRef, pulse.PulseNumber)
+	defer lr.immutables.finish(es)
+
+	callCtx := &insolar.LogicCallContext{
+		Mode:            insolar.ImmutableMode,
+		Caller:          m.GetCaller(),
+		Callee:          &m.ObjectRef,
+		Request:         es.Current.Request,
+		Prototype:       protoDesc.HeadRef(),
+		Code:            codeDesc.Ref(),
+		Parent:          objDesc.Parent(),
+		CallerPrototype: m.GetCallerPrototype(),
+		Time:            time.Now(),
+		Pulse:           pulse,
+		TraceID:         inslogger.TraceID(ctx),
+		Budget:          lr.budgets.get(protoDesc.HeadRef()),
+	}
+	es.Current.LogicContext = callCtx
+
+	object := objDesc.Memory()
+	history := decodeCodeHistory(protoDesc.Memory())
+	if migrations := history.migrations(objDesc.StateID().Pulse(), pulse.PulseNumber, codeDesc.Ref()); len(migrations) > 0 {
+		// migrated state is used for the call only, it's saved by the next mutable call of the object
+		object, err = lr.migrateObject(ctx, callCtx, executor, &ObjectBody{Object: object, migrations: migrations})
+		if err != nil && err != insolar.ErrExecutionBudgetExceeded {
+			return nil, errors.Wrap(err, "couldn't migrate object")
+		}
+	}
+
+	var newData, result []byte
+	if err == nil {
+		newData, result, err = executor.CallMethod(ctx, callCtx, *codeDesc.Ref(), object, m.Method, m.Arguments)
+	}
+	if err == insolar.ErrExecutionBudgetExceeded {
+		inslogger.FromContext(ctx).Warn("immutable call has exceeded execution budget, method: ", m.Method)
+		return &reply.Error{ErrType: reply.ErrExecutionBudgetExceeded}, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "executor error")
+	}
+	if stateChanged(object, newData) {
+		return nil, errors.Errorf("immutable method %s has changed state of the object", m.Method)
+	}
+
+	return &reply.CallMethod{Result: result}, nil
+}
+
+// stateChanged compares serialized states of an object. Serialization of maps isn't stable,
+// so states are decoded to compare values if bytes differ.
+func stateChanged(prev, next []byte) bool {
+	if bytes.Equal(prev, next) {
+		return false
+	}
+	var prevValue, nextValue interface{}
+	if insolar.Deserialize(prev, &prevValue) != nil || insolar.Deserialize(next, &nextValue) != nil {
+		return true
+	}
+	return !reflect.DeepEqual(prevValue, nextValue)
+}
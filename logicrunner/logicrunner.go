@@ -42,6 +42,7 @@ import (
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/logicrunner/builtin"
 	"github.com/insolar/insolar/logicrunner/goplugin"
+	"github.com/insolar/insolar/logicrunner/wasm"
 )
 
 const maxQueueLength = 10
@@ -65,6 +66,8 @@ type CurrentExecution struct {
 	RequesterNode *Ref
 	ReturnMode    message.MethodReturnMode
 	SentResult    bool
+	// Events are emitted by the call, they are registered with its result
+	Events []insolar.Event
 }
 
 type ExecutionQueueResult struct {
@@ -139,10 +142,13 @@ type LogicRunner struct {
 	PulseAccessor              pulse.Accessor                     `inject:""`
 	ArtifactManager            artifacts.Client                   `inject:""`
 	JetCoordinator             insolar.JetCoordinator             `inject:""`
+	GenesisDataProvider        insolar.GenesisDataProvider        `inject:""`
 
 	Executors    [insolar.MachineTypesLastID]insolar.MachineLogicExecutor
 	machinePrefs []insolar.MachineType
 	Cfg          *configuration.LogicRunner
+	budgets      *budgets
+	immutables   *immutableCalls
 
 	state      map[Ref]*ObjectState // if object exists, we are validating or executing it right now
 	stateMutex sync.RWMutex
@@ -159,17 +165,35 @@ func NewLogicRunner(cfg *configuration.LogicRunner) (*LogicRunner, error) {
 	if cfg == nil {
 		return nil, errors.New("LogicRunner have nil configuration")
 	}
+	budgets, err := newBudgets(cfg)
+	if err != nil {
+		return nil, err
+	}
 	res := LogicRunner{
-		Cfg:   cfg,
-		state: make(map[Ref]*ObjectState),
+		Cfg:        cfg,
+		budgets:    budgets,
+		immutables: newImmutableCalls(),
+		state:      make(map[Ref]*ObjectState),
 	}
 	return &res, nil
 }
 
 // Start starts logic runner component
 func (lr *LogicRunner) Start(ctx context.Context) error {
+	err := lr.startExecutors(ctx)
+	if err != nil {
+		return err
+	}
+
+	lr.RegisterHandlers()
+
+	return nil
+}
+
+// startExecutors registers executors enabled in configuration
+func (lr *LogicRunner) startExecutors(ctx context.Context) error {
 	if lr.Cfg.BuiltIn != nil {
-		bi := builtin.NewBuiltIn(lr.MessageBus, lr.ArtifactManager)
+		bi := builtin.NewBuiltIn(lr.MessageBus, lr.ArtifactManager, &RPC{lr: lr})
 		if err := lr.RegisterExecutor(insolar.MachineTypeBuiltin, bi); err != nil {
 			return err
 		}
@@ -191,7 +215,13 @@ func (lr *LogicRunner) Start(ctx context.Context) error {
 		lr.machinePrefs = append(lr.machinePrefs, insolar.MachineTypeGoPlugin)
 	}
 
-	lr.RegisterHandlers()
+	if lr.Cfg.WASM != nil {
+		w := wasm.NewWASM(lr.ArtifactManager, &RPC{lr: lr})
+		if err := lr.RegisterExecutor(insolar.MachineTypeWASM, w); err != nil {
+			return err
+		}
+		lr.machinePrefs = append(lr.machinePrefs, insolar.MachineTypeWASM)
+	}
 
 	return nil
 }
@@ -296,6 +326,10 @@ func (lr *LogicRunner) Execute(ctx context.Context, parcel insolar.Parcel) (inso
 	)
 	defer span.End()
 
+	if m, ok := msg.(*message.CallMethod); ok && m.Immutable {
+		return lr.executeImmutable(ctx, m)
+	}
+
 	rep, err := lr.executeActual(ctx, parcel, msg)
 	return rep, err
 }
@@ -506,6 +540,11 @@ func (lr *LogicRunner) ProcessExecutionQueue(ctx context.Context, es *ExecutionS
 			RequesterNode: &sender,
 			Context:       qe.ctx,
 		}
+		if lr.Cfg.TapeDir != "" {
+			// every request fetches the object, so its tape can be replayed alone
+			es.objectbody = nil
+			current.Context = lr.startTape(current.Context)
+		}
 		es.Current = &current
 
 		if msg, ok := qe.parcel.Message().(*message.CallMethod); ok {
@@ -514,6 +553,7 @@ func (lr *LogicRunner) ProcessExecutionQueue(ctx context.Context, es *ExecutionS
 		if msg, ok := qe.parcel.Message().(message.IBaseLogicMessage); ok {
 			current.Sequence = msg.GetBaseLogicMessage().Sequence
 		}
+		nonce := es.nonce
 
 		es.Unlock()
 
@@ -521,7 +561,9 @@ func (lr *LogicRunner) ProcessExecutionQueue(ctx context.Context, es *ExecutionS
 
 		inslogger.FromContext(qe.ctx).Debug("Registering request within execution behaviour")
 
-		es.Behaviour.(*ValidationSaver).NewRequest(qe.parcel, *qe.request, lr.MessageBus)
+		es.Behaviour.(*ValidationSaver).NewRequest(
+			qe.parcel, *qe.request, insolar.MessageBusFromContext(current.Context, lr.MessageBus), nonce,
+		)
 
 		res.reply, res.err = lr.executeOrValidate(current.Context, es, qe.parcel)
 
@@ -535,6 +577,10 @@ func (lr *LogicRunner) ProcessExecutionQueue(ctx context.Context, es *ExecutionS
 			res.err = err
 		}
 
+		if lr.Cfg.TapeDir != "" {
+			lr.saveTape(current.Context, es.Behaviour.(*ValidationSaver).current, current.LogicContext)
+		}
+
 		lr.finishPendingIfNeeded(ctx, es)
 	}
 }
@@ -732,6 +778,12 @@ type ObjectBody struct {
 	CodeMachineType insolar.MachineType
 	CodeRef         *Ref
 	Parent          *Ref
+
+	// codeChangesAfter is set when code of the prototype is upgraded and the new code is not in effect yet
+	codeChangesAfter insolar.PulseNumber
+	// migrations are codes which Migrate methods convert memory of the object written by previous code
+	// of the prototype, they are applied in order
+	migrations []Ref
 }
 
 func init() {
@@ -816,8 +868,18 @@ func (lr *LogicRunner) prepareObjectState(ctx context.Context, msg *message.Exec
 }
 
 func (lr *LogicRunner) executeMethodCall(ctx context.Context, es *ExecutionState, m *message.CallMethod) (insolar.Reply, error) {
+	if m.Method == migrateMethod {
+		return nil, errors.New("method Migrate can't be called directly")
+	}
+
+	pulse := es.Current.LogicContext.Pulse.PulseNumber
+	if es.objectbody != nil && es.objectbody.codeChangesAfter != 0 && pulse > es.objectbody.codeChangesAfter {
+		// upgraded code of the prototype is in effect now
+		es.objectbody = nil
+	}
+
 	if es.objectbody == nil {
-		objDesc, protoDesc, codeDesc, err := lr.getDescriptorsByObjectRef(ctx, m.ObjectRef)
+		objDesc, protoDesc, codeDesc, err := lr.getDescriptorsByObjectRef(ctx, m.ObjectRef, pulse)
 		if err != nil {
 			return nil, errors.Wrap(err, "couldn't get descriptors by object reference")
 		}
@@ -829,6 +891,9 @@ func (lr *LogicRunner) executeMethodCall(ctx context.Context, es *ExecutionState
 			CodeRef:         codeDesc.Ref(),
 			Parent:          objDesc.Parent(),
 		}
+		history := decodeCodeHistory(protoDesc.Memory())
+		es.objectbody.codeChangesAfter = history.changesAfter(pulse)
+		es.objectbody.migrations = history.migrations(objDesc.StateID().Pulse(), pulse, codeDesc.Ref())
 		inslogger.FromContext(ctx).Info("LogicRunner.executeMethodCall starts")
 	}
 
@@ -836,6 +901,7 @@ func (lr *LogicRunner) executeMethodCall(ctx context.Context, es *ExecutionState
 	current.LogicContext.Prototype = es.objectbody.Prototype
 	current.LogicContext.Code = es.objectbody.CodeRef
 	current.LogicContext.Parent = es.objectbody.Parent
+	current.LogicContext.Budget = lr.budgets.get(es.objectbody.Prototype)
 	// it's needed to assure that we call method on ref, that has same prototype as proxy, that we import in contract code
 	if !m.ProxyPrototype.IsEmpty() && !m.ProxyPrototype.Equal(*es.objectbody.Prototype) {
 		return nil, errors.New("proxy call error: try to call method of prototype as method of another prototype")
@@ -846,9 +912,24 @@ func (lr *LogicRunner) executeMethodCall(ctx context.Context, es *ExecutionState
 		return nil, es.WrapError(err, "no executor registered")
 	}
 
-	newData, result, err := executor.CallMethod(
-		ctx, current.LogicContext, *es.objectbody.CodeRef, es.objectbody.Object, m.Method, m.Arguments,
-	)
+	object := es.objectbody.Object
+	if len(es.objectbody.migrations) > 0 {
+		object, err = lr.migrateObject(ctx, current.LogicContext, executor, es.objectbody)
+		if err != nil && err != insolar.ErrExecutionBudgetExceeded {
+			return nil, es.WrapError(err, "couldn't migrate object")
+		}
+	}
+
+	var newData, result []byte
+	if err == nil {
+		newData, result, err = executor.CallMethod(
+			ctx, current.LogicContext, *es.objectbody.CodeRef, object, m.Method, m.Arguments,
+		)
+	}
+	if err == insolar.ErrExecutionBudgetExceeded {
+		es.deactivate = false
+		return lr.registerBudgetExceeded(ctx, es, m.ObjectRef, *current.Request)
+	}
 	if err != nil {
 		return nil, es.WrapError(err, "executor error")
 	}
@@ -861,7 +942,7 @@ func (lr *LogicRunner) executeMethodCall(ctx context.Context, es *ExecutionState
 		if err != nil {
 			return nil, es.WrapError(err, "couldn't deactivate object")
 		}
-	} else if !bytes.Equal(es.objectbody.Object, newData) {
+	} else if len(es.objectbody.migrations) > 0 || !bytes.Equal(es.objectbody.Object, newData) {
 		od, err := am.UpdateObject(ctx, Ref{}, *current.Request, es.objectbody.objDescriptor, newData)
 		if err != nil {
 			if strings.Contains(err.Error(), "invalid state record") {
@@ -870,8 +951,9 @@ func (lr *LogicRunner) executeMethodCall(ctx context.Context, es *ExecutionState
 			return nil, es.WrapError(err, "couldn't update object")
 		}
 		es.objectbody.objDescriptor = od
+		es.objectbody.migrations = nil
 	}
-	_, err = am.RegisterResult(ctx, m.ObjectRef, *current.Request, result)
+	_, err = am.RegisterResultWithEvents(ctx, m.ObjectRef, *current.Request, result, es.Current.Events)
 	if err != nil {
 		return nil, es.WrapError(err, "couldn't save results")
 	}
@@ -881,8 +963,51 @@ func (lr *LogicRunner) executeMethodCall(ctx context.Context, es *ExecutionState
 	return &reply.CallMethod{Result: result, Request: *current.Request}, nil
 }
 
+// registerBudgetExceeded saves result of a call that has exceeded its execution budget,
+// state of the object is left intact
+func (lr *LogicRunner) registerBudgetExceeded(
+	ctx context.Context, es *ExecutionState, object, request Ref,
+) (
+	insolar.Reply, error,
+) {
+	inslogger.FromContext(ctx).Warn("contract call has exceeded execution budget, request: ", request.String())
+
+	re := &reply.Error{ErrType: reply.ErrExecutionBudgetExceeded}
+	_, err := lr.ArtifactManager.RegisterResult(ctx, object, request, reply.ToBytes(re))
+	if err != nil {
+		return nil, es.WrapError(err, "couldn't save results")
+	}
+	return re, nil
+}
+
+// migrateObject converts memory of the object written by previous code of its prototype, migrations of every
+// upgrade since the memory was written are applied in order, the calls are made on behalf of the object itself
+func (lr *LogicRunner) migrateObject(
+	ctx context.Context, callCtx *insolar.LogicCallContext, executor insolar.MachineLogicExecutor, body *ObjectBody,
+) (
+	[]byte, error,
+) {
+	object := body.Object
+	for i := range body.migrations {
+		code := body.migrations[i]
+		inslogger.FromContext(ctx).Info("migrating object ", callCtx.Callee.String(), " by code ", code.String())
+
+		migrateCtx := *callCtx
+		migrateCtx.Caller = callCtx.Callee
+		migrateCtx.CallerPrototype = callCtx.Prototype
+		migrateCtx.Code = &code
+
+		var err error
+		object, _, err = executor.CallMethod(ctx, &migrateCtx, code, object, migrateMethod, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return object, nil
+}
+
 func (lr *LogicRunner) getDescriptorsByPrototypeRef(
-	ctx context.Context, protoRef Ref,
+	ctx context.Context, protoRef Ref, pulse insolar.PulseNumber,
 ) (
 	artifacts.ObjectDescriptor, artifacts.CodeDescriptor, error,
 ) {
@@ -890,9 +1015,9 @@ func (lr *LogicRunner) getDescriptorsByPrototypeRef(
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "couldn't get prototype descriptor")
 	}
-	codeRef, err := protoDesc.Code()
+	codeRef, err := prototypeCode(protoDesc, pulse)
 	if err != nil {
-		return nil, nil, errors.Wrap(err, "couldn't get code reference")
+		return nil, nil, err
 	}
 	// we don't want to record GetCode messages because of cache
 	ctx = insolar.ContextWithMessageBus(ctx, lr.MessageBus)
@@ -905,7 +1030,7 @@ func (lr *LogicRunner) getDescriptorsByPrototypeRef(
 }
 
 func (lr *LogicRunner) getDescriptorsByObjectRef(
-	ctx context.Context, objRef Ref,
+	ctx context.Context, objRef Ref, pulse insolar.PulseNumber,
 ) (
 	artifacts.ObjectDescriptor, artifacts.ObjectDescriptor, artifacts.CodeDescriptor, error,
 ) {
@@ -922,7 +1047,7 @@ func (lr *LogicRunner) getDescriptorsByObjectRef(
 		return nil, nil, nil, errors.Wrap(err, "couldn't get prototype reference")
 	}
 
-	protoDesc, codeDesc, err := lr.getDescriptorsByPrototypeRef(ctx, *protoRef)
+	protoDesc, codeDesc, err := lr.getDescriptorsByPrototypeRef(ctx, *protoRef, pulse)
 	if err != nil {
 		return nil, nil, nil, errors.Wrap(err, "couldn't resolve prototype reference to descriptors")
 	}
@@ -940,12 +1065,13 @@ func (lr *LogicRunner) executeConstructorCall(
 		return nil, es.WrapError(nil, "Call constructor from nowhere")
 	}
 
-	protoDesc, codeDesc, err := lr.getDescriptorsByPrototypeRef(ctx, m.PrototypeRef)
+	protoDesc, codeDesc, err := lr.getDescriptorsByPrototypeRef(ctx, m.PrototypeRef, current.LogicContext.Pulse.PulseNumber)
 	if err != nil {
 		return nil, es.WrapError(err, "couldn't descriptors")
 	}
 	current.LogicContext.Prototype = protoDesc.HeadRef()
 	current.LogicContext.Code = codeDesc.Ref()
+	current.LogicContext.Budget = lr.budgets.get(protoDesc.HeadRef())
 
 	executor, err := lr.GetExecutor(codeDesc.MachineType())
 	if err != nil {
@@ -953,6 +1079,9 @@ func (lr *LogicRunner) executeConstructorCall(
 	}
 
 	newData, err := executor.CallConstructor(ctx, current.LogicContext, *codeDesc.Ref(), m.Method, m.Arguments)
+	if err == insolar.ErrExecutionBudgetExceeded {
+		return lr.registerBudgetExceeded(ctx, es, *current.Request, *current.Request)
+	}
 	if err != nil {
 		return nil, es.WrapError(err, "executer error")
 	}
@@ -966,7 +1095,7 @@ func (lr *LogicRunner) executeConstructorCall(
 		if err != nil {
 			return nil, es.WrapError(err, "couldn't activate object")
 		}
-		_, err = lr.ArtifactManager.RegisterResult(ctx, *current.Request, *current.Request, nil)
+		_, err = lr.ArtifactManager.RegisterResultWithEvents(ctx, *current.Request, *current.Request, nil, es.Current.Events)
 		if err != nil {
 			return nil, es.WrapError(err, "couldn't save results")
 		}
@@ -166,8 +166,9 @@ func (s *LogicRunnerFuncSuite) PrepareLrAmCbPm() (insolar.LogicRunner, artifacts
 	cr, err := contractrequester.New()
 	pulseAccessor := l.PulseManager.(*pulsemanager.PulseManager).PulseAccessor
 	nth := testutils.NewTerminationHandlerMock(s.T())
+	gdp := testutils.NewGenesisDataProviderMock(s.T())
 
-	cm.Inject(db, pulseAccessor, nk, providerMock, l, lr, nw, mb, cr, delegationTokenFactory, parcelFactory, nth, mock)
+	cm.Inject(db, pulseAccessor, nk, providerMock, l, lr, nw, mb, cr, delegationTokenFactory, parcelFactory, nth, gdp, mock)
 	err = cm.Init(ctx)
 	s.NoError(err)
 	err = cm.Start(ctx)
@@ -895,6 +896,8 @@ func (c *Contract) Rand() (int, error) {
 	lr, am, cb, pm, cleaner := s.PrepareLrAmCbPm()
 	defer cleaner()
 
+	// contract is non-deterministic on purpose to fail validation
+	cb.SandboxAllow = []string{"math/rand", "time.Now"}
 	err := cb.Build(map[string]string{"contract": goContract})
 	s.NoError(err)
 
@@ -1694,6 +1697,7 @@ func (r *One) ShortSleep() (error) {
 	lr, am, cb, pm, cleaner := s.PrepareLrAmCbPm()
 	defer cleaner()
 
+	cb.SandboxAllow = []string{"time.Sleep"}
 	err := cb.Build(map[string]string{
 		"one": sleepContract,
 	})
@@ -1781,6 +1785,7 @@ func (r *One) EmptyMethod() (error) {
 	lr, am, cb, _, cleaner := s.PrepareLrAmCbPm()
 	defer cleaner()
 
+	cb.SandboxAllow = []string{"time.Sleep"}
 	err := cb.Build(map[string]string{
 		"one": emptyMethodContract,
 	})
@@ -17,6 +17,7 @@
 package logicrunner
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"strconv"
@@ -27,6 +28,7 @@ import (
 	"github.com/gojuno/minimock"
 	"github.com/insolar/insolar/ledger/storage/pulse"
 	"github.com/insolar/insolar/logicrunner/artifacts"
+	"github.com/insolar/insolar/logicrunner/goplugin/rpctypes"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -37,6 +39,8 @@ import (
 	"github.com/insolar/insolar/insolar/message"
 	"github.com/insolar/insolar/insolar/reply"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/messagebus"
+	"github.com/insolar/insolar/platformpolicy"
 	"github.com/insolar/insolar/testutils"
 	"github.com/insolar/insolar/testutils/network"
 )
@@ -572,7 +576,7 @@ func (suite *LogicRunnerTestSuite) TestNoExcessiveAmends() {
 	}
 
 	// In this case Update isn't send to ledger (objects data/newData are the same)
-	suite.am.RegisterResultMock.Return(nil, nil)
+	suite.am.RegisterResultWithEventsMock.Return(nil, nil)
 
 	_, err := suite.lr.executeMethodCall(suite.ctx, es, msg)
 	suite.Require().NoError(err)
@@ -587,6 +591,509 @@ func (suite *LogicRunnerTestSuite) TestNoExcessiveAmends() {
 	suite.Require().Equal(uint64(1), suite.am.UpdateObjectCounter)
 }
 
+func (suite *LogicRunnerTestSuite) TestExecutionBudgetExceeded() {
+	protoRef := testutils.RandomRef()
+	codeRef := testutils.RandomRef()
+	objRef := testutils.RandomRef()
+	reqRef := testutils.RandomRef()
+
+	var err error
+	suite.lr, err = NewLogicRunner(&configuration.LogicRunner{
+		Budget: &configuration.ExecutionBudget{MaxUpcalls: 10, MaxStateSize: 100},
+		PrototypeBudgets: []configuration.PrototypeBudget{
+			{Prototype: protoRef.String(), Budget: configuration.ExecutionBudget{MaxUpcalls: 1}},
+		},
+	})
+	suite.Require().NoError(err)
+	suite.lr.ArtifactManager = suite.am
+
+	es := &ExecutionState{
+		Current: &CurrentExecution{
+			LogicContext: &insolar.LogicCallContext{},
+			Request:      &reqRef,
+		},
+		objectbody: &ObjectBody{
+			Object:          []byte{1, 2, 3},
+			Prototype:       &protoRef,
+			CodeMachineType: insolar.MachineTypeBuiltin,
+			CodeRef:         &codeRef,
+		},
+	}
+
+	mle := testutils.NewMachineLogicExecutorMock(suite.mc)
+	suite.lr.Executors[insolar.MachineTypeBuiltin] = mle
+	mle.CallMethodFunc = func(_ context.Context, callCtx *insolar.LogicCallContext, _ insolar.Reference, _ []byte, _ string, _ insolar.Arguments) ([]byte, insolar.Arguments, error) {
+		suite.Equal(insolar.ExecutionBudget{MaxUpcalls: 1, MaxStateSize: 100}, callCtx.Budget)
+		return nil, nil, insolar.ErrExecutionBudgetExceeded
+	}
+
+	expected := &reply.Error{ErrType: reply.ErrExecutionBudgetExceeded}
+	suite.am.RegisterResultFunc = func(_ context.Context, obj insolar.Reference, request insolar.Reference, payload []byte) (*insolar.ID, error) {
+		suite.Equal(objRef, obj)
+		suite.Equal(reqRef, request)
+		rep, err := reply.Deserialize(bytes.NewReader(payload))
+		suite.Require().NoError(err)
+		suite.Equal(expected, rep)
+		return nil, nil
+	}
+
+	re, err := suite.lr.executeMethodCall(suite.ctx, es, &message.CallMethod{ObjectRef: objRef, Method: "Loop"})
+	suite.Require().NoError(err)
+	suite.Equal(expected, re)
+	suite.Equal([]byte{1, 2, 3}, es.objectbody.Object)
+	suite.Zero(suite.am.UpdateObjectCounter)
+}
+
+func (suite *LogicRunnerTestSuite) TestEmittedEventsAreRegisteredWithResult() {
+	protoRef := testutils.RandomRef()
+	codeRef := testutils.RandomRef()
+	objRef := testutils.RandomRef()
+	reqRef := testutils.RandomRef()
+
+	es := &ExecutionState{
+		Current: &CurrentExecution{
+			LogicContext: &insolar.LogicCallContext{Callee: &objRef},
+			Request:      &reqRef,
+		},
+		objectbody: &ObjectBody{
+			Object:          []byte{1},
+			Prototype:       &protoRef,
+			CodeMachineType: insolar.MachineTypeBuiltin,
+			CodeRef:         &codeRef,
+		},
+	}
+	suite.lr.state[objRef] = &ObjectState{ExecutionState: es}
+
+	rpc := &RPC{lr: suite.lr}
+	mle := testutils.NewMachineLogicExecutorMock(suite.mc)
+	suite.lr.Executors[insolar.MachineTypeBuiltin] = mle
+	mle.CallMethodFunc = func(
+		_ context.Context, _ *insolar.LogicCallContext, _ insolar.Reference, data []byte, _ string, _ insolar.Arguments,
+	) ([]byte, insolar.Arguments, error) {
+		base := rpctypes.UpBaseReq{Mode: "execution", Callee: objRef}
+		err := rpc.Emit(rpctypes.UpEmitReq{UpBaseReq: base, Name: "Transfer", Payload: []byte{2}}, &rpctypes.UpEmitResp{})
+		suite.NoError(err)
+		err = rpc.Emit(rpctypes.UpEmitReq{UpBaseReq: base}, &rpctypes.UpEmitResp{})
+		suite.Error(err, "event without name")
+		return data, nil, nil
+	}
+
+	suite.am.RegisterResultWithEventsFunc = func(
+		_ context.Context, obj, request insolar.Reference, _ []byte, events []insolar.Event,
+	) (*insolar.ID, error) {
+		suite.Equal(objRef, obj)
+		suite.Equal([]insolar.Event{{Name: "Transfer", Payload: []byte{2}}}, events)
+		return nil, nil
+	}
+
+	_, err := suite.lr.executeMethodCall(suite.ctx, es, &message.CallMethod{ObjectRef: objRef, Method: "Transfer"})
+	suite.Require().NoError(err)
+}
+
+func (suite *LogicRunnerTestSuite) TestExecuteImmutable() {
+	objRef := testutils.RandomRef()
+	protoRef := testutils.RandomRef()
+	codeRef := testutils.RandomRef()
+	otherRef := testutils.RandomRef()
+
+	suite.ps.LatestMock.Return(insolar.Pulse{PulseNumber: 100}, nil)
+
+	od := artifacts.NewObjectDescriptorMock(suite.T())
+	od.PrototypeMock.Return(&protoRef, nil)
+	od.MemoryMock.Return([]byte{1})
+	od.ParentMock.Return(nil)
+
+	pd := artifacts.NewObjectDescriptorMock(suite.T())
+	pd.CodeMock.Return(&codeRef, nil)
+	pd.MemoryMock.Return(nil)
+	pd.HeadRefMock.Return(&protoRef)
+
+	cd := artifacts.NewCodeDescriptorMock(suite.T())
+	cd.MachineTypeMock.Return(insolar.MachineTypeBuiltin)
+	cd.RefMock.Return(&codeRef)
+
+	suite.am.GetObjectFunc = func(
+		ctx context.Context, obj insolar.Reference, st *insolar.ID, approved bool,
+	) (artifacts.ObjectDescriptor, error) {
+		if obj == protoRef {
+			return pd, nil
+		}
+		return od, nil
+	}
+	suite.am.GetCodeMock.Return(cd, nil)
+
+	cr := testutils.NewContractRequesterMock(suite.mc)
+	cr.CallImmutableFunc = func(
+		_ context.Context, _ insolar.Message, obj *insolar.Reference, method string, _ insolar.Arguments, _ *insolar.Reference,
+	) (insolar.Reply, error) {
+		suite.Equal(otherRef, *obj)
+		suite.Equal("GetBalance", method)
+		return &reply.CallMethod{Result: []byte{3}}, nil
+	}
+	suite.lr.ContractRequester = cr
+
+	rpc := &RPC{lr: suite.lr}
+	mle := testutils.NewMachineLogicExecutorMock(suite.mc)
+	suite.lr.Executors[insolar.MachineTypeBuiltin] = mle
+	mle.CallMethodFunc = func(
+		_ context.Context, callCtx *insolar.LogicCallContext, _ insolar.Reference, data []byte, method string, _ insolar.Arguments,
+	) ([]byte, insolar.Arguments, error) {
+		suite.Equal(insolar.ImmutableMode, callCtx.Mode)
+		base := rpctypes.UpBaseReq{Mode: callCtx.Mode, Callee: objRef, Request: *callCtx.Request}
+
+		routeRep := &rpctypes.UpRouteResp{}
+		err := rpc.RouteCall(rpctypes.UpRouteReq{UpBaseReq: base, Wait: true, Object: otherRef, Method: "GetBalance"}, routeRep)
+		suite.NoError(err)
+		suite.Equal(insolar.Arguments{3}, routeRep.Result)
+
+		err = rpc.RouteCall(rpctypes.UpRouteReq{UpBaseReq: base, Object: otherRef, Method: "GetBalance"}, &rpctypes.UpRouteResp{})
+		suite.Error(err, "notification in immutable call")
+		err = rpc.Emit(rpctypes.UpEmitReq{UpBaseReq: base, Name: "Transfer"}, &rpctypes.UpEmitResp{})
+		suite.Error(err, "event in immutable call")
+		err = rpc.SaveAsChild(rpctypes.UpSaveAsChildReq{UpBaseReq: base}, &rpctypes.UpSaveAsChildResp{})
+		suite.Error(err, "new object in immutable call")
+
+		if method == "SetName" {
+			return []byte{2}, nil, nil
+		}
+		return data, []byte{4}, nil
+	}
+
+	// nothing is registered in ledger, artifact manager mock fails on any unexpected call
+	parcel := testutils.NewParcelMock(suite.mc).MessageMock.Return(
+		&message.CallMethod{ObjectRef: objRef, Method: "GetName", Immutable: true},
+	)
+	parcel.DefaultTargetMock.Return(&objRef)
+	rep, err := suite.lr.Execute(suite.ctx, parcel)
+	suite.Require().NoError(err)
+	suite.Equal(&reply.CallMethod{Result: []byte{4}}, rep)
+	suite.Empty(suite.lr.immutables.calls)
+
+	_, err = suite.lr.executeImmutable(suite.ctx, &message.CallMethod{ObjectRef: objRef, Method: "SetName", Immutable: true})
+	suite.Error(err, "state is changed")
+
+	_, err = suite.lr.executeImmutable(suite.ctx, &message.CallMethod{ObjectRef: objRef, Method: migrateMethod, Immutable: true})
+	suite.Error(err)
+}
+
+func (suite *LogicRunnerTestSuite) TestNewLogicRunnerBadPrototypeBudget() {
+	_, err := NewLogicRunner(&configuration.LogicRunner{
+		PrototypeBudgets: []configuration.PrototypeBudget{{Prototype: "not a reference"}},
+	})
+	suite.Error(err)
+}
+
+func (suite *LogicRunnerTestSuite) TestMethodCallMigratesUpgradedObject() {
+	objRef := testutils.RandomRef()
+	protoRef := testutils.RandomRef()
+	codeRef := testutils.RandomRef()
+	previousCodeRef := testutils.RandomRef()
+	reqRef := testutils.RandomRef()
+
+	upgrade, err := insolar.Serialize(codeHistory{{PreviousCode: previousCodeRef, Pulse: 90}})
+	suite.Require().NoError(err)
+
+	od := artifacts.NewObjectDescriptorMock(suite.T())
+	od.PrototypeMock.Return(&protoRef, nil)
+	od.MemoryMock.Return([]byte{1})
+	od.ParentMock.Return(nil)
+	od.StateIDMock.Return(insolar.NewID(80, nil))
+
+	pd := artifacts.NewObjectDescriptorMock(suite.T())
+	pd.CodeMock.Return(&codeRef, nil)
+	pd.MemoryMock.Return(upgrade)
+	pd.HeadRefMock.Return(&protoRef)
+
+	suite.am.GetObjectFunc = func(
+		ctx context.Context, obj insolar.Reference, st *insolar.ID, approved bool,
+	) (artifacts.ObjectDescriptor, error) {
+		switch obj {
+		case objRef:
+			return od, nil
+		case protoRef:
+			return pd, nil
+		}
+		return nil, errors.New("unexpected call")
+	}
+	suite.am.GetCodeFunc = func(ctx context.Context, ref insolar.Reference) (artifacts.CodeDescriptor, error) {
+		suite.Equal(codeRef, ref)
+		cd := artifacts.NewCodeDescriptorMock(suite.T())
+		cd.MachineTypeMock.Return(insolar.MachineTypeBuiltin)
+		cd.RefMock.Return(&codeRef)
+		return cd, nil
+	}
+	suite.am.UpdateObjectFunc = func(
+		ctx context.Context, domain, request insolar.Reference, obj artifacts.ObjectDescriptor, memory []byte,
+	) (artifacts.ObjectDescriptor, error) {
+		suite.Equal([]byte{2}, memory)
+		return od, nil
+	}
+	suite.am.RegisterResultWithEventsMock.Return(nil, nil)
+
+	var calls []string
+	mle := testutils.NewMachineLogicExecutorMock(suite.mc)
+	suite.lr.Executors[insolar.MachineTypeBuiltin] = mle
+	mle.CallMethodFunc = func(
+		_ context.Context, callCtx *insolar.LogicCallContext, _ insolar.Reference, data []byte, method string, _ insolar.Arguments,
+	) ([]byte, insolar.Arguments, error) {
+		calls = append(calls, method)
+		if method == migrateMethod {
+			suite.Equal(objRef, *callCtx.Caller)
+			suite.Equal([]byte{1}, data)
+			return []byte{2}, nil, nil
+		}
+		suite.Equal([]byte{2}, data)
+		return data, nil, nil
+	}
+
+	es := &ExecutionState{
+		Current: &CurrentExecution{
+			LogicContext: &insolar.LogicCallContext{
+				Callee: &objRef,
+				Pulse:  insolar.Pulse{PulseNumber: 100},
+			},
+			Request: &reqRef,
+		},
+	}
+	msg := &message.CallMethod{ObjectRef: objRef, Method: "Get"}
+
+	_, err = suite.lr.executeMethodCall(suite.ctx, es, msg)
+	suite.Require().NoError(err)
+	suite.Equal([]string{migrateMethod, "Get"}, calls)
+	suite.Equal(uint64(1), suite.am.UpdateObjectCounter)
+
+	// object is migrated only once
+	_, err = suite.lr.executeMethodCall(suite.ctx, es, msg)
+	suite.Require().NoError(err)
+	suite.Equal([]string{migrateMethod, "Get", "Get"}, calls)
+	suite.Equal(uint64(1), suite.am.UpdateObjectCounter)
+
+	_, err = suite.lr.executeMethodCall(suite.ctx, es, &message.CallMethod{ObjectRef: objRef, Method: migrateMethod})
+	suite.Error(err)
+}
+
+func (suite *LogicRunnerTestSuite) TestMethodCallMigratesObjectThroughConsecutiveUpgrades() {
+	objRef := testutils.RandomRef()
+	protoRef := testutils.RandomRef()
+	firstCodeRef := testutils.RandomRef()
+	secondCodeRef := testutils.RandomRef()
+	codeRef := testutils.RandomRef()
+	reqRef := testutils.RandomRef()
+
+	history, err := insolar.Serialize(codeHistory{
+		{PreviousCode: firstCodeRef, Pulse: 90},
+		{PreviousCode: secondCodeRef, Pulse: 95},
+	})
+	suite.Require().NoError(err)
+
+	od := artifacts.NewObjectDescriptorMock(suite.T())
+	od.PrototypeMock.Return(&protoRef, nil)
+	od.MemoryMock.Return([]byte{1})
+	od.ParentMock.Return(nil)
+	od.StateIDMock.Return(insolar.NewID(80, nil))
+
+	pd := artifacts.NewObjectDescriptorMock(suite.T())
+	pd.CodeMock.Return(&codeRef, nil)
+	pd.MemoryMock.Return(history)
+	pd.HeadRefMock.Return(&protoRef)
+
+	suite.am.GetObjectFunc = func(
+		ctx context.Context, obj insolar.Reference, st *insolar.ID, approved bool,
+	) (artifacts.ObjectDescriptor, error) {
+		if obj == protoRef {
+			return pd, nil
+		}
+		return od, nil
+	}
+	suite.am.GetCodeFunc = func(ctx context.Context, ref insolar.Reference) (artifacts.CodeDescriptor, error) {
+		suite.Equal(codeRef, ref)
+		cd := artifacts.NewCodeDescriptorMock(suite.T())
+		cd.MachineTypeMock.Return(insolar.MachineTypeBuiltin)
+		cd.RefMock.Return(&codeRef)
+		return cd, nil
+	}
+	suite.am.UpdateObjectFunc = func(
+		ctx context.Context, domain, request insolar.Reference, obj artifacts.ObjectDescriptor, memory []byte,
+	) (artifacts.ObjectDescriptor, error) {
+		suite.Equal([]byte{3}, memory)
+		return od, nil
+	}
+	suite.am.RegisterResultWithEventsMock.Return(nil, nil)
+
+	// every code migrates state written by the previous one
+	var codes []insolar.Reference
+	mle := testutils.NewMachineLogicExecutorMock(suite.mc)
+	suite.lr.Executors[insolar.MachineTypeBuiltin] = mle
+	mle.CallMethodFunc = func(
+		_ context.Context, callCtx *insolar.LogicCallContext, code insolar.Reference, data []byte, method string, _ insolar.Arguments,
+	) ([]byte, insolar.Arguments, error) {
+		codes = append(codes, code)
+		suite.Equal(code, *callCtx.Code)
+		if method == migrateMethod {
+			return []byte{data[0] + 1}, nil, nil
+		}
+		suite.Equal([]byte{3}, data)
+		return data, nil, nil
+	}
+
+	es := &ExecutionState{
+		Current: &CurrentExecution{
+			LogicContext: &insolar.LogicCallContext{
+				Callee: &objRef,
+				Pulse:  insolar.Pulse{PulseNumber: 100},
+			},
+			Request: &reqRef,
+		},
+	}
+
+	_, err = suite.lr.executeMethodCall(suite.ctx, es, &message.CallMethod{ObjectRef: objRef, Method: "Get"})
+	suite.Require().NoError(err)
+	suite.Equal([]insolar.Reference{secondCodeRef, codeRef, codeRef}, codes)
+	suite.Equal(uint64(1), suite.am.UpdateObjectCounter)
+}
+
+func (suite *LogicRunnerTestSuite) TestMethodCallUsesPreviousCodeUntilUpgradeIsInEffect() {
+	objRef := testutils.RandomRef()
+	protoRef := testutils.RandomRef()
+	codeRef := testutils.RandomRef()
+	previousCodeRef := testutils.RandomRef()
+	reqRef := testutils.RandomRef()
+
+	upgrade, err := insolar.Serialize(codeHistory{{PreviousCode: previousCodeRef, Pulse: 100}})
+	suite.Require().NoError(err)
+
+	od := artifacts.NewObjectDescriptorMock(suite.T())
+	od.PrototypeMock.Return(&protoRef, nil)
+	od.MemoryMock.Return([]byte{1})
+	od.ParentMock.Return(nil)
+	od.StateIDMock.Return(insolar.NewID(80, nil))
+
+	pd := artifacts.NewObjectDescriptorMock(suite.T())
+	pd.CodeMock.Return(&codeRef, nil)
+	pd.MemoryMock.Return(upgrade)
+	pd.HeadRefMock.Return(&protoRef)
+
+	suite.am.GetObjectFunc = func(
+		ctx context.Context, obj insolar.Reference, st *insolar.ID, approved bool,
+	) (artifacts.ObjectDescriptor, error) {
+		if obj == protoRef {
+			return pd, nil
+		}
+		return od, nil
+	}
+	suite.am.GetCodeFunc = func(ctx context.Context, ref insolar.Reference) (artifacts.CodeDescriptor, error) {
+		cd := artifacts.NewCodeDescriptorMock(suite.T())
+		cd.MachineTypeMock.Return(insolar.MachineTypeBuiltin)
+		cd.RefMock.Return(&ref)
+		return cd, nil
+	}
+	suite.am.RegisterResultWithEventsMock.Return(nil, nil)
+
+	var codes []insolar.Reference
+	mle := testutils.NewMachineLogicExecutorMock(suite.mc)
+	suite.lr.Executors[insolar.MachineTypeBuiltin] = mle
+	mle.CallMethodFunc = func(
+		_ context.Context, _ *insolar.LogicCallContext, code insolar.Reference, data []byte, method string, _ insolar.Arguments,
+	) ([]byte, insolar.Arguments, error) {
+		suite.NotEqual(migrateMethod, method)
+		codes = append(codes, code)
+		return data, nil, nil
+	}
+
+	es := &ExecutionState{
+		Current: &CurrentExecution{
+			LogicContext: &insolar.LogicCallContext{Pulse: insolar.Pulse{PulseNumber: 100}},
+			Request:      &reqRef,
+		},
+	}
+	msg := &message.CallMethod{ObjectRef: objRef, Method: "Get"}
+
+	_, err = suite.lr.executeMethodCall(suite.ctx, es, msg)
+	suite.Require().NoError(err)
+	suite.Equal([]insolar.Reference{previousCodeRef}, codes)
+
+	// new code is in effect in the next pulse, the object should be migrated by it
+	es.Current.LogicContext.Pulse.PulseNumber = 101
+	mle.CallMethodFunc = func(
+		_ context.Context, _ *insolar.LogicCallContext, code insolar.Reference, data []byte, method string, _ insolar.Arguments,
+	) ([]byte, insolar.Arguments, error) {
+		suite.Equal(codeRef, code)
+		codes = append(codes, code)
+		return data, nil, nil
+	}
+	suite.am.UpdateObjectMock.Return(od, nil)
+
+	_, err = suite.lr.executeMethodCall(suite.ctx, es, msg)
+	suite.Require().NoError(err)
+	suite.Equal([]insolar.Reference{previousCodeRef, codeRef, codeRef}, codes)
+	suite.Equal(uint64(1), suite.am.UpdateObjectCounter)
+}
+
+func (suite *LogicRunnerTestSuite) TestUpgradePrototype() {
+	protoRef := testutils.RandomRef()
+	codeRef := testutils.RandomRef()
+	reqRef := testutils.RandomRef()
+	codeID := testutils.RandomID()
+	rootMember := testutils.RandomRef()
+
+	gdp := testutils.NewGenesisDataProviderMock(suite.mc)
+	gdp.GetRootMemberMock.Return(&rootMember, nil)
+	suite.lr.GenesisDataProvider = gdp
+
+	// only root member is allowed to upgrade
+	_, err := suite.lr.upgradePrototype(suite.ctx, testutils.RandomRef(), reqRef, protoRef, []byte("code"), insolar.MachineTypeBuiltin)
+	suite.Require().Error(err)
+
+	suite.ps.LatestMock.Return(insolar.Pulse{PulseNumber: 100}, nil)
+
+	pd := artifacts.NewObjectDescriptorMock(suite.T())
+	pd.IsPrototypeMock.Return(true)
+	pd.CodeMock.Return(&codeRef, nil)
+	pd.MemoryMock.Return(nil)
+	suite.am.GetObjectMock.Return(pd, nil)
+	suite.am.DeployCodeMock.Expect(suite.ctx, Ref{}, reqRef, []byte("code"), insolar.MachineTypeBuiltin).Return(&codeID, nil)
+
+	var memory []byte
+	suite.am.UpdatePrototypeFunc = func(
+		ctx context.Context, domain, request insolar.Reference, obj artifacts.ObjectDescriptor, mem []byte, code *insolar.Reference,
+	) (artifacts.ObjectDescriptor, error) {
+		suite.Equal(*insolar.NewReference(*protoRef.Domain(), codeID), *code)
+		memory = mem
+		return pd, nil
+	}
+
+	newCode, err := suite.lr.upgradePrototype(suite.ctx, rootMember, reqRef, protoRef, []byte("code"), insolar.MachineTypeBuiltin)
+	suite.Require().NoError(err)
+	suite.Equal(*insolar.NewReference(*protoRef.Domain(), codeID), *newCode)
+	suite.Equal(codeHistory{{PreviousCode: codeRef, Pulse: 100}}, decodeCodeHistory(memory))
+
+	// only one upgrade in a pulse
+	pd.MemoryMock.Return(memory)
+	_, err = suite.lr.upgradePrototype(suite.ctx, rootMember, reqRef, protoRef, []byte("code"), insolar.MachineTypeBuiltin)
+	suite.Error(err)
+
+	// upgrade of the next pulse is added to the history
+	suite.ps.LatestMock.Return(insolar.Pulse{PulseNumber: 101}, nil)
+	pd.CodeMock.Return(newCode, nil)
+	_, err = suite.lr.upgradePrototype(suite.ctx, rootMember, reqRef, protoRef, []byte("code"), insolar.MachineTypeBuiltin)
+	suite.Require().NoError(err)
+	suite.Equal(codeHistory{
+		{PreviousCode: codeRef, Pulse: 100},
+		{PreviousCode: *newCode, Pulse: 101},
+	}, decodeCodeHistory(memory))
+}
+
+func (suite *LogicRunnerTestSuite) TestUpgradePrototypeInImmutableCall() {
+	rpc := &RPC{lr: suite.lr}
+	req := rpctypes.UpUpgradePrototypeReq{
+		UpBaseReq: rpctypes.UpBaseReq{Mode: insolar.ImmutableMode, Callee: testutils.RandomRef()},
+		Prototype: testutils.RandomRef(),
+		Code:      []byte("code"),
+	}
+	err := rpc.UpgradePrototype(req, &rpctypes.UpUpgradePrototypeResp{})
+	suite.Require().Error(err)
+}
+
 func (suite *LogicRunnerTestSuite) TestHandleAbandonedRequestsNotificationMessage() {
 	objectId := testutils.RandomID()
 	msg := &message.AbandonedRequestsNotification{Object: objectId}
@@ -734,6 +1241,7 @@ func (suite *LogicRunnerTestSuite) TestConcurrency() {
 
 	pd := artifacts.NewObjectDescriptorMock(suite.T())
 	pd.CodeMock.Return(&codeRef, nil)
+	pd.MemoryMock.Return(nil)
 	pd.HeadRefMock.Return(&protoRef)
 
 	cd := artifacts.NewCodeDescriptorMock(suite.T())
@@ -760,7 +1268,7 @@ func (suite *LogicRunnerTestSuite) TestConcurrency() {
 	reqId := testutils.RandomID()
 	suite.am.RegisterRequestMock.Return(&reqId, nil)
 	resId := testutils.RandomID()
-	suite.am.RegisterResultMock.Return(&resId, nil)
+	suite.am.RegisterResultWithEventsMock.Return(&resId, nil)
 
 	num := 100
 	wg := sync.WaitGroup{}
@@ -960,6 +1468,7 @@ func (suite *LogicRunnerTestSuite) TestCallMethodWithOnPulse() {
 
 				pd := artifacts.NewObjectDescriptorMock(suite.T())
 				pd.CodeMock.Return(&codeRef, nil)
+				pd.MemoryMock.Return(nil)
 				pd.HeadRefMock.Return(&protoRef)
 
 				cd := artifacts.NewCodeDescriptorMock(suite.T())
@@ -980,8 +1489,8 @@ func (suite *LogicRunnerTestSuite) TestCallMethodWithOnPulse() {
 
 				suite.am.GetCodeMock.Return(cd, nil)
 
-				suite.am.RegisterResultFunc = func(
-					ctx context.Context, r1 insolar.Reference, r2 insolar.Reference, mem []byte,
+				suite.am.RegisterResultWithEventsFunc = func(
+					ctx context.Context, r1 insolar.Reference, r2 insolar.Reference, mem []byte, events []insolar.Event,
 				) (*insolar.ID, error) {
 					resId := testutils.RandomID()
 					return &resId, nil
@@ -1463,3 +1972,65 @@ func (s LRUnsafeGetLedgerPendingRequestTestSuite) TestUnsafeGetLedgerPendingRequ
 	s.Require().Equal(true, es.LedgerHasMoreRequests)
 	s.Require().Equal(parcel, es.LedgerQueueElement.parcel)
 }
+
+func TestReplayBus(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	scheme := platformpolicy.NewPlatformCryptographyScheme()
+
+	getObject := &message.GetObject{Head: testutils.RandomRef()}
+	updateObject := &message.UpdateObject{Object: testutils.RandomRef(), Memory: []byte{1}}
+	getCode := &message.GetCode{Code: testutils.RandomRef()}
+	objRep := &reply.Object{Memory: []byte{1, 2, 3}}
+	codeRep := &reply.Code{MachineType: insolar.MachineTypeBuiltin}
+
+	entry := func(msg insolar.Message, rep insolar.Reply, err error) messagebus.TapeEntry {
+		return messagebus.TapeEntry{
+			MsgHash:  messagebus.GetTapeHash(scheme, msg),
+			Message:  msg,
+			TapeItem: messagebus.TapeItem{Reply: rep, Error: err},
+		}
+	}
+	bus := newReplayBus(scheme, &messagebus.Tape{Entries: []messagebus.TapeEntry{
+		entry(getObject, objRep, nil),
+		entry(updateObject, nil, insolar.ErrDeactivated),
+		entry(getCode, codeRep, nil),
+	}})
+
+	// code is answered in any order and any number of times
+	for i := 0; i < 2; i++ {
+		rep, err := bus.Send(ctx, getCode, nil)
+		require.NoError(t, err)
+		require.Equal(t, codeRep, rep)
+	}
+
+	_, err := bus.Send(ctx, updateObject, nil)
+	require.Error(t, err)
+
+	rep, err := bus.Send(ctx, getObject, nil)
+	require.NoError(t, err)
+	require.Equal(t, objRep, rep)
+
+	_, err = bus.Send(ctx, updateObject, nil)
+	require.Equal(t, insolar.ErrDeactivated, err)
+
+	_, err = bus.Send(ctx, &message.GetCode{Code: testutils.RandomRef()}, nil)
+	require.Error(t, err)
+}
+
+func TestValidationChecker_Result(t *testing.T) {
+	rep := &reply.CallMethod{Result: []byte{1, 2, 3}}
+	checker := &ValidationChecker{
+		cb: NewCaseBindReplay(CaseBind{Requests: []CaseRequest{
+			{Reply: rep},
+			{Error: "call failed"},
+		}}),
+	}
+
+	checker.NextRequest()
+	require.NoError(t, checker.Result(&reply.CallMethod{Result: []byte{1, 2, 3}}, nil))
+	require.Error(t, checker.Result(&reply.CallMethod{Result: []byte{3, 2, 1}}, nil))
+
+	checker.NextRequest()
+	require.NoError(t, checker.Result(nil, errors.New("call failed")))
+	require.Error(t, checker.Result(nil, nil))
+}
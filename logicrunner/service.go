@@ -66,6 +66,22 @@ type RPC struct {
 	lr *LogicRunner
 }
 
+// mustExecutionState returns state of the execution which has made the request
+func (lr *LogicRunner) mustExecutionState(req rpctypes.UpBaseReq) *ExecutionState {
+	if req.Mode == insolar.ImmutableMode {
+		return lr.immutables.mustGet(req.Request)
+	}
+	return lr.MustObjectState(req.Callee).MustModeState(req.Mode)
+}
+
+// checkMutable returns error if the request is made by an immutable call, which can't change objects
+func checkMutable(req rpctypes.UpBaseReq, action string) error {
+	if req.Mode == insolar.ImmutableMode {
+		return errors.Errorf("%s isn't allowed in immutable call", action)
+	}
+	return nil
+}
+
 func recoverRPC(err *error) {
 	if r := recover(); r != nil {
 		if err != nil {
@@ -81,8 +97,7 @@ func recoverRPC(err *error) {
 // GetCode is an RPC retrieving a code by its reference
 func (gpr *RPC) GetCode(req rpctypes.UpGetCodeReq, reply *rpctypes.UpGetCodeResp) (err error) {
 	defer recoverRPC(&err)
-	os := gpr.lr.MustObjectState(req.Callee)
-	es := os.MustModeState(req.Mode)
+	es := gpr.lr.mustExecutionState(req.UpBaseReq)
 	ctx := es.Current.Context
 	// we don't want to record GetCode messages because of cache
 	ctx = insolar.ContextWithMessageBus(ctx, gpr.lr.MessageBus)
@@ -119,11 +134,22 @@ func MakeBaseMessage(req rpctypes.UpBaseReq, es *ExecutionState) message.BaseLog
 func (gpr *RPC) RouteCall(req rpctypes.UpRouteReq, rep *rpctypes.UpRouteResp) (err error) {
 	defer recoverRPC(&err)
 
-	os := gpr.lr.MustObjectState(req.Callee)
-	es := os.MustModeState(req.Mode)
+	es := gpr.lr.mustExecutionState(req.UpBaseReq)
 	ctx := es.Current.Context
 
 	bm := MakeBaseMessage(req.UpBaseReq, es)
+	if req.Mode == insolar.ImmutableMode {
+		if !req.Wait {
+			return errors.New("call without waiting for result isn't allowed in immutable call")
+		}
+		res, err := gpr.lr.ContractRequester.CallImmutable(ctx, &bm, &req.Object, req.Method, req.Arguments, &req.ProxyPrototype)
+		if err != nil {
+			return err
+		}
+		rep.Result = res.(*reply.CallMethod).Result
+		return nil
+	}
+
 	res, err := gpr.lr.ContractRequester.CallMethod(ctx,
 		&bm,
 		!req.Wait,
@@ -147,8 +173,12 @@ func (gpr *RPC) RouteCall(req rpctypes.UpRouteReq, rep *rpctypes.UpRouteResp) (e
 func (gpr *RPC) SaveAsChild(req rpctypes.UpSaveAsChildReq, rep *rpctypes.UpSaveAsChildResp) (err error) {
 	defer recoverRPC(&err)
 
-	os := gpr.lr.MustObjectState(req.Callee)
-	es := os.MustModeState(req.Mode)
+	err = checkMutable(req.UpBaseReq, "saving object as child")
+	if err != nil {
+		return err
+	}
+
+	es := gpr.lr.mustExecutionState(req.UpBaseReq)
 	ctx := es.Current.Context
 
 	bm := MakeBaseMessage(req.UpBaseReq, es)
@@ -163,8 +193,12 @@ func (gpr *RPC) SaveAsChild(req rpctypes.UpSaveAsChildReq, rep *rpctypes.UpSaveA
 func (gpr *RPC) SaveAsDelegate(req rpctypes.UpSaveAsDelegateReq, rep *rpctypes.UpSaveAsDelegateResp) (err error) {
 	defer recoverRPC(&err)
 
-	os := gpr.lr.MustObjectState(req.Callee)
-	es := os.MustModeState(req.Mode)
+	err = checkMutable(req.UpBaseReq, "saving object as delegate")
+	if err != nil {
+		return err
+	}
+
+	es := gpr.lr.mustExecutionState(req.UpBaseReq)
 	ctx := es.Current.Context
 
 	bm := MakeBaseMessage(req.UpBaseReq, es)
@@ -187,8 +221,7 @@ func (gpr *RPC) GetObjChildrenIterator(
 ) {
 	defer recoverRPC(&err)
 
-	os := gpr.lr.MustObjectState(req.Callee)
-	es := os.MustModeState(req.Mode)
+	es := gpr.lr.mustExecutionState(req.UpBaseReq)
 	ctx := es.Current.Context
 
 	am := gpr.lr.ArtifactManager
@@ -258,12 +291,45 @@ func (gpr *RPC) GetObjChildrenIterator(
 	return nil
 }
 
+// GetObjStates is an RPC returns a page of object states, latest first
+func (gpr *RPC) GetObjStates(req rpctypes.UpGetObjStatesReq, rep *rpctypes.UpGetObjStatesResp) (err error) {
+	defer recoverRPC(&err)
+
+	es := gpr.lr.mustExecutionState(req.UpBaseReq)
+	ctx := es.Current.Context
+
+	am := gpr.lr.ArtifactManager
+	state := req.From
+	for len(rep.States) < req.Limit {
+		desc, err := am.GetObject(ctx, req.Obj, state, false)
+		if err != nil {
+			return errors.Wrap(err, "[ GetObjStates ] Can't get object state")
+		}
+
+		s := rpctypes.ObjectState{
+			State:  *desc.StateID(),
+			Memory: desc.Memory(),
+		}
+		if desc.Request() != nil {
+			s.Request = *desc.Request()
+		}
+		rep.States = append(rep.States, s)
+
+		state = desc.PrevStateID()
+		if state == nil {
+			break
+		}
+	}
+	rep.Next = state
+
+	return nil
+}
+
 // GetDelegate is an RPC saving data as memory of a contract as child a parent
 func (gpr *RPC) GetDelegate(req rpctypes.UpGetDelegateReq, rep *rpctypes.UpGetDelegateResp) (err error) {
 	defer recoverRPC(&err)
 
-	os := gpr.lr.MustObjectState(req.Callee)
-	es := os.MustModeState(req.Mode)
+	es := gpr.lr.mustExecutionState(req.UpBaseReq)
 	ctx := es.Current.Context
 
 	am := gpr.lr.ArtifactManager
@@ -275,12 +341,53 @@ func (gpr *RPC) GetDelegate(req rpctypes.UpGetDelegateReq, rep *rpctypes.UpGetDe
 	return nil
 }
 
+// UpgradePrototype is an RPC deploying new code of a prototype
+func (gpr *RPC) UpgradePrototype(req rpctypes.UpUpgradePrototypeReq, rep *rpctypes.UpUpgradePrototypeResp) (err error) {
+	defer recoverRPC(&err)
+
+	err = checkMutable(req.UpBaseReq, "upgrading prototype")
+	if err != nil {
+		return err
+	}
+
+	es := gpr.lr.mustExecutionState(req.UpBaseReq)
+	ctx := es.Current.Context
+
+	code, err := gpr.lr.upgradePrototype(ctx, req.Callee, req.Request, req.Prototype, req.Code, req.MachineType)
+	if err != nil {
+		return err
+	}
+	rep.Code = *code
+	return nil
+}
+
+// Emit is an RPC saving an event emitted by the current call, events are registered with the result of the call
+func (gpr *RPC) Emit(req rpctypes.UpEmitReq, rep *rpctypes.UpEmitResp) (err error) {
+	defer recoverRPC(&err)
+
+	if req.Name == "" {
+		return errors.New("event name is empty")
+	}
+	err = checkMutable(req.UpBaseReq, "emitting event")
+	if err != nil {
+		return err
+	}
+
+	es := gpr.lr.mustExecutionState(req.UpBaseReq)
+	es.Current.Events = append(es.Current.Events, insolar.Event{Name: req.Name, Payload: req.Payload})
+	return nil
+}
+
 // DeactivateObject is an RPC saving data as memory of a contract as child a parent
 func (gpr *RPC) DeactivateObject(req rpctypes.UpDeactivateObjectReq, rep *rpctypes.UpDeactivateObjectResp) (err error) {
 	defer recoverRPC(&err)
 
-	os := gpr.lr.MustObjectState(req.Callee)
-	es := os.MustModeState(req.Mode)
+	err = checkMutable(req.UpBaseReq, "deactivating object")
+	if err != nil {
+		return err
+	}
+
+	es := gpr.lr.mustExecutionState(req.UpBaseReq)
 	es.deactivate = true
 	return nil
 }
@@ -0,0 +1,231 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package logicrunner
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/contractrequester"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/storage/pulse"
+	"github.com/insolar/insolar/logicrunner/artifacts"
+	"github.com/insolar/insolar/messagebus"
+	"github.com/insolar/insolar/platformpolicy"
+)
+
+// startTape replaces message bus of the execution context with recorder, so messages sent by the execution
+// are saved on tape
+func (lr *LogicRunner) startTape(ctx context.Context) context.Context {
+	recorder, err := lr.MessageBus.NewRecorder(ctx, *lr.pulse(ctx))
+	if err != nil {
+		inslogger.FromContext(ctx).Error("couldn't start tape: ", err)
+		return ctx
+	}
+	return insolar.ContextWithMessageBus(ctx, recorder)
+}
+
+// saveTape writes tape of the executed request to the tape directory
+func (lr *LogicRunner) saveTape(ctx context.Context, req *CaseRequest, callCtx *insolar.LogicCallContext) {
+	err := lr.writeTape(ctx, req, callCtx)
+	if err != nil {
+		inslogger.FromContext(ctx).Error("couldn't save tape: ", err)
+	}
+}
+
+func (lr *LogicRunner) writeTape(ctx context.Context, req *CaseRequest, callCtx *insolar.LogicCallContext) error {
+	if req == nil {
+		return errors.New("request isn't registered")
+	}
+	recorder, ok := req.MessageBus.(insolar.TapeWriter)
+	if !ok {
+		return errors.New("request isn't recorded")
+	}
+
+	var buf bytes.Buffer
+	err := recorder.WriteTape(ctx, &buf)
+	if err != nil {
+		return err
+	}
+	tape, err := messagebus.ReadTape(&buf)
+	if err != nil {
+		return err
+	}
+	tape.Header.Request = &messagebus.TapeRequest{
+		Parcel:  req.Parcel,
+		Request: req.Request,
+		Reply:   req.Reply,
+		Error:   req.Error,
+		Nonce:   req.Nonce,
+	}
+	// code requests are cached and may not be recorded, so code of the contract is saved explicitly
+	if callCtx != nil && callCtx.Code != nil {
+		entry, err := lr.codeTapeEntry(ctx, *callCtx.Code)
+		if err != nil {
+			return errors.Wrap(err, "couldn't save code")
+		}
+		tape.Entries = append(tape.Entries, *entry)
+	}
+
+	name := filepath.Join(lr.Cfg.TapeDir, fmt.Sprintf("%d-%s.tape", tape.Header.Pulse, req.Request.Record().String()))
+	f, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	err = tape.Write(f)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+func (lr *LogicRunner) codeTapeEntry(ctx context.Context, code Ref) (*messagebus.TapeEntry, error) {
+	ctx = insolar.ContextWithMessageBus(ctx, lr.MessageBus)
+	desc, err := lr.ArtifactManager.GetCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	data, err := desc.Code()
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &message.GetCode{Code: code}
+	return &messagebus.TapeEntry{
+		MsgHash: messagebus.GetTapeHash(lr.PlatformCryptographyScheme, msg),
+		Message: msg,
+		TapeItem: messagebus.TapeItem{
+			Reply: &reply.Code{Code: data, MachineType: desc.MachineType()},
+		},
+	}, nil
+}
+
+// replayBus answers messages sent by replayed execution. Code requests are answered in any order as they are
+// cached by nodes, other messages are expected in the order they were recorded.
+type replayBus struct {
+	insolar.MessageBus
+	scheme insolar.PlatformCryptographyScheme
+	code   map[string]insolar.Reply
+}
+
+func newReplayBus(scheme insolar.PlatformCryptographyScheme, tape *messagebus.Tape) *replayBus {
+	code := make(map[string]insolar.Reply)
+	calls := *tape
+	calls.Entries = make([]messagebus.TapeEntry, 0, len(tape.Entries))
+	for _, entry := range tape.Entries {
+		if entry.Message != nil && entry.Message.Type() == insolar.TypeGetCode {
+			code[string(entry.MsgHash)] = entry.Reply
+			continue
+		}
+		calls.Entries = append(calls.Entries, entry)
+	}
+	return &replayBus{
+		MessageBus: messagebus.NewTapePlayer(scheme, &calls),
+		scheme:     scheme,
+		code:       code,
+	}
+}
+
+func (b *replayBus) Send(ctx context.Context, msg insolar.Message, ops *insolar.MessageSendOptions) (insolar.Reply, error) {
+	if msg.Type() != insolar.TypeGetCode {
+		return b.MessageBus.Send(ctx, msg, ops)
+	}
+	rep, ok := b.code[string(messagebus.GetTapeHash(b.scheme, msg))]
+	if !ok {
+		return nil, errors.New("code isn't saved on the tape")
+	}
+	return rep, nil
+}
+
+// Replay executes request recorded on the tape in validation mode. Messages sent by the execution are answered from
+// the tape, so neither network nor ledger is required. Error is returned if the execution differs from the recorded one.
+func Replay(ctx context.Context, cfg configuration.LogicRunner, tape *messagebus.Tape) error {
+	req := tape.Header.Request
+	if req == nil {
+		return errors.New("tape has no recorded request")
+	}
+	msg, ok := req.Parcel.Message().(message.IBaseLogicMessage)
+	if !ok {
+		return errors.Errorf("can't replay %s request", req.Parcel.Type())
+	}
+
+	scheme := platformpolicy.NewPlatformCryptographyScheme()
+	bus := newReplayBus(scheme, tape)
+
+	pulses := pulse.NewStorageMem()
+	err := pulses.Append(ctx, insolar.Pulse{PulseNumber: tape.Header.Pulse})
+	if err != nil {
+		return err
+	}
+
+	am := artifacts.NewClient()
+	am.DefaultBus = bus
+	am.PlatformCryptographyScheme = scheme
+	am.PulseAccessor = pulses
+	am.JetStorage = jet.NewStore()
+
+	cr, err := contractrequester.New()
+	if err != nil {
+		return err
+	}
+	cr.MessageBus = bus
+
+	lr, err := NewLogicRunner(&cfg)
+	if err != nil {
+		return err
+	}
+	lr.MessageBus = bus
+	lr.ContractRequester = cr
+	lr.PlatformCryptographyScheme = scheme
+	lr.PulseAccessor = pulses
+	lr.ArtifactManager = am
+
+	err = lr.startExecutors(ctx)
+	if err != nil {
+		return errors.Wrap(err, "couldn't start executors")
+	}
+	defer func() {
+		if err := lr.Stop(ctx); err != nil {
+			inslogger.FromContext(ctx).Error("couldn't stop executors: ", err)
+		}
+	}()
+
+	p, err := pulses.Latest(ctx)
+	if err != nil {
+		return err
+	}
+	cb := CaseBind{Requests: []CaseRequest{{
+		Parcel:     req.Parcel,
+		Request:    req.Request,
+		MessageBus: bus,
+		Reply:      req.Reply,
+		Error:      req.Error,
+		Nonce:      req.Nonce,
+	}}}
+	_, err = lr.Validate(ctx, msg.GetReference(), p, cb)
+	return err
+}
@@ -0,0 +1,151 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package logicrunner
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/logicrunner/artifacts"
+)
+
+// migrateMethod is a method of contract that converts memory of an object written by previous code of its prototype,
+// it's called by logicrunner only
+const migrateMethod = "Migrate"
+
+// codeUpgrade is a replacement of prototype code. PreviousCode is in effect up to Pulse inclusive,
+// the code that replaced it is in effect starting from the next pulse.
+type codeUpgrade struct {
+	PreviousCode insolar.Reference
+	Pulse        insolar.PulseNumber
+}
+
+// codeHistory is kept in memory of a prototype which code was replaced, upgrades are ordered by pulse.
+// Code versions are numbered by upgrades: version i is PreviousCode of i-th upgrade and the last version
+// is the current code of the prototype.
+type codeHistory []codeUpgrade
+
+// decodeCodeHistory returns upgrades stored in memory of a prototype, nil if the prototype was never upgraded
+func decodeCodeHistory(memory []byte) codeHistory {
+	if len(memory) == 0 {
+		return nil
+	}
+	var history codeHistory
+	if err := insolar.Deserialize(memory, &history); err != nil {
+		return nil
+	}
+	return history
+}
+
+// version returns version of code that is in effect in the pulse
+func (h codeHistory) version(pulse insolar.PulseNumber) int {
+	return sort.Search(len(h), func(i int) bool {
+		return pulse <= h[i].Pulse
+	})
+}
+
+// changesAfter returns the last pulse code in effect in the pulse is used in, zero if it's the current code
+func (h codeHistory) changesAfter(pulse insolar.PulseNumber) insolar.PulseNumber {
+	if v := h.version(pulse); v < len(h) {
+		return h[v].Pulse
+	}
+	return 0
+}
+
+// migrations returns codes which Migrate methods convert object state written in the state pulse to
+// the state of code that is in effect in the pulse, in order they should be applied.
+// The code is a reference to code in effect in the pulse.
+func (h codeHistory) migrations(state, pulse insolar.PulseNumber, code *Ref) []Ref {
+	first, last := h.version(state)+1, h.version(pulse)
+	if first > last {
+		return nil
+	}
+	codes := make([]Ref, 0, last-first+1)
+	for v := first; v < last; v++ {
+		codes = append(codes, h[v].PreviousCode)
+	}
+	return append(codes, *code)
+}
+
+// prototypeCode returns reference to code of the prototype that is in effect in the pulse
+func prototypeCode(protoDesc artifacts.ObjectDescriptor, pulse insolar.PulseNumber) (*Ref, error) {
+	codeRef, err := protoDesc.Code()
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get code reference")
+	}
+	history := decodeCodeHistory(protoDesc.Memory())
+	if v := history.version(pulse); v < len(history) {
+		return &history[v].PreviousCode, nil
+	}
+	return codeRef, nil
+}
+
+// upgradePrototype deploys new code and amends the prototype to use it starting from the next pulse,
+// only methods of the root member are allowed to do it
+func (lr *LogicRunner) upgradePrototype(
+	ctx context.Context, caller, request, prototype Ref, code []byte, machineType insolar.MachineType,
+) (
+	*Ref, error,
+) {
+	rootMember, err := lr.GenesisDataProvider.GetRootMember(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get root member")
+	}
+	if caller != *rootMember {
+		return nil, errors.New("only root member can upgrade prototypes")
+	}
+
+	am := lr.ArtifactManager
+	protoDesc, err := am.GetObject(ctx, prototype, nil, false)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get prototype descriptor")
+	}
+	if !protoDesc.IsPrototype() {
+		return nil, errors.New("object is not a prototype")
+	}
+	previousCode, err := protoDesc.Code()
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get code reference")
+	}
+
+	pulse := lr.pulse(ctx).PulseNumber
+	history := decodeCodeHistory(protoDesc.Memory())
+	if len(history) > 0 && history[len(history)-1].Pulse >= pulse {
+		return nil, errors.New("prototype is already upgraded in the current pulse")
+	}
+
+	codeID, err := am.DeployCode(ctx, Ref{}, request, code, machineType)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't deploy code")
+	}
+	codeRef := insolar.NewReference(*prototype.Domain(), *codeID)
+
+	history = append(history, codeUpgrade{PreviousCode: *previousCode, Pulse: pulse})
+	memory, err := insolar.Serialize(history)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't serialize upgrade")
+	}
+	_, err = am.UpdatePrototype(ctx, Ref{}, request, protoDesc, memory, codeRef)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't update prototype")
+	}
+
+	return codeRef, nil
+}
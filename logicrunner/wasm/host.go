@@ -0,0 +1,200 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package wasm
+
+import (
+	"github.com/perlin-network/life/exec"
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/logicrunner/goplugin/rpctypes"
+)
+
+// host holds state of a single contract call and resolves module imports to host functions
+type host struct {
+	callCtx  *insolar.LogicCallContext
+	upstream Upstream
+
+	state  []byte
+	result []byte
+	reply  []byte
+}
+
+func newHost(callCtx *insolar.LogicCallContext, upstream Upstream) *host {
+	return &host{
+		callCtx:  callCtx,
+		upstream: upstream,
+	}
+}
+
+// ResolveFunc implements exec.ImportResolver
+func (h *host) ResolveFunc(module, field string) exec.FunctionImport {
+	if module != "env" {
+		panic(errors.Errorf("unknown import module %q", module))
+	}
+
+	switch field {
+	case "set_state":
+		return h.setState
+	case "set_result":
+		return h.setResult
+	case "get_self":
+		return h.getSelf
+	case "route_call":
+		return h.routeCall
+	case "save_as_child":
+		return h.saveAsChild
+	case "get_delegate":
+		return h.getDelegate
+	case "deactivate_object":
+		return h.deactivateObject
+	case "reply_copy":
+		return h.replyCopy
+	}
+	panic(errors.Errorf("unknown import %q", field))
+}
+
+// ResolveGlobal implements exec.ImportResolver, no globals are exported to modules
+func (h *host) ResolveGlobal(module, field string) int64 {
+	panic(errors.Errorf("unknown global import %s.%s", module, field))
+}
+
+// put copies data into memory allocated by the module
+func (h *host) put(vm *exec.VirtualMachine, data []byte) (int64, error) {
+	alloc, ok := vm.GetFunctionExport("alloc")
+	if !ok {
+		return 0, errors.New("no alloc in the module")
+	}
+	ptr, err := vm.Run(alloc, int64(len(data)))
+	if err != nil {
+		return 0, errors.Wrap(err, "alloc failed")
+	}
+	copy(memory(vm, ptr, int64(len(data))), data)
+	return ptr, nil
+}
+
+func (h *host) baseReq() rpctypes.UpBaseReq {
+	return rpctypes.UpBaseReq{
+		Mode:      h.callCtx.Mode,
+		Callee:    *h.callCtx.Callee,
+		Prototype: *h.callCtx.Prototype,
+		Request:   *h.callCtx.Request,
+	}
+}
+
+// ret stores reply of the upstream call and returns its length or -1 on error
+func (h *host) ret(reply []byte, err error) int64 {
+	if err != nil {
+		h.reply = []byte(err.Error())
+		return -1
+	}
+	h.reply = reply
+	return int64(len(reply))
+}
+
+func (h *host) setState(vm *exec.VirtualMachine) int64 {
+	h.state = append([]byte{}, memory(vm, local(vm, 0), local(vm, 1))...)
+	return 0
+}
+
+func (h *host) setResult(vm *exec.VirtualMachine) int64 {
+	h.result = append([]byte{}, memory(vm, local(vm, 0), local(vm, 1))...)
+	return 0
+}
+
+func (h *host) getSelf(vm *exec.VirtualMachine) int64 {
+	copy(memory(vm, local(vm, 0), insolar.RecordRefSize), h.callCtx.Callee[:])
+	return 0
+}
+
+func (h *host) replyCopy(vm *exec.VirtualMachine) int64 {
+	copy(memory(vm, local(vm, 0), int64(len(h.reply))), h.reply)
+	return 0
+}
+
+func (h *host) routeCall(vm *exec.VirtualMachine) int64 {
+	req := rpctypes.UpRouteReq{
+		UpBaseReq:      h.baseReq(),
+		Object:         reference(vm, local(vm, 0)),
+		Wait:           local(vm, 1) != 0,
+		Method:         string(memory(vm, local(vm, 2), local(vm, 3))),
+		Arguments:      append([]byte{}, memory(vm, local(vm, 4), local(vm, 5))...),
+		ProxyPrototype: reference(vm, local(vm, 6)),
+	}
+
+	res := rpctypes.UpRouteResp{}
+	err := h.upstream.RouteCall(req, &res)
+	return h.ret(res.Result, err)
+}
+
+func (h *host) saveAsChild(vm *exec.VirtualMachine) int64 {
+	req := rpctypes.UpSaveAsChildReq{
+		UpBaseReq:       h.baseReq(),
+		Parent:          reference(vm, local(vm, 0)),
+		Prototype:       reference(vm, local(vm, 1)),
+		ConstructorName: string(memory(vm, local(vm, 2), local(vm, 3))),
+		ArgsSerialized:  append([]byte{}, memory(vm, local(vm, 4), local(vm, 5))...),
+	}
+
+	res := rpctypes.UpSaveAsChildResp{}
+	err := h.upstream.SaveAsChild(req, &res)
+	if err != nil {
+		return h.ret(nil, err)
+	}
+	return h.ret(res.Reference[:], nil)
+}
+
+func (h *host) getDelegate(vm *exec.VirtualMachine) int64 {
+	req := rpctypes.UpGetDelegateReq{
+		UpBaseReq: h.baseReq(),
+		Object:    reference(vm, local(vm, 0)),
+		OfType:    reference(vm, local(vm, 1)),
+	}
+
+	res := rpctypes.UpGetDelegateResp{}
+	err := h.upstream.GetDelegate(req, &res)
+	return h.ret(res.Object[:], err)
+}
+
+func (h *host) deactivateObject(vm *exec.VirtualMachine) int64 {
+	req := rpctypes.UpDeactivateObjectReq{
+		UpBaseReq: h.baseReq(),
+	}
+
+	res := rpctypes.UpDeactivateObjectResp{}
+	err := h.upstream.DeactivateObject(req, &res)
+	return h.ret(nil, err)
+}
+
+// local returns i32 argument of the host function
+func local(vm *exec.VirtualMachine, i int) int64 {
+	return int64(uint32(vm.GetCurrentFrame().Locals[i]))
+}
+
+// memory returns slice of module memory, out of bounds access aborts execution
+func memory(vm *exec.VirtualMachine, ptr, size int64) []byte {
+	if ptr < 0 || size < 0 || ptr+size > int64(len(vm.Memory)) {
+		panic(errors.Errorf("memory access out of bounds: %d+%d", ptr, size))
+	}
+	return vm.Memory[ptr : ptr+size]
+}
+
+func reference(vm *exec.VirtualMachine, ptr int64) insolar.Reference {
+	var ref insolar.Reference
+	copy(ref[:], memory(vm, ptr, insolar.RecordRefSize))
+	return ref
+}
@@ -0,0 +1,60 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package wasm
+
+import (
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+
+	"github.com/insolar/insolar/instrumentation/insmetrics"
+)
+
+var (
+	tagMethodName = insmetrics.MustTagKey("methodName")
+)
+
+var (
+	statWASMContractTime = stats.Float64(
+		"wasm/contract/method/time",
+		"time spent on execution contract, measured in wasm",
+		stats.UnitMilliseconds,
+	)
+	statWASMContractGas = stats.Int64(
+		"wasm/contract/method/gas",
+		"gas spent on execution contract",
+		stats.UnitDimensionless,
+	)
+)
+
+func init() {
+	err := view.Register(
+		&view.View{
+			Measure:     statWASMContractTime,
+			Aggregation: view.Distribution(0.001, 0.01, 0.1, 1, 10, 100, 1000, 5000, 10000, 20000),
+			TagKeys:     []tag.Key{tagMethodName},
+		},
+		&view.View{
+			Measure:     statWASMContractGas,
+			Aggregation: view.Distribution(1e3, 1e4, 1e5, 1e6, 1e7, 1e8),
+			TagKeys:     []tag.Key{tagMethodName},
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,41 @@
+;; Test contract, compiled into contract.wasm
+(module
+  (import "env" "set_state" (func $set_state (param i32 i32)))
+  (import "env" "set_result" (func $set_result (param i32 i32)))
+  (import "env" "get_self" (func $get_self (param i32)))
+
+  (memory (export "memory") 1)
+  (global $heap (mut i32) (i32.const 1024))
+  (data (i32.const 512) "fail")
+
+  (func (export "alloc") (param $size i32) (result i32)
+    global.get $heap
+    global.get $heap
+    local.get $size
+    i32.add
+    global.set $heap)
+
+  ;; returns state and arguments as is
+  (func (export "method_Echo") (param i32 i32 i32 i32) (result i32)
+    (call $set_state (local.get 0) (local.get 1))
+    (call $set_result (local.get 2) (local.get 3))
+    i32.const 0)
+
+  ;; returns reference of the called object
+  (func (export "method_Self") (param i32 i32 i32 i32) (result i32)
+    (call $get_self (i32.const 0))
+    (call $set_state (local.get 0) (local.get 1))
+    (call $set_result (i32.const 0) (i32.const 64))
+    i32.const 0)
+
+  (func (export "method_Fail") (param i32 i32 i32 i32) (result i32)
+    (call $set_result (i32.const 512) (i32.const 4))
+    i32.const 1)
+
+  (func (export "method_Loop") (param i32 i32 i32 i32) (result i32)
+    (loop (br 0))
+    i32.const 0)
+
+  (func (export "constructor_New") (param i32 i32) (result i32)
+    (call $set_state (local.get 0) (local.get 1))
+    i32.const 0))
@@ -0,0 +1,263 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package wasm is an executor of contracts compiled to WebAssembly.
+//
+// Modules are run by embedded interpreter, floating point instructions are disabled
+// and every instruction costs one unit of gas, so execution is the same on every node.
+//
+// A contract module exports its memory and following functions:
+//
+//	alloc(size i32) i32 - allocates buffer in module memory, used to pass data into the module
+//	method_<Name>(state_ptr, state_len, args_ptr, args_len i32) i32 - contract method
+//	constructor_<Name>(args_ptr, args_len i32) i32 - contract constructor
+//
+// Methods and constructors return zero on success, the result buffer holds an error message
+// otherwise. Optional method_Migrate converts state written by previous code of the prototype,
+// state is kept as is when it's not exported. Host functions available in "env" module mirror proxyctx.ProxyHelper:
+//
+//	set_state(ptr, len i32) - sets new object state
+//	set_result(ptr, len i32) - sets serialized results of a method
+//	get_self(ptr i32) - writes reference of the called object
+//	route_call(obj_ptr, wait, method_ptr, method_len, args_ptr, args_len, proto_ptr i32) i32
+//	save_as_child(parent_ptr, proto_ptr, name_ptr, name_len, args_ptr, args_len i32) i32
+//	get_delegate(obj_ptr, type_ptr i32) i32
+//	deactivate_object() i32
+//	reply_copy(ptr i32) - copies reply of the last call into module memory
+//
+// References are passed as insolar.RecordRefSize bytes. Calls return length of the reply
+// or -1 on failure, in which case the reply holds an error message.
+package wasm
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/perlin-network/life/compiler"
+	"github.com/perlin-network/life/exec"
+	"github.com/pkg/errors"
+	"go.opencensus.io/stats"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/instrumentation/insmetrics"
+	"github.com/insolar/insolar/instrumentation/instracer"
+	"github.com/insolar/insolar/logicrunner/artifacts"
+	"github.com/insolar/insolar/logicrunner/goplugin/rpctypes"
+)
+
+// Limits of a contract call, they are part of consensus and must be the same on every node,
+// otherwise nodes would disagree on results of validated calls.
+const (
+	// GasLimit - maximum amount of gas one contract call can spend, one instruction costs one gas unit
+	GasLimit = 10000000
+	// MaxMemoryPages - maximum size of module memory in 64KiB pages
+	MaxMemoryPages = 256
+)
+
+// ErrGasExhausted is returned when contract spends more gas than it's allowed to
+var ErrGasExhausted = errors.New("gas limit exceeded")
+
+var errNoExport = errors.New("no such function exported")
+
+// gasLimitPanic is a panic value of the interpreter that is stopped by gas limit
+const gasLimitPanic = "gas limit exceeded"
+
+// Upstream is a set of logicrunner calls available to contracts
+type Upstream interface {
+	RouteCall(req rpctypes.UpRouteReq, rep *rpctypes.UpRouteResp) error
+	SaveAsChild(req rpctypes.UpSaveAsChildReq, rep *rpctypes.UpSaveAsChildResp) error
+	GetDelegate(req rpctypes.UpGetDelegateReq, rep *rpctypes.UpGetDelegateResp) error
+	DeactivateObject(req rpctypes.UpDeactivateObjectReq, rep *rpctypes.UpDeactivateObjectResp) error
+}
+
+// WASM is an executor of WebAssembly contracts
+type WASM struct {
+	AM       artifacts.Client
+	Upstream Upstream
+
+	vmConfig  exec.VMConfig
+	gasPolicy compiler.GasPolicy
+
+	modulesLock sync.RWMutex
+	modules     map[insolar.Reference]*exec.Module
+}
+
+// NewWASM returns a new WASM executor
+func NewWASM(am artifacts.Client, upstream Upstream) *WASM {
+	return &WASM{
+		AM:       am,
+		Upstream: upstream,
+		vmConfig: exec.VMConfig{
+			MaxMemoryPages:       MaxMemoryPages,
+			DefaultMemoryPages:   1,
+			DefaultTableSize:     65536,
+			GasLimit:             GasLimit,
+			DisableFloatingPoint: true,
+		},
+		gasPolicy: &compiler.SimpleGasPolicy{GasPerInstruction: 1},
+		modules:   make(map[insolar.Reference]*exec.Module),
+	}
+}
+
+// Stop stops executor
+func (w *WASM) Stop() error {
+	return nil
+}
+
+// CallMethod runs a method on an object
+func (w *WASM) CallMethod(
+	ctx context.Context, callCtx *insolar.LogicCallContext,
+	codeRef insolar.Reference, data []byte,
+	method string, args insolar.Arguments,
+) (
+	[]byte, insolar.Arguments, error,
+) {
+	ctx = insmetrics.InsertTag(ctx, tagMethodName, method)
+	ctx, span := instracer.StartSpan(ctx, "wasm.CallMethod")
+	defer span.End()
+
+	if callCtx.Mode == insolar.ImmutableMode && method != "Migrate" {
+		return nil, nil, errors.Errorf("immutable calls aren't supported by wasm contracts, method %s", method)
+	}
+
+	h, err := w.run(ctx, callCtx, codeRef, "method_"+method, data, args)
+	if errors.Cause(err) == errNoExport && method == "Migrate" {
+		return data, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return h.state, h.result, nil
+}
+
+// CallConstructor runs a constructor and returns state of the new object
+func (w *WASM) CallConstructor(
+	ctx context.Context, callCtx *insolar.LogicCallContext,
+	codeRef insolar.Reference, name string, args insolar.Arguments,
+) (
+	[]byte, error,
+) {
+	ctx = insmetrics.InsertTag(ctx, tagMethodName, name)
+	ctx, span := instracer.StartSpan(ctx, "wasm.CallConstructor")
+	defer span.End()
+
+	h, err := w.run(ctx, callCtx, codeRef, "constructor_"+name, args)
+	if err != nil {
+		return nil, err
+	}
+	if h.state == nil {
+		return nil, errors.Errorf("constructor %s didn't set state", name)
+	}
+	return h.state, nil
+}
+
+// run calls exported function of the module, every buffer is passed as a pair of pointer and length
+func (w *WASM) run(
+	ctx context.Context, callCtx *insolar.LogicCallContext,
+	codeRef insolar.Reference, export string, buffers ...[]byte,
+) (*host, error) {
+	module, err := w.getModule(ctx, codeRef)
+	if err != nil {
+		return nil, err
+	}
+
+	h := newHost(callCtx, w.Upstream)
+	vm, err := newVM(module, h)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't instantiate module")
+	}
+
+	entry, ok := vm.GetFunctionExport(export)
+	if !ok {
+		return nil, errors.Wrap(errNoExport, export)
+	}
+
+	params := make([]int64, 0, len(buffers)*2)
+	for _, buf := range buffers {
+		ptr, err := h.put(vm, buf)
+		if err != nil {
+			return nil, err
+		}
+		params = append(params, ptr, int64(len(buf)))
+	}
+
+	start := time.Now()
+	ret, err := vm.Run(entry, params...)
+	stats.Record(ctx,
+		statWASMContractTime.M(float64(time.Since(start).Nanoseconds())/1e6),
+		statWASMContractGas.M(int64(vm.Gas)),
+	)
+	inslogger.FromContext(ctx).Debugf("wasm %s spent %d gas", export, vm.Gas)
+
+	// interpreter stops with a panic before an instruction exceeding the limit, spent gas never goes over it
+	if err != nil && vm.ExitError == gasLimitPanic {
+		return nil, ErrGasExhausted
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "%s failed", export)
+	}
+	if ret != 0 {
+		return nil, errors.Errorf("%s returned error: %s", export, string(h.result))
+	}
+
+	return h, nil
+}
+
+// newVM creates a fresh virtual machine of the compiled module, imports are resolved by the host of the call
+func newVM(module *exec.Module, h *host) (vm *exec.VirtualMachine, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Errorf("%v", r)
+		}
+	}()
+
+	vm = module.NewVirtualMachine()
+	vm.ImportResolver = h
+	return vm, nil
+}
+
+// getModule returns compiled module of the code, modules are compiled once and shared by all calls
+func (w *WASM) getModule(ctx context.Context, codeRef insolar.Reference) (*exec.Module, error) {
+	w.modulesLock.RLock()
+	module, ok := w.modules[codeRef]
+	w.modulesLock.RUnlock()
+	if ok {
+		return module, nil
+	}
+
+	desc, err := w.AM.GetCode(ctx, codeRef)
+	if err != nil {
+		return nil, errors.Wrap(err, "Can't find code")
+	}
+	code, err := desc.Code()
+	if err != nil {
+		return nil, errors.Wrap(err, "Can't get code")
+	}
+
+	// imports are collected only when resolver is set, they are resolved lazily by a VM of every call
+	module, err = exec.NewModule(code, w.vmConfig, &host{}, w.gasPolicy)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't compile module")
+	}
+
+	w.modulesLock.Lock()
+	w.modules[codeRef] = module
+	w.modulesLock.Unlock()
+
+	return module, nil
+}
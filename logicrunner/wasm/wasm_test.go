@@ -0,0 +1,105 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package wasm
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+
+	"github.com/gojuno/minimock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/logicrunner/artifacts"
+	"github.com/insolar/insolar/testutils"
+)
+
+func newTestWASM(t *testing.T, mc *minimock.Controller) (*WASM, *artifacts.ClientMock) {
+	code, err := ioutil.ReadFile("testdata/contract.wasm")
+	require.NoError(t, err)
+
+	desc := artifacts.NewCodeDescriptorMock(mc)
+	desc.CodeMock.Return(code, nil)
+
+	am := artifacts.NewClientMock(mc)
+	am.GetCodeMock.Return(desc, nil)
+
+	w := NewWASM(am, nil)
+	w.vmConfig.GasLimit = 100000
+	return w, am
+}
+
+func TestWASM_CallMethod(t *testing.T) {
+	ctx := context.Background()
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	w, am := newTestWASM(t, mc)
+	codeRef := testutils.RandomRef()
+	callee := testutils.RandomRef()
+	callCtx := &insolar.LogicCallContext{Callee: &callee}
+
+	t.Run("returns state and result", func(t *testing.T) {
+		state, res, err := w.CallMethod(ctx, callCtx, codeRef, []byte("state"), "Echo", []byte("args"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("state"), state)
+		require.Equal(t, insolar.Arguments("args"), res)
+	})
+
+	t.Run("host function", func(t *testing.T) {
+		_, res, err := w.CallMethod(ctx, callCtx, codeRef, []byte("state"), "Self", nil)
+		require.NoError(t, err)
+		require.Equal(t, insolar.Arguments(callee[:]), res)
+	})
+
+	t.Run("contract error", func(t *testing.T) {
+		_, _, err := w.CallMethod(ctx, callCtx, codeRef, []byte("state"), "Fail", nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "fail")
+	})
+
+	t.Run("unknown method", func(t *testing.T) {
+		_, _, err := w.CallMethod(ctx, callCtx, codeRef, []byte("state"), "Unknown", nil)
+		require.Error(t, err)
+	})
+
+	t.Run("missing migration keeps state", func(t *testing.T) {
+		state, _, err := w.CallMethod(ctx, callCtx, codeRef, []byte("state"), "Migrate", nil)
+		require.NoError(t, err)
+		require.Equal(t, []byte("state"), state)
+	})
+
+	t.Run("gas is limited", func(t *testing.T) {
+		_, _, err := w.CallMethod(ctx, callCtx, codeRef, []byte("state"), "Loop", nil)
+		require.Equal(t, ErrGasExhausted, err)
+	})
+
+	require.Equal(t, uint64(1), am.GetCodeCounter, "module is compiled once")
+}
+
+func TestWASM_CallConstructor(t *testing.T) {
+	ctx := context.Background()
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	w, _ := newTestWASM(t, mc)
+
+	state, err := w.CallConstructor(ctx, &insolar.LogicCallContext{}, testutils.RandomRef(), "New", []byte("args"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("args"), state)
+}
@@ -56,6 +56,10 @@ type MessageBus struct {
 	handlers     map[insolar.MessageType]insolar.MessageHandler
 	signmessages bool
 
+	queues         *deliveryQueues
+	busyRetries    int
+	busyRetryDelay time.Duration
+
 	globalLock                  sync.RWMutex
 	NextPulseMessagePoolChan    chan interface{}
 	NextPulseMessagePoolCounter uint32
@@ -65,9 +69,16 @@ type MessageBus struct {
 // NewMessageBus creates plain MessageBus instance. It can be used to create Player and Recorder instances that
 // wrap it, providing additional functionality.
 func NewMessageBus(config configuration.Configuration) (*MessageBus, error) {
+	queues, err := newDeliveryQueues(config.MessageBus)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create delivery queues")
+	}
 	mb := &MessageBus{
 		handlers:                 map[insolar.MessageType]insolar.MessageHandler{},
 		signmessages:             config.Host.SignMessages,
+		queues:                   queues,
+		busyRetries:              config.MessageBus.BusyRetries,
+		busyRetryDelay:           config.MessageBus.BusyRetryDelay,
 		NextPulseMessagePoolChan: make(chan interface{}),
 	}
 	mb.Lock(context.Background())
@@ -83,7 +94,7 @@ func (mb *MessageBus) NewPlayer(ctx context.Context, reader io.Reader) (insolar.
 	if err != nil {
 		return nil, err
 	}
-	pl := newPlayer(mb, tape, mb.PlatformCryptographyScheme, mb.PulseAccessor)
+	pl := newPlayer(mb, tape, mb.PlatformCryptographyScheme)
 	return pl, nil
 }
 
@@ -104,7 +115,10 @@ func (mb *MessageBus) Start(ctx context.Context) error {
 }
 
 // Stop releases resources and stops the bus
-func (mb *MessageBus) Stop(ctx context.Context) error { return nil }
+func (mb *MessageBus) Stop(ctx context.Context) error {
+	mb.queues.stop()
+	return nil
+}
 
 func (mb *MessageBus) Lock(ctx context.Context) {
 	inslogger.FromContext(ctx).Info("Acquire GIL")
@@ -210,14 +224,32 @@ func (mb *MessageBus) SendParcel(
 		return nil, err
 	}
 
+	rep, err := mb.sendToNode(ctx, nodes[0], parcel)
+	// Receiver is overloaded with messages of the parcel priority class, give it time to catch up.
+	for retry := 0; err == nil && isBusy(rep); retry++ {
+		if retry >= mb.busyRetries {
+			return nil, insolar.ErrBusy
+		}
+		select {
+		case <-time.After(mb.busyRetryDelay << uint(retry)):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		stats.Record(ctx, statParcelsBusyRetriesTotal.M(1))
+		rep, err = mb.sendToNode(ctx, nodes[0], parcel)
+	}
+	return rep, err
+}
+
+func (mb *MessageBus) sendToNode(ctx context.Context, node insolar.Reference, parcel insolar.Parcel) (insolar.Reply, error) {
 	// Short path when sending to self node. Skip serialization
 	origin := mb.NodeNetwork.GetOrigin()
-	if nodes[0].Equal(origin.ID()) {
+	if node.Equal(origin.ID()) {
 		stats.Record(ctx, statLocallyDeliveredParcelsTotal.M(1))
 		return mb.doDeliver(parcel.Context(context.Background()), parcel)
 	}
 
-	res, err := mb.Network.SendMessage(nodes[0], deliverRPCMethodName, parcel)
+	res, err := mb.Network.SendMessage(node, deliverRPCMethodName, parcel)
 	if err != nil {
 		return nil, err
 	}
@@ -271,7 +303,7 @@ func (mb *MessageBus) doDeliver(ctx context.Context, msg insolar.Parcel) (insola
 	}
 	// TODO: sergey.morozov 2018-12-21 there is potential race condition because of readBarrier. We must implement correct locking.
 
-	resp, err := handler(ctx, msg)
+	resp, err := mb.queues.handle(ctx, msg, handler)
 	if err != nil {
 		return nil, &serializableError{
 			S: err.Error(),
@@ -29,6 +29,7 @@ import (
 	"github.com/insolar/insolar/configuration"
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/reply"
 	"github.com/insolar/insolar/testutils"
 	"github.com/insolar/insolar/testutils/network"
 )
@@ -174,3 +175,52 @@ func TestMessageBus_doDeliver_TwoAheadPulses(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, insolar.PulseNumber(102), pulse.PulseNumber)
 }
+
+func prepareSend(t *testing.T, ctx context.Context, handler insolar.MessageHandler) (*MessageBus, insolar.Parcel) {
+	mb, _, parcel := prepare(t, ctx, 100, 100)
+	mb.handlers[testType] = handler
+	mb.busyRetries = 2
+	mb.busyRetryDelay = time.Millisecond
+
+	jc := testutils.NewJetCoordinatorMock(t)
+	jc.QueryRoleMock.Return([]insolar.Reference{{}}, nil)
+	mb.JetCoordinator = jc
+
+	parcelMock := parcel.(*testutils.ParcelMock)
+	parcelMock.DefaultTargetMock.Return(&insolar.Reference{})
+	parcelMock.DefaultRoleMock.Return(insolar.DynamicRoleLightExecutor)
+	parcelMock.ContextFunc = func(ctx context.Context) context.Context {
+		return ctx
+	}
+	return mb, parcel
+}
+
+func TestMessageBus_SendParcel_RetriesBusy(t *testing.T) {
+	ctx := context.Background()
+	calls := 0
+	mb, parcel := prepareSend(t, ctx, func(context.Context, insolar.Parcel) (insolar.Reply, error) {
+		calls++
+		if calls <= 2 {
+			return &reply.Error{ErrType: reply.ErrBusy}, nil
+		}
+		return testReply, nil
+	})
+
+	result, err := mb.SendParcel(ctx, parcel, insolar.Pulse{PulseNumber: 100}, nil)
+	require.NoError(t, err)
+	require.Equal(t, testReply, result)
+	require.Equal(t, 3, calls)
+}
+
+func TestMessageBus_SendParcel_StaysBusy(t *testing.T) {
+	ctx := context.Background()
+	calls := 0
+	mb, parcel := prepareSend(t, ctx, func(context.Context, insolar.Parcel) (insolar.Reply, error) {
+		calls++
+		return &reply.Error{ErrType: reply.ErrBusy}, nil
+	})
+
+	_, err := mb.SendParcel(ctx, parcel, insolar.Pulse{PulseNumber: 100}, nil)
+	require.Equal(t, insolar.ErrBusy, err)
+	require.Equal(t, 3, calls)
+}
@@ -25,7 +25,8 @@ import (
 )
 
 var (
-	tagMessageType = insmetrics.MustTagKey("messageType")
+	tagMessageType  = insmetrics.MustTagKey("messageType")
+	tagMessageClass = insmetrics.MustTagKey("messageClass")
 )
 
 var (
@@ -44,6 +45,26 @@ var (
 		"time spent on sending parcels",
 		stats.UnitMilliseconds,
 	)
+	statParcelsBusyTotal = stats.Int64(
+		"messagebus/parcels/busy/count",
+		"number of parcels replied busy because delivery queue was full",
+		stats.UnitDimensionless,
+	)
+	statParcelsBusyRetriesTotal = stats.Int64(
+		"messagebus/parcels/busy/retries/count",
+		"number of parcels resent after busy reply",
+		stats.UnitDimensionless,
+	)
+	statQueueLength = stats.Int64(
+		"messagebus/queue/length",
+		"number of parcels waiting in delivery queue",
+		stats.UnitDimensionless,
+	)
+	statQueueWaitTime = stats.Float64(
+		"messagebus/queue/wait/time",
+		"time parcels spent waiting in delivery queue",
+		stats.UnitMilliseconds,
+	)
 )
 
 func init() {
@@ -63,6 +84,26 @@ func init() {
 			Aggregation: view.Distribution(0.001, 0.01, 0.1, 1, 10, 100, 1000, 5000, 10000, 20000),
 			TagKeys:     []tag.Key{tagMessageType},
 		},
+		&view.View{
+			Measure:     statParcelsBusyTotal,
+			Aggregation: view.Count(),
+			TagKeys:     []tag.Key{tagMessageType, tagMessageClass},
+		},
+		&view.View{
+			Measure:     statParcelsBusyRetriesTotal,
+			Aggregation: view.Count(),
+			TagKeys:     []tag.Key{tagMessageType},
+		},
+		&view.View{
+			Measure:     statQueueLength,
+			Aggregation: view.LastValue(),
+			TagKeys:     []tag.Key{tagMessageClass},
+		},
+		&view.View{
+			Measure:     statQueueWaitTime,
+			Aggregation: view.Distribution(0.001, 0.01, 0.1, 1, 10, 100, 1000, 5000, 10000, 20000),
+			TagKeys:     []tag.Key{tagMessageClass},
+		},
 	)
 	if err != nil {
 		panic(err)
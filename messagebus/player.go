@@ -20,41 +20,35 @@ import (
 	"context"
 
 	"github.com/insolar/insolar/insolar"
-	"github.com/insolar/insolar/ledger/storage/pulse"
 )
 
 // Player is a MessageBus wrapper that replays replies from provided tape. The tape can be created and by Recorder
 // and transferred to player.
 type player struct {
 	sender
-	tape          tape
-	scheme        insolar.PlatformCryptographyScheme
-	pulseAccessor pulse.Accessor
+	tape   tape
+	scheme insolar.PlatformCryptographyScheme
 }
 
 // newPlayer creates player instance. It will replay replies from provided tape.
-func newPlayer(s sender, tape tape, scheme insolar.PlatformCryptographyScheme, pulseAccessor pulse.Accessor) *player {
+func newPlayer(s sender, tape tape, scheme insolar.PlatformCryptographyScheme) *player {
 	return &player{
-		sender:        s,
-		tape:          tape,
-		scheme:        scheme,
-		pulseAccessor: pulseAccessor,
+		sender: s,
+		tape:   tape,
+		scheme: scheme,
 	}
 }
 
+// NewTapePlayer creates player that replies to messages from the tape without sending them. It's used to replay
+// recorded execution offline, only Send method is supported.
+func NewTapePlayer(scheme insolar.PlatformCryptographyScheme, t *Tape) insolar.MessageBus {
+	return newPlayer(nil, newMemoryTapeFromTape(t), scheme)
+}
+
 // Send wraps MessageBus Send to reply replies from the tape. If reply for this message is not on the tape, an error
 // will be returned.
 func (p *player) Send(ctx context.Context, msg insolar.Message, ops *insolar.MessageSendOptions) (insolar.Reply, error) {
-	currentPulse, err := p.pulseAccessor.Latest(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	parcel, err := p.CreateParcel(ctx, msg, ops.Safe().Token, currentPulse)
-	if err != nil {
-		return nil, err
-	}
-	id := GetMessageHash(p.scheme, parcel)
+	id := GetTapeHash(p.scheme, msg)
 
 	item, err := p.tape.Get(ctx, id)
 	if err != nil {
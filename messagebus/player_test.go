@@ -17,15 +17,12 @@
 package messagebus
 
 import (
-	"context"
 	"testing"
 
 	"github.com/gojuno/minimock"
-	"github.com/insolar/insolar/ledger/storage/pulse"
 	"github.com/insolar/insolar/platformpolicy"
 	"github.com/stretchr/testify/require"
 
-	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/message"
 	"github.com/insolar/insolar/insolar/reply"
 	"github.com/insolar/insolar/instrumentation/inslogger"
@@ -39,16 +36,10 @@ func TestPlayer_Send(t *testing.T) {
 
 	ctx := inslogger.TestContext(t)
 	msg := message.GenesisRequest{Name: "test"}
-	parcel := message.Parcel{Msg: &msg}
-	msgHash := GetMessageHash(pcs, &parcel)
+	msgHash := GetTapeHash(pcs, &msg)
 	s := NewsenderMock(mc)
-	s.CreateParcelFunc = func(p context.Context, p2 insolar.Message, p3 insolar.DelegationToken, p4 insolar.Pulse) (r insolar.Parcel, r1 error) {
-		return &parcel, nil
-	}
 	tape := NewtapeMock(mc)
-	pulseStorageMock := pulse.NewAccessorMock(t)
-	pulseStorageMock.LatestMock.Return(*insolar.GenesisPulse, nil)
-	player := newPlayer(s, tape, pcs, pulseStorageMock)
+	player := newPlayer(s, tape, pcs)
 
 	t.Run("with no reply on the Tape doesn't send the message and returns an error", func(t *testing.T) {
 		tape.GetMock.Expect(ctx, msgHash).Return(nil, ErrNoReply)
@@ -0,0 +1,234 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package messagebus
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opencensus.io/stats"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/instrumentation/insmetrics"
+)
+
+// messageClass is a priority class of delivered messages. Every class has its own bounded queue and workers, so bulk
+// messages can't delay handling of latency-sensitive ones.
+type messageClass int
+
+const (
+	classHigh messageClass = iota
+	classNormal
+	classLow
+)
+
+func (c messageClass) String() string {
+	switch c {
+	case classHigh:
+		return "high"
+	case classNormal:
+		return "normal"
+	case classLow:
+		return "low"
+	}
+	return "unknown"
+}
+
+// delivery is a parcel waiting in a queue for a worker to handle it.
+type delivery struct {
+	ctx     context.Context
+	parcel  insolar.Parcel
+	handler insolar.MessageHandler
+	queued  time.Time
+	result  chan deliveryResult
+}
+
+type deliveryResult struct {
+	reply insolar.Reply
+	err   error
+}
+
+// deliveryQueue is a bounded queue of parcels of a priority class handled by a fixed pool of workers.
+type deliveryQueue struct {
+	class    messageClass
+	queue    chan *delivery
+	stopped  chan struct{}
+	stopOnce sync.Once
+}
+
+// newDeliveryQueue starts workers of the class. It returns nil if the class has no workers, parcels of such class are
+// handled on the caller goroutine.
+func newDeliveryQueue(class messageClass, cfg configuration.DeliveryQueue) *deliveryQueue {
+	if cfg.Workers <= 0 {
+		return nil
+	}
+	q := &deliveryQueue{
+		class:   class,
+		queue:   make(chan *delivery, cfg.Length),
+		stopped: make(chan struct{}),
+	}
+	for i := 0; i < cfg.Workers; i++ {
+		go q.work()
+	}
+	return q
+}
+
+// handle passes parcel to a worker and waits for the handler reply. If the queue is full, parcel is not handled and
+// busy reply is returned, so sender can retry later.
+func (q *deliveryQueue) handle(
+	ctx context.Context, parcel insolar.Parcel, handler insolar.MessageHandler,
+) (insolar.Reply, error) {
+	ctx = insmetrics.InsertTag(ctx, tagMessageType, parcel.Type().String())
+	ctx = insmetrics.InsertTag(ctx, tagMessageClass, q.class.String())
+
+	d := &delivery{
+		ctx:     ctx,
+		parcel:  parcel,
+		handler: handler,
+		queued:  time.Now(),
+		result:  make(chan deliveryResult, 1),
+	}
+	select {
+	case q.queue <- d:
+		stats.Record(ctx, statQueueLength.M(int64(len(q.queue))))
+	default:
+		stats.Record(ctx, statParcelsBusyTotal.M(1))
+		inslogger.FromContext(ctx).Warnf("delivery queue of %s priority messages is full", q.class)
+		return &reply.Error{ErrType: reply.ErrBusy}, nil
+	}
+
+	select {
+	case res := <-d.result:
+		return res.reply, res.err
+	case <-q.stopped:
+		return nil, errors.New("message bus is stopped")
+	}
+}
+
+func (q *deliveryQueue) work() {
+	for {
+		select {
+		case d := <-q.queue:
+			stats.Record(
+				d.ctx,
+				statQueueLength.M(int64(len(q.queue))),
+				statQueueWaitTime.M(float64(time.Since(d.queued).Nanoseconds())/1e6),
+			)
+			rep, err := d.handler(d.ctx, d.parcel)
+			d.result <- deliveryResult{reply: rep, err: err}
+		case <-q.stopped:
+			return
+		}
+	}
+}
+
+func (q *deliveryQueue) stop() {
+	q.stopOnce.Do(func() {
+		close(q.stopped)
+	})
+}
+
+// deliveryQueues routes parcels to the queues of their priority classes.
+type deliveryQueues struct {
+	byType map[insolar.MessageType]*deliveryQueue
+	// normal gets parcels of types not listed in any class.
+	normal *deliveryQueue
+	all    []*deliveryQueue
+}
+
+func newDeliveryQueues(cfg configuration.MessageBus) (*deliveryQueues, error) {
+	classes := []struct {
+		class messageClass
+		cfg   configuration.DeliveryQueue
+	}{
+		{class: classHigh, cfg: cfg.High},
+		{class: classNormal, cfg: cfg.Normal},
+		{class: classLow, cfg: cfg.Low},
+	}
+
+	types := map[insolar.MessageType]messageClass{}
+	for _, c := range classes {
+		for _, name := range c.cfg.Types {
+			t, ok := messageTypeByName(name)
+			if !ok {
+				return nil, errors.Errorf("unknown message type %s in %s priority class", name, c.class)
+			}
+			if other, ok := types[t]; ok {
+				return nil, errors.Errorf("message type %s is listed in both %s and %s priority classes", name, other, c.class)
+			}
+			types[t] = c.class
+		}
+	}
+
+	qs := &deliveryQueues{byType: map[insolar.MessageType]*deliveryQueue{}}
+	byClass := map[messageClass]*deliveryQueue{}
+	for _, c := range classes {
+		q := newDeliveryQueue(c.class, c.cfg)
+		if q != nil {
+			qs.all = append(qs.all, q)
+		}
+		byClass[c.class] = q
+	}
+	for t, class := range types {
+		qs.byType[t] = byClass[class]
+	}
+	qs.normal = byClass[classNormal]
+	return qs, nil
+}
+
+func (qs *deliveryQueues) handle(
+	ctx context.Context, parcel insolar.Parcel, handler insolar.MessageHandler,
+) (insolar.Reply, error) {
+	q, ok := qs.byType[parcel.Type()]
+	if !ok {
+		q = qs.normal
+	}
+	if q == nil {
+		return handler(ctx, parcel)
+	}
+	return q.handle(ctx, parcel, handler)
+}
+
+func (qs *deliveryQueues) stop() {
+	for _, q := range qs.all {
+		q.stop()
+	}
+}
+
+func messageTypeByName(name string) (insolar.MessageType, bool) {
+	for t := insolar.MessageType(0); ; t++ {
+		s := t.String()
+		if strings.HasPrefix(s, "MessageType(") {
+			return 0, false
+		}
+		if s == name {
+			return t, true
+		}
+	}
+}
+
+// isBusy checks if receiver replied that it is busy.
+func isBusy(rep insolar.Reply) bool {
+	errRep, ok := rep.(*reply.Error)
+	return ok && errRep.ErrType == reply.ErrBusy
+}
@@ -0,0 +1,108 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package messagebus
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/testutils"
+)
+
+func TestNewDeliveryQueues(t *testing.T) {
+	qs, err := newDeliveryQueues(configuration.NewMessageBus())
+	require.NoError(t, err)
+	defer qs.stop()
+
+	require.Len(t, qs.all, 3)
+	assert.Equal(t, classHigh, qs.byType[insolar.TypeCallMethod].class)
+	assert.Equal(t, classLow, qs.byType[insolar.TypeHeavyPayload].class)
+	_, ok := qs.byType[insolar.TypeSetRecord]
+	assert.False(t, ok)
+	assert.Equal(t, classNormal, qs.normal.class)
+}
+
+func TestNewDeliveryQueues_InvalidTypes(t *testing.T) {
+	cfg := configuration.NewMessageBus()
+	cfg.Low.Types = append(cfg.Low.Types, "TypeUnknown")
+	_, err := newDeliveryQueues(cfg)
+	require.Error(t, err)
+
+	cfg = configuration.NewMessageBus()
+	cfg.Low.Types = append(cfg.Low.Types, "TypeCallMethod")
+	_, err = newDeliveryQueues(cfg)
+	require.Error(t, err)
+}
+
+func TestDeliveryQueues_NoWorkers(t *testing.T) {
+	qs, err := newDeliveryQueues(configuration.MessageBus{})
+	require.NoError(t, err)
+
+	parcel := testutils.NewParcelMock(t)
+	parcel.TypeMock.Return(insolar.TypeCallMethod)
+	result, err := qs.handle(context.Background(), parcel, testHandler)
+	require.NoError(t, err)
+	require.Equal(t, testReply, result)
+}
+
+func TestDeliveryQueue_Busy(t *testing.T) {
+	ctx := context.Background()
+	q := newDeliveryQueue(classLow, configuration.DeliveryQueue{Workers: 1, Length: 1})
+	defer q.stop()
+
+	parcel := testutils.NewParcelMock(t)
+	parcel.TypeMock.Return(insolar.TypeHeavyPayload)
+
+	started := make(chan struct{}, 2)
+	release := make(chan struct{})
+	blocking := func(context.Context, insolar.Parcel) (insolar.Reply, error) {
+		started <- struct{}{}
+		<-release
+		return testReply, nil
+	}
+
+	results := make(chan insolar.Reply, 2)
+	deliver := func() {
+		rep, err := q.handle(ctx, parcel, blocking)
+		require.NoError(t, err)
+		results <- rep
+	}
+
+	// The first parcel occupies the only worker, the second one fills the queue.
+	go deliver()
+	<-started
+	go deliver()
+	for len(q.queue) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	result, err := q.handle(ctx, parcel, blocking)
+	require.NoError(t, err)
+	require.Equal(t, &reply.Error{ErrType: reply.ErrBusy}, result)
+	require.True(t, isBusy(result))
+
+	close(release)
+	assert.Equal(t, testReply, <-results)
+	assert.Equal(t, testReply, <-results)
+}
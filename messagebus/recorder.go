@@ -65,8 +65,8 @@ func (r *recorder) Send(ctx context.Context, msg insolar.Message, ops *insolar.M
 	rep, sendErr := r.SendParcel(ctx, parcel, currentPulse, ops)
 
 	// Save the received Value on the tape.
-	id := GetMessageHash(r.scheme, parcel)
-	err = r.tape.Set(ctx, id, rep, sendErr)
+	id := GetTapeHash(r.scheme, msg)
+	err = r.tape.Set(ctx, id, msg, rep, sendErr)
 	if err != nil {
 		return nil, err
 	}
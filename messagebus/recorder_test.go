@@ -39,7 +39,7 @@ func TestRecorder_Send(t *testing.T) {
 	ctx := inslogger.TestContext(t)
 	msg := message.GenesisRequest{Name: "test"}
 	parcel := message.Parcel{Msg: &msg}
-	msgHash := GetMessageHash(pcs, &parcel)
+	msgHash := GetTapeHash(pcs, &msg)
 	expectedRep := reply.Object{Memory: []byte{1, 2, 3}}
 	s := NewsenderMock(mc)
 	s.CreateParcelFunc = func(p context.Context, p2 insolar.Message, p3 insolar.DelegationToken, p4 insolar.Pulse) (r insolar.Parcel, r1 error) {
@@ -52,7 +52,7 @@ func TestRecorder_Send(t *testing.T) {
 	recorder := newRecorder(s, tape, pcs, pulseStorageMock)
 
 	t.Run("with no reply on the tape sends the message and returns reply", func(t *testing.T) {
-		tape.SetMock.Expect(ctx, msgHash, &expectedRep, nil).Return(nil)
+		tape.SetMock.Expect(ctx, msgHash, &msg, &expectedRep, nil).Return(nil)
 		s.SendParcelMock.Expect(ctx, &parcel, *insolar.GenesisPulse, nil).Return(&expectedRep, nil)
 
 		recorderReply, err := recorder.Send(ctx, &msg, nil)
@@ -17,18 +17,30 @@
 package messagebus
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"fmt"
 	"io"
+	"sync"
 
 	"github.com/pkg/errors"
 	"github.com/ugorji/go/codec"
 
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/message"
 	"github.com/insolar/insolar/insolar/reply"
 )
 
+// TapeVersion is the version of tape format written by recorder.
+//
+// Tape starts with magic bytes followed by CBOR encoded header and CBOR encoded list of items. Structs are encoded as
+// maps with field names as keys, so tape can be inspected by any CBOR reader. Tapes written before the format was
+// versioned (CBOR encoded pulse number followed by items) are read as version 0.
+const TapeVersion = 1
+
+var tapeMagic = []byte("INSTAPE")
+
 // Tape is an abstraction for saving replies for messages and restoring them.
 //
 // There can be many active tapes simultaneously and they do not share saved replies.
@@ -36,7 +48,7 @@ import (
 type tape interface {
 	Write(ctx context.Context, writer io.Writer) error
 	Get(ctx context.Context, msgHash []byte) (*TapeItem, error)
-	Set(ctx context.Context, msgHash []byte, rep insolar.Reply, gotError error) error
+	Set(ctx context.Context, msgHash []byte, msg insolar.Message, rep insolar.Reply, gotError error) error
 }
 
 // TapeItem stores reply/error pair for tape.
@@ -45,101 +57,355 @@ type TapeItem struct {
 	Error error
 }
 
-// memoryTape saves and fetches message reply/error pairs to/from memory array.
-//
-// It uses <storageTape id> + <message hash> for Value keys.
-type memoryTape struct {
-	pulse   insolar.PulseNumber
-	storage []memoryTapeMessage
+// TapeEntry is a message sent during recording with its reply/error pair.
+type TapeEntry struct {
+	MsgHash []byte
+	// Message is nil for tapes of version 0.
+	Message insolar.Message
+	TapeItem
 }
 
-type memoryTapeMessage struct {
-	MsgHash []byte
-	Item    TapeItem
+// TapeRequest is a request which execution was recorded on tape. It's required to replay the execution.
+type TapeRequest struct {
+	Parcel  insolar.Parcel
+	Request insolar.Reference
+	Reply   insolar.Reply
+	Error   string
+	// Nonce is a nonce of the executor before the request, messages sent by the execution depend on it.
+	Nonce uint64
+}
+
+// TapeHeader describes recorded tape.
+type TapeHeader struct {
+	Version uint32
+	Pulse   insolar.PulseNumber
+	// Request is set when tape is saved with the request which execution was recorded.
+	Request *TapeRequest
+}
+
+// Tape is a decoded tape file.
+type Tape struct {
+	Header  TapeHeader
+	Entries []TapeEntry
+}
+
+// TapeError is an error read from tape when its type is not known to the reader.
+type TapeError struct {
+	// Type is the name of the recorded error type.
+	Type    string
+	Message string
+}
+
+// Error implements error interface.
+func (e *TapeError) Error() string {
+	return e.Message
+}
+
+// tapeErrors are errors restored from tape by their names, so restored errors can be compared with them.
+var tapeErrors = map[string]error{
+	"insolar.ErrUnknown":                 insolar.ErrUnknown,
+	"insolar.ErrDeactivated":             insolar.ErrDeactivated,
+	"insolar.ErrStateNotAvailable":       insolar.ErrStateNotAvailable,
+	"insolar.ErrHotDataTimeout":          insolar.ErrHotDataTimeout,
+	"insolar.ErrNoPendingRequest":        insolar.ErrNoPendingRequest,
+	"insolar.ErrNotFound":                insolar.ErrNotFound,
+	"insolar.ErrTooManyPendingRequests":  insolar.ErrTooManyPendingRequests,
+	"insolar.ErrExecutionBudgetExceeded": insolar.ErrExecutionBudgetExceeded,
+	"insolar.ErrBusy":                    insolar.ErrBusy,
+	"messagebus.ErrNoReply":              ErrNoReply,
+}
+
+const (
+	errorKindKnown = "known"
+	errorKindReply = "reply"
+	errorKindOther = "other"
+)
+
+// wrappedTapeError is a known error restored with the message it was wrapped with.
+type wrappedTapeError struct {
+	message string
+	cause   error
+}
+
+func (e *wrappedTapeError) Error() string {
+	return e.message
+}
+
+// Cause returns the known error, it's used by errors.Cause.
+func (e *wrappedTapeError) Cause() error {
+	return e.cause
+}
+
+type headerBlob struct {
+	Version uint32
+	Pulse   insolar.PulseNumber
+	Request *requestBlob
+}
+
+type requestBlob struct {
+	ParcelB []byte
+	Request insolar.Reference
+	ReplyB  []byte
+	Error   string
+	Nonce   uint64
 }
 
 type itemBlob struct {
-	MsgHash []byte
+	MsgHash  []byte
+	MessageB []byte
+	ReplyB   []byte
+	// ErrorB is an error message in tapes of version 0.
+	ErrorB []byte
+	Error  *errorBlob
+}
+
+type errorBlob struct {
+	Kind    string
+	Type    string
+	Message string
 	ReplyB  []byte
-	ErrorB  []byte
 }
 
-func newMemoryTape(pulse insolar.PulseNumber) *memoryTape {
-	return &memoryTape{
-		pulse: pulse,
+func encodeTapeError(err error) *errorBlob {
+	cause := errors.Cause(err)
+	for name, known := range tapeErrors {
+		if cause == known {
+			return &errorBlob{Kind: errorKindKnown, Type: name, Message: err.Error()}
+		}
 	}
+	if rep, ok := cause.(insolar.Reply); ok {
+		return &errorBlob{
+			Kind:    errorKindReply,
+			Type:    fmt.Sprintf("%T", cause),
+			Message: err.Error(),
+			ReplyB:  reply.ToBytes(rep),
+		}
+	}
+	return &errorBlob{Kind: errorKindOther, Type: fmt.Sprintf("%T", cause), Message: err.Error()}
 }
 
-func newMemoryTapeFromReader(ctx context.Context, r io.Reader) (*memoryTape, error) {
-	t := memoryTape{}
-	ch := new(codec.CborHandle)
-	decoder := codec.NewDecoder(r, ch)
-	err := decoder.Decode(&t.pulse)
-	if err != nil {
-		return nil, errors.Wrap(err, "[ MemoryTape ] can't read pulse")
+// decodeTapeError restores recorded error, errors of unknown types are restored as TapeError.
+func decodeTapeError(blob *errorBlob) error {
+	var cause error
+	switch blob.Kind {
+	case errorKindKnown:
+		cause = tapeErrors[blob.Type]
+	case errorKindReply:
+		rep, err := reply.Deserialize(bytes.NewReader(blob.ReplyB))
+		if err == nil {
+			cause, _ = rep.(error)
+		}
+	}
+	if cause == nil {
+		return &TapeError{Type: blob.Type, Message: blob.Message}
 	}
+	if cause.Error() == blob.Message {
+		return cause
+	}
+	return &wrappedTapeError{message: blob.Message, cause: cause}
+}
 
-	var storageBlobs []itemBlob
-	err = decoder.Decode(&storageBlobs)
-	if err != nil {
-		return nil, errors.Wrap(err, "[ MemoryTape ] can't read storage")
+// ReadTape reads tape of current or previous versions.
+func ReadTape(r io.Reader) (*Tape, error) {
+	br := bufio.NewReader(r)
+	magic, err := br.Peek(len(tapeMagic))
+	if err != nil && err != io.EOF {
+		return nil, errors.Wrap(err, "[ ReadTape ] can't read tape")
 	}
-	storage := make([]memoryTapeMessage, 0, len(storageBlobs))
-	for _, blob := range storageBlobs {
-		item := TapeItem{}
-		if blob.ReplyB != nil {
-			rep, err := reply.Deserialize(bytes.NewReader(blob.ReplyB))
+
+	decoder := codec.NewDecoder(br, new(codec.CborHandle))
+	t := Tape{}
+	if bytes.Equal(magic, tapeMagic) {
+		_, err = br.Discard(len(tapeMagic))
+		if err != nil {
+			return nil, errors.Wrap(err, "[ ReadTape ] can't read tape")
+		}
+		var header headerBlob
+		err = decoder.Decode(&header)
+		if err != nil {
+			return nil, errors.Wrap(err, "[ ReadTape ] can't read header")
+		}
+		if header.Version > TapeVersion {
+			return nil, errors.Errorf("[ ReadTape ] unsupported tape version %d", header.Version)
+		}
+		t.Header.Version = header.Version
+		t.Header.Pulse = header.Pulse
+		if header.Request != nil {
+			t.Header.Request, err = decodeTapeRequest(header.Request)
 			if err != nil {
-				return nil, err
+				return nil, errors.Wrap(err, "[ ReadTape ] can't read request")
 			}
-			item.Reply = rep
 		}
-		if blob.ErrorB != nil {
-			item.Error = fmt.Errorf(string(blob.ErrorB))
+	} else {
+		err = decoder.Decode(&t.Header.Pulse)
+		if err != nil {
+			return nil, errors.Wrap(err, "[ ReadTape ] can't read pulse")
+		}
+	}
+
+	var storageBlobs []itemBlob
+	err = decoder.Decode(&storageBlobs)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ ReadTape ] can't read storage")
+	}
+	t.Entries = make([]TapeEntry, 0, len(storageBlobs))
+	for i, blob := range storageBlobs {
+		entry, err := decodeTapeEntry(blob)
+		if err != nil {
+			return nil, errors.Wrapf(err, "[ ReadTape ] can't read item %d", i)
 		}
-		storage = append(storage, memoryTapeMessage{
-			MsgHash: blob.MsgHash,
-			Item:    item,
-		})
+		t.Entries = append(t.Entries, entry)
 	}
-	t.storage = storage
 	return &t, nil
 }
 
-func (t *memoryTape) Write(ctx context.Context, w io.Writer) error {
-	encoder := codec.NewEncoder(w, new(codec.CborHandle))
+func decodeTapeRequest(blob *requestBlob) (*TapeRequest, error) {
+	req := TapeRequest{
+		Request: blob.Request,
+		Error:   blob.Error,
+		Nonce:   blob.Nonce,
+	}
+	parcel, err := message.DeserializeParcel(bytes.NewReader(blob.ParcelB))
+	if err != nil {
+		return nil, err
+	}
+	req.Parcel = parcel
+	if blob.ReplyB != nil {
+		req.Reply, err = reply.Deserialize(bytes.NewReader(blob.ReplyB))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &req, nil
+}
 
-	err := encoder.Encode(t.pulse)
+func decodeTapeEntry(blob itemBlob) (TapeEntry, error) {
+	entry := TapeEntry{MsgHash: blob.MsgHash}
+	var err error
+	if blob.MessageB != nil {
+		entry.Message, err = message.Deserialize(bytes.NewReader(blob.MessageB))
+		if err != nil {
+			return entry, errors.Wrap(err, "can't read message")
+		}
+	}
+	if blob.ReplyB != nil {
+		entry.Reply, err = reply.Deserialize(bytes.NewReader(blob.ReplyB))
+		if err != nil {
+			return entry, errors.Wrap(err, "can't read reply")
+		}
+	}
+	if blob.Error != nil {
+		entry.Error = decodeTapeError(blob.Error)
+	} else if blob.ErrorB != nil {
+		entry.Error = errors.New(string(blob.ErrorB))
+	}
+	return entry, nil
+}
+
+// Write writes tape in current format version.
+func (t *Tape) Write(w io.Writer) error {
+	_, err := w.Write(tapeMagic)
+	if err != nil {
+		return errors.Wrap(err, "[ Tape ] can't write header")
+	}
+
+	header := headerBlob{
+		Version: TapeVersion,
+		Pulse:   t.Header.Pulse,
+	}
+	if req := t.Header.Request; req != nil {
+		header.Request = &requestBlob{
+			ParcelB: message.ParcelToBytes(req.Parcel),
+			Request: req.Request,
+			Error:   req.Error,
+			Nonce:   req.Nonce,
+		}
+		if req.Reply != nil {
+			header.Request.ReplyB = reply.ToBytes(req.Reply)
+		}
+	}
+
+	encoder := codec.NewEncoder(w, new(codec.CborHandle))
+	err = encoder.Encode(header)
 	if err != nil {
-		return errors.Wrap(err, "[ MemoryTape ] can't write pulse")
+		return errors.Wrap(err, "[ Tape ] can't write header")
 	}
 
 	// TODO: remove once https://github.com/ugorji/go/issues/278
 	// is resolved
 	encoder.Reset(w)
 
-	storageBlobs := make([]itemBlob, 0, len(t.storage))
-	for _, record := range t.storage {
+	storageBlobs := make([]itemBlob, 0, len(t.Entries))
+	for _, entry := range t.Entries {
 		blob := itemBlob{
-			MsgHash: record.MsgHash,
+			MsgHash: entry.MsgHash,
+		}
+		if entry.Message != nil {
+			blob.MessageB = message.ToBytes(entry.Message)
 		}
-		if record.Item.Reply != nil {
-			blob.ReplyB = reply.ToBytes(record.Item.Reply)
+		if entry.Reply != nil {
+			blob.ReplyB = reply.ToBytes(entry.Reply)
 		}
-		if record.Item.Error != nil {
-			// TODO: preserve error type (use gob?) - 24.Dec.2018 - @nordicdyno
-			blob.ErrorB = []byte(record.Item.Error.Error())
+		if entry.Error != nil {
+			blob.Error = encodeTapeError(entry.Error)
 		}
 		storageBlobs = append(storageBlobs, blob)
 	}
 	err = encoder.Encode(storageBlobs)
 	if err != nil {
-		return errors.Wrap(err, "[ MemoryTape ] can't write storage")
+		return errors.Wrap(err, "[ Tape ] can't write storage")
 	}
 	return nil
 }
 
+// memoryTape saves and fetches message reply/error pairs to/from memory array.
+type memoryTape struct {
+	lock    sync.Mutex
+	pulse   insolar.PulseNumber
+	storage []TapeEntry
+}
+
+func newMemoryTape(pulse insolar.PulseNumber) *memoryTape {
+	return &memoryTape{
+		pulse: pulse,
+	}
+}
+
+func newMemoryTapeFromReader(ctx context.Context, r io.Reader) (*memoryTape, error) {
+	t, err := ReadTape(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ MemoryTape ] can't read tape")
+	}
+	return newMemoryTapeFromTape(t), nil
+}
+
+func newMemoryTapeFromTape(t *Tape) *memoryTape {
+	storage := make([]TapeEntry, len(t.Entries))
+	copy(storage, t.Entries)
+	return &memoryTape{
+		pulse:   t.Header.Pulse,
+		storage: storage,
+	}
+}
+
+func (t *memoryTape) Write(ctx context.Context, w io.Writer) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	tape := Tape{
+		Header: TapeHeader{
+			Version: TapeVersion,
+			Pulse:   t.pulse,
+		},
+		Entries: t.storage,
+	}
+	return tape.Write(w)
+}
+
 func (t *memoryTape) Get(ctx context.Context, msgHash []byte) (*TapeItem, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
 	if len(t.storage) == 0 {
 		return nil, errors.New("Validation error. Message is not expected")
 	}
@@ -150,13 +416,17 @@ func (t *memoryTape) Get(ctx context.Context, msgHash []byte) (*TapeItem, error)
 	}
 	t.storage = t.storage[1:]
 
-	return &tapeMsg.Item, nil
+	return &tapeMsg.TapeItem, nil
 }
 
-func (t *memoryTape) Set(ctx context.Context, msgHash []byte, rep insolar.Reply, gotError error) error {
-	t.storage = append(t.storage, memoryTapeMessage{
+func (t *memoryTape) Set(ctx context.Context, msgHash []byte, msg insolar.Message, rep insolar.Reply, gotError error) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.storage = append(t.storage, TapeEntry{
 		MsgHash: msgHash,
-		Item: TapeItem{
+		Message: msg,
+		TapeItem: TapeItem{
 			Reply: rep,
 			Error: gotError,
 		},
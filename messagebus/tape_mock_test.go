@@ -26,7 +26,7 @@ type tapeMock struct {
 	GetPreCounter uint64
 	GetMock       mtapeMockGet
 
-	SetFunc       func(p context.Context, p1 []byte, p2 insolar.Reply, p3 error) (r error)
+	SetFunc       func(p context.Context, p1 []byte, p2 insolar.Message, p3 insolar.Reply, p4 error) (r error)
 	SetCounter    uint64
 	SetPreCounter uint64
 	SetMock       mtapeMockSet
@@ -217,8 +217,9 @@ type tapeMockSetExpectation struct {
 type tapeMockSetInput struct {
 	p  context.Context
 	p1 []byte
-	p2 insolar.Reply
-	p3 error
+	p2 insolar.Message
+	p3 insolar.Reply
+	p4 error
 }
 
 type tapeMockSetResult struct {
@@ -226,14 +227,14 @@ type tapeMockSetResult struct {
 }
 
 //Expect specifies that invocation of tape.Set is expected from 1 to Infinity times
-func (m *mtapeMockSet) Expect(p context.Context, p1 []byte, p2 insolar.Reply, p3 error) *mtapeMockSet {
+func (m *mtapeMockSet) Expect(p context.Context, p1 []byte, p2 insolar.Message, p3 insolar.Reply, p4 error) *mtapeMockSet {
 	m.mock.SetFunc = nil
 	m.expectationSeries = nil
 
 	if m.mainExpectation == nil {
 		m.mainExpectation = &tapeMockSetExpectation{}
 	}
-	m.mainExpectation.input = &tapeMockSetInput{p, p1, p2, p3}
+	m.mainExpectation.input = &tapeMockSetInput{p, p1, p2, p3, p4}
 	return m
 }
 
@@ -250,12 +251,12 @@ func (m *mtapeMockSet) Return(r error) *tapeMock {
 }
 
 //ExpectOnce specifies that invocation of tape.Set is expected once
-func (m *mtapeMockSet) ExpectOnce(p context.Context, p1 []byte, p2 insolar.Reply, p3 error) *tapeMockSetExpectation {
+func (m *mtapeMockSet) ExpectOnce(p context.Context, p1 []byte, p2 insolar.Message, p3 insolar.Reply, p4 error) *tapeMockSetExpectation {
 	m.mock.SetFunc = nil
 	m.mainExpectation = nil
 
 	expectation := &tapeMockSetExpectation{}
-	expectation.input = &tapeMockSetInput{p, p1, p2, p3}
+	expectation.input = &tapeMockSetInput{p, p1, p2, p3, p4}
 	m.expectationSeries = append(m.expectationSeries, expectation)
 	return expectation
 }
@@ -265,7 +266,7 @@ func (e *tapeMockSetExpectation) Return(r error) {
 }
 
 //Set uses given function f as a mock of tape.Set method
-func (m *mtapeMockSet) Set(f func(p context.Context, p1 []byte, p2 insolar.Reply, p3 error) (r error)) *tapeMock {
+func (m *mtapeMockSet) Set(f func(p context.Context, p1 []byte, p2 insolar.Message, p3 insolar.Reply, p4 error) (r error)) *tapeMock {
 	m.mainExpectation = nil
 	m.expectationSeries = nil
 
@@ -274,18 +275,18 @@ func (m *mtapeMockSet) Set(f func(p context.Context, p1 []byte, p2 insolar.Reply
 }
 
 //Set implements github.com/insolar/insolar/messagebus.tape interface
-func (m *tapeMock) Set(p context.Context, p1 []byte, p2 insolar.Reply, p3 error) (r error) {
+func (m *tapeMock) Set(p context.Context, p1 []byte, p2 insolar.Message, p3 insolar.Reply, p4 error) (r error) {
 	counter := atomic.AddUint64(&m.SetPreCounter, 1)
 	defer atomic.AddUint64(&m.SetCounter, 1)
 
 	if len(m.SetMock.expectationSeries) > 0 {
 		if counter > uint64(len(m.SetMock.expectationSeries)) {
-			m.t.Fatalf("Unexpected call to tapeMock.Set. %v %v %v %v", p, p1, p2, p3)
+			m.t.Fatalf("Unexpected call to tapeMock.Set. %v %v %v %v %v", p, p1, p2, p3, p4)
 			return
 		}
 
 		input := m.SetMock.expectationSeries[counter-1].input
-		testify_assert.Equal(m.t, *input, tapeMockSetInput{p, p1, p2, p3}, "tape.Set got unexpected parameters")
+		testify_assert.Equal(m.t, *input, tapeMockSetInput{p, p1, p2, p3, p4}, "tape.Set got unexpected parameters")
 
 		result := m.SetMock.expectationSeries[counter-1].result
 		if result == nil {
@@ -302,7 +303,7 @@ func (m *tapeMock) Set(p context.Context, p1 []byte, p2 insolar.Reply, p3 error)
 
 		input := m.SetMock.mainExpectation.input
 		if input != nil {
-			testify_assert.Equal(m.t, *input, tapeMockSetInput{p, p1, p2, p3}, "tape.Set got unexpected parameters")
+			testify_assert.Equal(m.t, *input, tapeMockSetInput{p, p1, p2, p3, p4}, "tape.Set got unexpected parameters")
 		}
 
 		result := m.SetMock.mainExpectation.result
@@ -316,11 +317,11 @@ func (m *tapeMock) Set(p context.Context, p1 []byte, p2 insolar.Reply, p3 error)
 	}
 
 	if m.SetFunc == nil {
-		m.t.Fatalf("Unexpected call to tapeMock.Set. %v %v %v %v", p, p1, p2, p3)
+		m.t.Fatalf("Unexpected call to tapeMock.Set. %v %v %v %v %v", p, p1, p2, p3, p4)
 		return
 	}
 
-	return m.SetFunc(p, p1, p2, p3)
+	return m.SetFunc(p, p1, p2, p3, p4)
 }
 
 //SetMinimockCounter returns a count of tapeMock.SetFunc invocations
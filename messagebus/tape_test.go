@@ -19,17 +19,20 @@ package messagebus
 import (
 	"bytes"
 	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/insolar/insolar/platformpolicy"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/ugorji/go/codec"
 
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/message"
 	"github.com/insolar/insolar/insolar/reply"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/testutils"
 )
 
 func TestGetMessageHash(t *testing.T) {
@@ -49,7 +52,7 @@ func TestTape_SetGet(t *testing.T) {
 
 	msgHash := []byte{4, 5, 6}
 	tp := newMemoryTape(pn)
-	err = tp.Set(ctx, msgHash, &rep, nil)
+	err = tp.Set(ctx, msgHash, nil, &rep, nil)
 	require.NoError(t, err)
 
 	item, err := tp.Get(ctx, msgHash)
@@ -68,7 +71,7 @@ func TestTape_SetGet_WithError(t *testing.T) {
 
 	msgHash := []byte{4, 5, 6}
 	tp := newMemoryTape(pn)
-	err := tp.Set(ctx, msgHash, nil, gotErr)
+	err := tp.Set(ctx, msgHash, nil, nil, gotErr)
 	require.NoError(t, err)
 
 	item, err := tp.Get(ctx, msgHash)
@@ -109,7 +112,7 @@ func TestTape_Write(t *testing.T) {
 	}
 
 	for _, tCase := range expected {
-		err := tp.Set(ctx, tCase.msgHash, tCase.item.Reply, tCase.item.Error)
+		err := tp.Set(ctx, tCase.msgHash, nil, tCase.item.Reply, tCase.item.Error)
 		require.NoError(t, err)
 	}
 	var buf bytes.Buffer
@@ -132,3 +135,113 @@ func TestTape_Write(t *testing.T) {
 		// fmt.Printf("gotItem => %+v\n", gotItem)
 	}
 }
+
+func TestTape_Write_PreservesErrors(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	heavyErr := &reply.HeavyError{Message: "sync failed", SubType: reply.ErrHeavySyncInProgress, PulseNum: 10}
+
+	tp := newMemoryTape(insolar.FirstPulseNumber)
+	errs := []error{
+		insolar.ErrDeactivated,
+		errors.Wrap(insolar.ErrStateNotAvailable, "can't get object"),
+		heavyErr,
+		&strconv.NumError{Func: "Atoi", Num: "x", Err: strconv.ErrSyntax},
+	}
+	for i, err := range errs {
+		require.NoError(t, tp.Set(ctx, []byte{byte(i)}, nil, nil, err))
+	}
+	var buf bytes.Buffer
+	require.NoError(t, tp.Write(ctx, &buf))
+
+	rTape, err := ReadTape(&buf)
+	require.NoError(t, err)
+	require.Len(t, rTape.Entries, len(errs))
+
+	assert.Equal(t, insolar.ErrDeactivated, rTape.Entries[0].Error)
+
+	assert.Equal(t, insolar.ErrStateNotAvailable, errors.Cause(rTape.Entries[1].Error))
+	assert.Equal(t, errs[1].Error(), rTape.Entries[1].Error.Error())
+
+	assert.Equal(t, heavyErr, rTape.Entries[2].Error)
+
+	require.IsType(t, &TapeError{}, rTape.Entries[3].Error)
+	assert.Equal(t, &TapeError{Type: "*strconv.NumError", Message: errs[3].Error()}, rTape.Entries[3].Error)
+}
+
+func TestTape_Write_WithRequest(t *testing.T) {
+	pcs := platformpolicy.NewPlatformCryptographyScheme()
+	msg := &message.GetObject{Head: testutils.RandomRef()}
+	request := testutils.RandomRef()
+	tp := Tape{
+		Header: TapeHeader{
+			Pulse: insolar.FirstPulseNumber + 10,
+			Request: &TapeRequest{
+				Parcel:  &message.Parcel{Msg: &message.CallMethod{Method: "Get"}, PulseNumber: insolar.FirstPulseNumber + 10},
+				Request: request,
+				Reply:   &reply.CallMethod{Result: []byte{1, 2, 3}},
+				Error:   "",
+				Nonce:   5,
+			},
+		},
+		Entries: []TapeEntry{{
+			MsgHash:  GetTapeHash(pcs, msg),
+			Message:  msg,
+			TapeItem: TapeItem{Reply: &reply.Object{Memory: []byte{4, 5, 6}}},
+		}},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, tp.Write(&buf))
+	require.True(t, bytes.HasPrefix(buf.Bytes(), tapeMagic))
+
+	rTape, err := ReadTape(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(TapeVersion), rTape.Header.Version)
+	assert.Equal(t, tp.Header.Pulse, rTape.Header.Pulse)
+	require.NotNil(t, rTape.Header.Request)
+	assert.Equal(t, request, rTape.Header.Request.Request)
+	assert.Equal(t, uint64(5), rTape.Header.Request.Nonce)
+	assert.Equal(t, tp.Header.Request.Reply, rTape.Header.Request.Reply)
+	assert.Equal(t, tp.Header.Request.Parcel.Message(), rTape.Header.Request.Parcel.Message())
+	assert.Equal(t, tp.Entries, rTape.Entries)
+}
+
+func TestReadTape_Version0(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	rep := &reply.Object{Memory: []byte{9, 9, 9}}
+
+	var buf bytes.Buffer
+	encoder := codec.NewEncoder(&buf, new(codec.CborHandle))
+	require.NoError(t, encoder.Encode(insolar.PulseNumber(insolar.FirstPulseNumber)))
+	encoder.Reset(&buf)
+	require.NoError(t, encoder.Encode([]itemBlob{
+		{MsgHash: []byte{1}, ReplyB: reply.ToBytes(rep)},
+		{MsgHash: []byte{2}, ErrorB: []byte("send failed")},
+	}))
+
+	rTape, err := ReadTape(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	assert.Equal(t, uint32(0), rTape.Header.Version)
+	assert.Equal(t, insolar.PulseNumber(insolar.FirstPulseNumber), rTape.Header.Pulse)
+	require.Len(t, rTape.Entries, 2)
+	assert.Equal(t, rep, rTape.Entries[0].Reply)
+	assert.Nil(t, rTape.Entries[0].Message)
+	assert.Equal(t, "send failed", rTape.Entries[1].Error.Error())
+
+	mTape, err := newMemoryTapeFromReader(ctx, bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	item, err := mTape.Get(ctx, []byte{1})
+	require.NoError(t, err)
+	assert.Equal(t, rep, item.Reply)
+}
+
+func TestReadTape_UnsupportedVersion(t *testing.T) {
+	var buf bytes.Buffer
+	buf.Write(tapeMagic)
+	encoder := codec.NewEncoder(&buf, new(codec.CborHandle))
+	require.NoError(t, encoder.Encode(headerBlob{Version: TapeVersion + 1}))
+
+	_, err := ReadTape(&buf)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported tape version")
+}
@@ -25,3 +25,9 @@ import (
 func GetMessageHash(scheme insolar.PlatformCryptographyScheme, msg insolar.Parcel) []byte {
 	return scheme.IntegrityHasher().Hash(message.ParcelToBytes(msg))
 }
+
+// GetTapeHash calculates hash that identifies message on tape. Unlike parcel hash it doesn't depend on sender, pulse
+// and signature, so recorded execution can be replayed on another node.
+func GetTapeHash(scheme insolar.PlatformCryptographyScheme, msg insolar.Message) []byte {
+	return scheme.IntegrityHasher().Hash(message.ToBytes(msg))
+}
@@ -52,6 +52,7 @@ package hostnetwork
 
 import (
 	"context"
+	"crypto"
 
 	"github.com/pkg/errors"
 	"go.opencensus.io/trace"
@@ -129,8 +130,13 @@ func (h *hostTransport) BuildResponse(ctx context.Context, request network.Reque
 	return p
 }
 
-func NewInternalTransport(conf configuration.Configuration, nodeRef string) (network.InternalTransport, error) {
-	tp, publicAddress, err := transport.NewTransport(conf.Host.Transport)
+func NewInternalTransport(
+	conf configuration.Configuration,
+	nodeRef string,
+	privateKey crypto.PrivateKey,
+	peerKeys transport.PeerKeyResolver,
+) (network.InternalTransport, error) {
+	tp, publicAddress, err := transport.NewTransport(conf.Host.Transport, privateKey, peerKeys)
 	if err != nil {
 		return nil, errors.Wrap(err, "error creating transport")
 	}
@@ -161,7 +161,7 @@ func NewConsensusNetwork(address, nodeID string, shortID insolar.ShortNodeID) (n
 	conf.Address = address
 	conf.Protocol = "PURE_UDP"
 
-	tp, publicAddress, err := transport.NewTransport(conf)
+	tp, publicAddress, err := transport.NewTransport(conf, nil, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, "error creating transport")
 	}
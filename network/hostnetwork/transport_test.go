@@ -142,10 +142,10 @@ func mockConfiguration(address string) configuration.Configuration {
 func TestNewInternalTransport(t *testing.T) {
 	// broken address
 	ctx := context.Background()
-	_, err := NewInternalTransport(mockConfiguration("abirvalg"), ID1+DOMAIN)
+	_, err := NewInternalTransport(mockConfiguration("abirvalg"), ID1+DOMAIN, nil, nil)
 	require.Error(t, err)
 	address := "127.0.0.1:0"
-	tp, err := NewInternalTransport(mockConfiguration(address), ID1+DOMAIN)
+	tp, err := NewInternalTransport(mockConfiguration(address), ID1+DOMAIN, nil, nil)
 	require.NoError(t, err)
 	defer tp.Stop(ctx)
 	// require that new address with correct port has been assigned
@@ -157,7 +157,7 @@ func TestNewInternalTransport(t *testing.T) {
 
 func TestNewInternalTransport2(t *testing.T) {
 	ctx := context.Background()
-	tp, err := NewInternalTransport(mockConfiguration("127.0.0.1:0"), ID1+DOMAIN)
+	tp, err := NewInternalTransport(mockConfiguration("127.0.0.1:0"), ID1+DOMAIN, nil, nil)
 	require.NoError(t, err)
 	go tp.Start(ctx)
 	time.Sleep(time.Millisecond)
@@ -171,12 +171,12 @@ func TestNewInternalTransport2(t *testing.T) {
 func createTwoHostNetworks(id1, id2 string) (t1, t2 *TransportResolvable, err error) {
 	m := newMockResolver()
 
-	i1, err := NewInternalTransport(mockConfiguration("127.0.0.1:0"), ID1+DOMAIN)
+	i1, err := NewInternalTransport(mockConfiguration("127.0.0.1:0"), ID1+DOMAIN, nil, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 	tr1 := &TransportResolvable{Transport: i1, Resolver: m}
-	i2, err := NewInternalTransport(mockConfiguration("127.0.0.1:0"), ID2+DOMAIN)
+	i2, err := NewInternalTransport(mockConfiguration("127.0.0.1:0"), ID2+DOMAIN, nil, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -195,7 +195,7 @@ func createTwoHostNetworks(id1, id2 string) (t1, t2 *TransportResolvable, err er
 }
 
 func TestNewInternalTransport3(t *testing.T) {
-	_, err := NewInternalTransport(mockConfiguration("127.0.0.1:0"), "")
+	_, err := NewInternalTransport(mockConfiguration("127.0.0.1:0"), "", nil, nil)
 	require.Error(t, err)
 }
 
@@ -242,7 +242,7 @@ func TestHostTransport_SendRequestPacket(t *testing.T) {
 	m := newMockResolver()
 	ctx := context.Background()
 
-	i1, err := NewInternalTransport(mockConfiguration("127.0.0.1:0"), ID1+DOMAIN)
+	i1, err := NewInternalTransport(mockConfiguration("127.0.0.1:0"), ID1+DOMAIN, nil, nil)
 	require.NoError(t, err)
 	t1 := &TransportResolvable{Transport: i1, Resolver: m}
 	t1.Transport.Start(ctx)
@@ -428,7 +428,7 @@ func TestHostTransport_WrongHandler(t *testing.T) {
 
 func TestDoubleStart(t *testing.T) {
 	ctx := context.Background()
-	tp, err := NewInternalTransport(mockConfiguration("127.0.0.1:0"), ID1+DOMAIN)
+	tp, err := NewInternalTransport(mockConfiguration("127.0.0.1:0"), ID1+DOMAIN, nil, nil)
 	require.NoError(t, err)
 
 	err = tp.Start(ctx)
@@ -441,7 +441,7 @@ func TestDoubleStart(t *testing.T) {
 
 func TestStartStop(t *testing.T) {
 	ctx := context.Background()
-	tp, err := NewInternalTransport(mockConfiguration("127.0.0.1:0"), ID1+DOMAIN)
+	tp, err := NewInternalTransport(mockConfiguration("127.0.0.1:0"), ID1+DOMAIN, nil, nil)
 	require.NoError(t, err)
 
 	err = tp.Start(ctx)
@@ -0,0 +1,154 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+package merkle
+
+import (
+	"bytes"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+)
+
+// InclusionProof proves that a leaf is included in a tree built by NewHashTree. It can be verified offline with
+// VerifyInclusion knowing only the tree root.
+type InclusionProof struct {
+	// Index is a position of the leaf among the tree leaves.
+	Index uint64
+	// Leaves is a number of the tree leaves.
+	Leaves uint64
+	// Siblings are hashes the leaf is combined with on the way to the root, from the bottom level to the top one.
+	Siblings [][]byte
+}
+
+// HashTree is a binary Merkle tree over a list of hashes. Parent hash is a hash of concatenated child hashes. A node
+// without a pair on its level is moved to the upper level as is.
+type HashTree struct {
+	// levels are tree levels from the leaves to the root.
+	levels [][][]byte
+}
+
+// NewHashTree builds a tree over provided leaves. Tree with no leaves has nil root.
+func NewHashTree(scheme insolar.PlatformCryptographyScheme, leaves [][]byte) *HashTree {
+	helper := newMerkleHelper(scheme)
+	t := &HashTree{}
+	if len(leaves) == 0 {
+		return t
+	}
+
+	level := leaves
+	t.levels = append(t.levels, level)
+	for len(level) > 1 {
+		upper := make([][]byte, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			if i+1 == len(level) {
+				upper = append(upper, level[i])
+				continue
+			}
+			upper = append(upper, helper.doubleSliceHash(level[i], level[i+1]))
+		}
+		level = upper
+		t.levels = append(t.levels, level)
+	}
+	return t
+}
+
+// Root returns the tree root.
+func (t *HashTree) Root() []byte {
+	if len(t.levels) == 0 {
+		return nil
+	}
+	return t.levels[len(t.levels)-1][0]
+}
+
+// Proof returns inclusion proof of a leaf with provided index.
+func (t *HashTree) Proof(index uint64) (*InclusionProof, error) {
+	if len(t.levels) == 0 || index >= uint64(len(t.levels[0])) {
+		return nil, errors.Errorf("leaf %d is out of tree", index)
+	}
+
+	proof := &InclusionProof{Index: index, Leaves: uint64(len(t.levels[0]))}
+	for _, level := range t.levels[:len(t.levels)-1] {
+		sibling := index ^ 1
+		if sibling < uint64(len(level)) {
+			proof.Siblings = append(proof.Siblings, level[sibling])
+		}
+		index /= 2
+	}
+	return proof, nil
+}
+
+// VerifyInclusion checks that the proof links provided leaf to provided root.
+func VerifyInclusion(scheme insolar.PlatformCryptographyScheme, root, leaf []byte, proof InclusionProof) bool {
+	if proof.Index >= proof.Leaves {
+		return false
+	}
+
+	helper := newMerkleHelper(scheme)
+	hash := leaf
+	index, width := proof.Index, proof.Leaves
+	siblings := proof.Siblings
+	for width > 1 {
+		if index^1 < width {
+			if len(siblings) == 0 {
+				return false
+			}
+			if index%2 == 0 {
+				hash = helper.doubleSliceHash(hash, siblings[0])
+			} else {
+				hash = helper.doubleSliceHash(siblings[0], hash)
+			}
+			siblings = siblings[1:]
+		}
+		index /= 2
+		width = (width + 1) / 2
+	}
+	return len(siblings) == 0 && bytes.Equal(hash, root)
+}
@@ -0,0 +1,121 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+package merkle
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/platformpolicy"
+)
+
+func TestHashTree_Proof(t *testing.T) {
+	scheme := platformpolicy.NewPlatformCryptographyScheme()
+	for n := 1; n <= 17; n++ {
+		t.Run(fmt.Sprintf("%d leaves", n), func(t *testing.T) {
+			leaves := make([][]byte, n)
+			for i := range leaves {
+				leaves[i] = scheme.IntegrityHasher().Hash([]byte{byte(i)})
+			}
+			tree := NewHashTree(scheme, leaves)
+			require.NotNil(t, tree.Root())
+
+			for i, leaf := range leaves {
+				proof, err := tree.Proof(uint64(i))
+				require.NoError(t, err)
+				assert.True(t, VerifyInclusion(scheme, tree.Root(), leaf, *proof))
+
+				other := leaves[(i+1)%n]
+				if n > 1 {
+					assert.False(t, VerifyInclusion(scheme, tree.Root(), other, *proof))
+				}
+				moved := *proof
+				moved.Index = (moved.Index + 1) % moved.Leaves
+				if n > 1 {
+					assert.False(t, VerifyInclusion(scheme, tree.Root(), leaf, moved))
+				}
+			}
+
+			_, err := tree.Proof(uint64(n))
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestHashTree_Empty(t *testing.T) {
+	scheme := platformpolicy.NewPlatformCryptographyScheme()
+	tree := NewHashTree(scheme, nil)
+	assert.Nil(t, tree.Root())
+	_, err := tree.Proof(0)
+	assert.Error(t, err)
+}
+
+func TestVerifyInclusion_Malformed(t *testing.T) {
+	scheme := platformpolicy.NewPlatformCryptographyScheme()
+	leaves := [][]byte{{1}, {2}, {3}}
+	tree := NewHashTree(scheme, leaves)
+	proof, err := tree.Proof(2)
+	require.NoError(t, err)
+
+	extra := *proof
+	extra.Siblings = append(extra.Siblings, []byte{4})
+	assert.False(t, VerifyInclusion(scheme, tree.Root(), leaves[2], extra))
+
+	short := *proof
+	short.Siblings = nil
+	assert.False(t, VerifyInclusion(scheme, tree.Root(), leaves[2], short))
+
+	outside := *proof
+	outside.Index = 3
+	assert.False(t, VerifyInclusion(scheme, tree.Root(), leaves[2], outside))
+}
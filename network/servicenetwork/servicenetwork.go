@@ -87,6 +87,7 @@ type ServiceNetwork struct {
 	PulseManager        insolar.PulseManager        `inject:""`
 	PulseAccessor       pulse.Accessor              `inject:""`
 	CryptographyService insolar.CryptographyService `inject:""`
+	KeyStore            insolar.KeyStore            `inject:""`
 	NetworkCoordinator  insolar.NetworkCoordinator  `inject:""`
 	NodeKeeper          network.NodeKeeper          `inject:""`
 	NetworkSwitcher     insolar.NetworkSwitcher     `inject:""`
@@ -126,7 +127,17 @@ func (n *ServiceNetwork) RemoteProcedureRegister(name string, method insolar.Rem
 
 // Start implements component.Initer
 func (n *ServiceNetwork) Init(ctx context.Context) error {
-	internalTransport, err := hostnetwork.NewInternalTransport(n.cfg, n.CertificateManager.GetCertificate().GetNodeRef().String())
+	privateKey, err := n.KeyStore.GetPrivateKey("")
+	if err != nil {
+		return errors.Wrap(err, "Failed to get node private key")
+	}
+
+	internalTransport, err := hostnetwork.NewInternalTransport(
+		n.cfg,
+		n.CertificateManager.GetCertificate().GetNodeRef().String(),
+		privateKey,
+		&nodeKeyResolver{serviceNetwork: n},
+	)
 	if err != nil {
 		return errors.Wrap(err, "Failed to create internal transport")
 	}
@@ -54,15 +54,15 @@ It can either be IP based network or any other kind of packet courier (e.g. an i
 
 Package exports simple interfaces for easily defining new transports.
 
-For now we provide two implementations of transport.
-The default is UTPTransport which using BitTorrent µTP protocol.
+For now we provide three implementations of transport, selected by configuration.Transport Protocol:
+TCP (default), PURE_UDP (used by consensus) and QUIC. QUIC connections are encrypted with a certificate
+signed by the node private key and every packet is sent over a separate stream of the peer session.
+Both sides of QUIC session present certificates, peers which keys are unknown may send only join packets.
 
 Usage:
 
-	var conn net.PacketConn
-	// get udp connection anywhere
-
-	tp, _ := transport.NewUTPTransport(conn)
+	cfg := configuration.Transport{Protocol: "QUIC", Address: "127.0.0.1:0"}
+	tp, _, _ := transport.NewTransport(cfg, privateKey, nil)
 	msg := &packet.Packet{}
 
 	// Send the async queries and wait for a future
@@ -51,93 +51,225 @@
 package transport
 
 import (
+	"bytes"
 	"context"
+	"crypto"
 	"crypto/rand"
-	"crypto/rsa"
 	"crypto/tls"
 	"crypto/x509"
-	"encoding/pem"
+	"crypto/x509/pkix"
+	"io"
 	"math/big"
 	"net"
+	"sync"
+	"time"
 
 	"github.com/lucas-clemente/quic-go"
 	"github.com/pkg/errors"
 
+	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/log"
+	"github.com/insolar/insolar/metrics"
+	"github.com/insolar/insolar/network/hostnetwork/packet/types"
+	"github.com/insolar/insolar/network/transport/pool"
 	"github.com/insolar/insolar/network/utils"
 )
 
-type quicConnection struct {
-	session quic.Session
-	stream  quic.Stream
+const (
+	quicCertificateValidity = 10 * 365 * 24 * time.Hour
+	// quicServerName is the name of all node certificates, nodes are identified by certificate keys instead
+	quicServerName = "insolar"
+)
+
+// quicVersions makes QUIC use TLS 1.3 handshake, gQUIC handshake doesn't authenticate clients.
+var quicVersions = []quic.VersionNumber{quic.VersionMilestone0_10_0}
+
+// unauthenticatedPacketTypes are packet types accepted from peers which keys are not known. Joining nodes send them
+// before they are added to active nodes and pulsars are not nodes at all. Pulses and join requests are signed,
+// pings carry no data.
+var unauthenticatedPacketTypes = map[types.PacketType]bool{
+	types.Ping:       true,
+	types.Pulse:      true,
+	types.Bootstrap:  true,
+	types.Authorize:  true,
+	types.Register:   true,
+	types.Challenge1: true,
+	types.Challenge2: true,
 }
 
 type quicTransport struct {
 	baseTransport
-	l           quic.Listener
-	conn        net.PacketConn
-	connections map[string]quicConnection
+
+	pool     pool.ConnectionPool
+	factory  *quicConnectionFactory
+	peerKeys PeerKeyResolver
+	listener quic.Listener
+	conn     net.PacketConn
+
+	sessionsLock sync.Mutex
+	sessions     map[quic.Session]struct{}
+
+	// unauthenticated holds connections to peers which keys were not known when connections were opened.
+	// They are kept out of the pool and replaced with pooled ones once keys are known.
+	unauthenticatedLock sync.Mutex
+	unauthenticated     map[string]*quicConnection
 }
 
-func NewQuicTransport(conn net.PacketConn, publicAddress string) (Transport, error) {
-	listener, err := quic.Listen(conn, generateTLSConfig(), nil)
+func newQuicTransport(
+	listenAddress, fixedPublicAddress string,
+	privateKey crypto.PrivateKey,
+	peerKeys PeerKeyResolver,
+) (*quicTransport, string, error) {
+	if privateKey == nil {
+		return nil, "", errors.New("QUIC transport requires node private key")
+	}
+
+	tlsConfig, err := newTLSConfig(privateKey)
 	if err != nil {
-		return nil, err
+		return nil, "", errors.Wrap(err, "failed to create TLS config")
+	}
+
+	conn, err := net.ListenPacket("udp", listenAddress)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to listen UDP")
+	}
+	publicAddress, err := Resolve(fixedPublicAddress, conn.LocalAddr().String())
+	if err != nil {
+		utils.CloseVerbose(conn)
+		return nil, "", errors.Wrap(err, "failed to resolve public address")
 	}
 
+	config := &quic.Config{KeepAlive: true, Versions: quicVersions}
+	listener, err := quic.Listen(conn, tlsConfig, config)
+	if err != nil {
+		utils.CloseVerbose(conn)
+		return nil, "", errors.Wrap(err, "failed to listen QUIC")
+	}
+
+	factory := &quicConnectionFactory{tlsConfig: tlsConfig, config: config, peerKeys: peerKeys}
 	transport := &quicTransport{
-		baseTransport: newBaseTransport(publicAddress),
-		l:             listener,
-		conn:          conn,
-		connections:   make(map[string]quicConnection),
+		baseTransport:   newBaseTransport(publicAddress),
+		pool:            pool.NewConnectionPool(factory),
+		factory:         factory,
+		peerKeys:        peerKeys,
+		listener:        listener,
+		conn:            conn,
+		sessions:        make(map[quic.Session]struct{}),
+		unauthenticated: make(map[string]*quicConnection),
 	}
 
 	transport.sendFunc = transport.send
-	return transport, nil
+
+	return transport, publicAddress, nil
 }
 
-func (t *quicTransport) send(recvAddress string, data []byte) error {
-	conn, ok := t.connections[recvAddress]
-	var stream quic.Stream
-	var err error
-	if !ok {
-		var session quic.Session
-		session, stream, err = createConnection(recvAddress)
+func (t *quicTransport) send(address string, data []byte) error {
+	ctx := context.Background()
+	logger := inslogger.FromContext(ctx)
+
+	addr, err := net.ResolveUDPAddr("udp", address)
+	if err != nil {
+		return errors.Wrap(err, "[ send ] Failed to resolve net address")
+	}
+
+	conn, err := t.getConnection(ctx, addr)
+	if err != nil {
+		return errors.Wrap(err, "[ send ] Failed to get connection")
+	}
+
+	logger.Debug("[ send ] len = ", len(data))
+
+	n, err := conn.Write(data)
+
+	if err != nil {
+		t.closeConnection(ctx, addr)
+		conn, err = t.getConnection(ctx, addr)
 		if err != nil {
-			return errors.Wrap(err, "[ send ] failed to create a connection")
+			return errors.Wrap(err, "[ send ] Failed to get connection")
 		}
-		t.connections[recvAddress] = quicConnection{session, stream}
-	} else {
-		stream = conn.stream
+		n, err = conn.Write(data)
 	}
 
-	n, err := stream.Write(data)
+	if err == nil {
+		metrics.NetworkSentSize.Add(float64(n))
+		return nil
+	}
+	return errors.Wrap(err, "[ send ] Failed to write data")
+}
+
+// getConnection returns pooled connection to the peer if its key is known, otherwise a connection that is not
+// authenticated.
+func (t *quicTransport) getConnection(ctx context.Context, address *net.UDPAddr) (net.Conn, error) {
+	if t.peerKeys == nil {
+		return t.pool.GetConnection(ctx, address)
+	}
+	if _, ok := t.peerKeys.ResolvePeerKey(address.String()); ok {
+		// connection opened before the key was known is replaced with authenticated one
+		t.closeUnauthenticated(address.String())
+		return t.pool.GetConnection(ctx, address)
+	}
+
+	t.unauthenticatedLock.Lock()
+	defer t.unauthenticatedLock.Unlock()
+
+	if conn, ok := t.unauthenticated[address.String()]; ok {
+		return conn, nil
+	}
+	// joining nodes are not known until they pass authorization
+	inslogger.FromContext(ctx).Debugf("[ getConnection ] Public key of %s is unknown, peer is not authenticated", address)
+	conn, err := t.factory.dial(ctx, address, nil)
 	if err != nil {
-		return errors.Wrap(err, "[ send ] failed to write to a stream")
+		return nil, err
 	}
+	t.unauthenticated[address.String()] = conn
+	go t.forgetUnauthenticated(address.String(), conn)
+	return conn, nil
+}
+
+// forgetUnauthenticated removes the connection when its session is closed.
+func (t *quicTransport) forgetUnauthenticated(address string, conn *quicConnection) {
+	<-conn.session.Context().Done()
 
-	if n != len(data) {
-		return errors.New("[ send ] sent a part of data")
+	t.unauthenticatedLock.Lock()
+	defer t.unauthenticatedLock.Unlock()
+
+	if t.unauthenticated[address] == conn {
+		delete(t.unauthenticated, address)
 	}
+}
 
-	return nil
+func (t *quicTransport) closeUnauthenticated(address string) {
+	t.unauthenticatedLock.Lock()
+	defer t.unauthenticatedLock.Unlock()
+
+	if conn, ok := t.unauthenticated[address]; ok {
+		utils.CloseVerbose(conn)
+		delete(t.unauthenticated, address)
+	}
+}
+
+func (t *quicTransport) closeConnection(ctx context.Context, address *net.UDPAddr) {
+	t.pool.CloseConnection(ctx, address)
+	t.closeUnauthenticated(address.String())
 }
 
 // Start starts networking.
 func (t *quicTransport) Start(ctx context.Context) error {
-	log.Debug("Start QUIC transport")
+	logger := inslogger.FromContext(ctx)
+	logger.Info("[ Start ] Start QUIC transport")
 
 	go func() {
 		for {
-			session, err := t.l.Accept()
+			session, err := t.listener.Accept()
 			if err != nil {
 				<-t.disconnectFinished
-				log.Error("failed to accept connection: ", err.Error())
+				logger.Info("[ Start ] Listener closed, quiting accept loop: ", err.Error())
 				return
 			}
 
-			log.Debug("accepted connection from ", session.RemoteAddr().String())
-			go t.handleAcceptedConnection(session)
+			logger.Debugf("[ Start ] Accepted new session from %s", session.RemoteAddr())
+
+			go t.handleAcceptedSession(session)
 		}
 	}()
 
@@ -149,66 +281,252 @@ func (t *quicTransport) Stop() {
 	t.mutex.Lock()
 	defer t.mutex.Unlock()
 
-	log.Debug("[ Stop ] Stop QUIC transport")
+	log.Info("[ Stop ] Stop QUIC transport")
 	t.prepareDisconnect()
 
-	utils.CloseVerbose(t.l)
+	utils.CloseVerbose(t.listener)
+	t.pool.Reset()
+
+	t.sessionsLock.Lock()
+	for session := range t.sessions {
+		utils.CloseVerbose(session)
+	}
+	t.sessions = make(map[quic.Session]struct{})
+	t.sessionsLock.Unlock()
 
-	for _, conn := range t.connections {
-		utils.CloseVerbose(conn.stream)
-		utils.CloseVerbose(conn.session)
+	t.unauthenticatedLock.Lock()
+	for _, conn := range t.unauthenticated {
+		utils.CloseVerbose(conn)
 	}
+	t.unauthenticated = make(map[string]*quicConnection)
+	t.unauthenticatedLock.Unlock()
 
 	utils.CloseVerbose(t.conn)
 }
 
-func (t *quicTransport) handleAcceptedConnection(session quic.Session) {
-	stream, err := session.AcceptStream()
+func (t *quicTransport) handleAcceptedSession(session quic.Session) {
+	t.sessionsLock.Lock()
+	t.sessions[session] = struct{}{}
+	t.sessionsLock.Unlock()
+
+	defer func() {
+		t.sessionsLock.Lock()
+		delete(t.sessions, session)
+		t.sessionsLock.Unlock()
+	}()
+
+	authenticated := false
+	for {
+		stream, err := session.AcceptStream()
+		if err != nil {
+			log.Warn("[ handleAcceptedSession ] Session closed by peer: ", err.Error())
+			return
+		}
+
+		// joining node keeps the session after it's added to active nodes, so the key is checked until it's known
+		if !authenticated {
+			authenticated = t.isAuthenticated(session)
+		}
+		go t.handleStream(stream, authenticated)
+	}
+}
+
+// isAuthenticated checks that client certificate of the session is issued for a key of known node.
+func (t *quicTransport) isAuthenticated(session quic.Session) bool {
+	if t.peerKeys == nil {
+		return true
+	}
+	certs := session.ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		return false
+	}
+	for _, key := range t.peerKeys.PeerKeys() {
+		if verifyPeerKey(certs[0].PublicKey, key) == nil {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *quicTransport) handleStream(stream quic.Stream, authenticated bool) {
+	defer utils.CloseVerbose(stream)
+
+	for {
+		msg, err := t.serializer.DeserializePacket(stream)
+		if err != nil {
+			if err != io.EOF && err != io.ErrUnexpectedEOF {
+				log.Error("[ handleStream ] Failed to deserialize packet: ", err.Error())
+			}
+			return
+		}
+
+		ctx, logger := inslogger.WithTraceField(context.Background(), msg.TraceID)
+		if !authenticated && !unauthenticatedPacketTypes[msg.Type] {
+			logger.Warnf("[ handleStream ] Dropping %s packet from not authenticated peer %s", msg.Type, msg.Sender)
+			continue
+		}
+		logger.Debug("[ handleStream ] Handling packet: ", msg.RequestID)
+
+		go t.packetHandler.Handle(ctx, msg)
+	}
+}
+
+type quicConnectionFactory struct {
+	tlsConfig *tls.Config
+	config    *quic.Config
+	peerKeys  PeerKeyResolver
+}
+
+// CreateConnection opens connection to the peer authenticated by its known key. Connections to peers which keys are
+// unknown are not created, so they never get into the pool.
+func (f *quicConnectionFactory) CreateConnection(ctx context.Context, address net.Addr) (net.Conn, error) {
+	udpAddress, ok := address.(*net.UDPAddr)
+	if !ok {
+		return nil, errors.New("[ createConnection ] Failed to get udp address")
+	}
+
+	var expectedKey crypto.PublicKey
+	if f.peerKeys != nil {
+		expectedKey, ok = f.peerKeys.ResolvePeerKey(udpAddress.String())
+		if !ok {
+			return nil, errors.Errorf("[ createConnection ] Public key of %s is unknown", address)
+		}
+	}
+	return f.dial(ctx, udpAddress, expectedKey)
+}
+
+// dial opens session to the address, server certificate is checked against expected key if it's not nil.
+func (f *quicConnectionFactory) dial(ctx context.Context, address *net.UDPAddr, expectedKey crypto.PublicKey) (*quicConnection, error) {
+	logger := inslogger.FromContext(ctx)
+
+	tlsConfig := f.tlsConfig
+	if expectedKey != nil {
+		tlsConfig = f.tlsConfig.Clone()
+		tlsConfig.VerifyPeerCertificate = func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if len(rawCerts) == 0 {
+				return errors.New("peer has not presented a certificate")
+			}
+			cert, err := x509.ParseCertificate(rawCerts[0])
+			if err != nil {
+				return errors.Wrap(err, "failed to parse peer certificate")
+			}
+			return verifyPeerKey(cert.PublicKey, expectedKey)
+		}
+	}
+
+	session, err := quic.DialAddr(address.String(), tlsConfig, f.config)
 	if err != nil {
-		log.Error(err, "[ handleAcceptedConnection ] failed to get a stream")
+		logger.Errorf("[ createConnection ] Failed to open session to %s: %s", address, err.Error())
+		return nil, errors.Wrap(err, "[ createConnection ] Failed to open session")
 	}
 
-	msg, err := t.serializer.DeserializePacket(stream)
+	return &quicConnection{session: session}, nil
+}
+
+// verifyPeerKey checks that peer certificate is issued for the node key.
+// The certificate is self-signed, and the handshake proves the peer holds its private key.
+func verifyPeerKey(actual, expected crypto.PublicKey) error {
+	actualDER, err := x509.MarshalPKIXPublicKey(actual)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal peer certificate key")
+	}
+	expectedDER, err := x509.MarshalPKIXPublicKey(expected)
 	if err != nil {
-		log.Error(err, "[ handleAcceptedConnection ] failed to deserialize a packet")
+		return errors.Wrap(err, "failed to marshal node key")
+	}
+	if !bytes.Equal(actualDER, expectedDER) {
+		return errors.New("peer certificate key doesn't match node key")
 	}
+	return nil
+}
 
-	go t.packetHandler.Handle(context.TODO(), msg)
+// quicConnection adapts QUIC session to net.Conn, so sessions can be kept in the connection pool.
+// Every Write opens a separate stream, so packets to the same peer are multiplexed over one session
+// and a big packet doesn't block the smaller ones sent after it.
+type quicConnection struct {
+	session quic.Session
+}
 
-	utils.CloseVerbose(stream)
+// Read blocks until the session is closed. Data is never sent back over outgoing sessions,
+// the pool uses Read only to detect that the remote host has closed the connection.
+func (c *quicConnection) Read(b []byte) (int, error) {
+	<-c.session.Context().Done()
+	return 0, io.EOF
 }
 
-func createConnection(addr string) (quic.Session, quic.Stream, error) {
-	// TODO: NETD18-78
-	session, err := quic.DialAddr(addr, &tls.Config{InsecureSkipVerify: true}, nil) //nolint: gosec
+func (c *quicConnection) Write(b []byte) (int, error) {
+	stream, err := c.session.OpenStreamSync()
 	if err != nil {
-		return nil, nil, errors.Wrap(err, "[ createConnection ] failed to create a session")
+		return 0, errors.Wrap(err, "failed to open a stream")
 	}
-	stream, err := session.OpenStreamSync()
+
+	n, err := stream.Write(b)
 	if err != nil {
-		return nil, nil, errors.Wrap(err, "[ createConnection ] failed to open a stream")
+		utils.CloseVerbose(stream)
+		return n, errors.Wrap(err, "failed to write to a stream")
 	}
-	log.Debug("connected to: %s", session.RemoteAddr().String())
-	return session, stream, nil
+
+	return n, stream.Close()
 }
 
-// Setup a bare-bones TLS config for the server
-func generateTLSConfig() *tls.Config {
-	key, err := rsa.GenerateKey(rand.Reader, 2048)
-	if err != nil {
-		panic(err)
+func (c *quicConnection) Close() error {
+	return c.session.Close()
+}
+
+func (c *quicConnection) LocalAddr() net.Addr {
+	return c.session.LocalAddr()
+}
+
+func (c *quicConnection) RemoteAddr() net.Addr {
+	return c.session.RemoteAddr()
+}
+
+func (c *quicConnection) SetDeadline(t time.Time) error {
+	return nil
+}
+
+func (c *quicConnection) SetReadDeadline(t time.Time) error {
+	return nil
+}
+
+func (c *quicConnection) SetWriteDeadline(t time.Time) error {
+	return nil
+}
+
+// newTLSConfig creates TLS config with a certificate self-signed by the node private key.
+func newTLSConfig(privateKey crypto.PrivateKey) (*tls.Config, error) {
+	signer, ok := privateKey.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("private key is not a crypto.Signer")
 	}
-	template := x509.Certificate{SerialNumber: big.NewInt(1)}
-	certDER, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
 	if err != nil {
-		panic(err)
+		return nil, errors.Wrap(err, "failed to generate certificate serial number")
 	}
-	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
-	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
 
-	tlsCert, err := tls.X509KeyPair(certPEM, keyPEM)
+	now := time.Now()
+	template := x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject:      pkix.Name{Organization: []string{"Insolar"}},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(quicCertificateValidity),
+		DNSNames:     []string{quicServerName},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, &template, &template, signer.Public(), signer)
 	if err != nil {
-		panic(err)
+		return nil, errors.Wrap(err, "failed to create certificate")
 	}
-	return &tls.Config{Certificates: []tls.Certificate{tlsCert}}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{certDER}, PrivateKey: signer}},
+		ServerName:   quicServerName,
+		// Clients present their certificates too, accepted sessions are checked against known node keys
+		ClientAuth: tls.RequireAnyClientCert,
+		// Node certificates are self-signed and can't be verified against a CA,
+		// instead the certificate key is compared with the known node key on dial
+		InsecureSkipVerify: true, //nolint: gosec
+	}, nil
 }
@@ -52,6 +52,7 @@ package transport
 
 import (
 	"context"
+	"crypto"
 
 	"github.com/insolar/insolar/configuration"
 	"github.com/insolar/insolar/network"
@@ -89,13 +90,25 @@ type Transport interface {
 	Stopped() <-chan bool
 }
 
-// NewTransport creates new Transport with particular configuration
-func NewTransport(cfg configuration.Transport) (Transport, string, error) {
+// PeerKeyResolver resolves public keys of known nodes by their network addresses.
+type PeerKeyResolver interface {
+	// ResolvePeerKey returns public key of the node listening on address, ok is false if the node is unknown.
+	ResolvePeerKey(address string) (key crypto.PublicKey, ok bool)
+	// PeerKeys returns public keys of all known nodes.
+	PeerKeys() []crypto.PublicKey
+}
+
+// NewTransport creates new Transport with particular configuration.
+// Private key is used by secure transports (QUIC) to establish encrypted connections, other transports ignore it.
+// Peer keys are used by secure transports to authenticate remote nodes, nil disables peer authentication.
+func NewTransport(cfg configuration.Transport, privateKey crypto.PrivateKey, peerKeys PeerKeyResolver) (Transport, string, error) {
 	switch cfg.Protocol {
 	case "TCP":
 		return newTCPTransport(cfg.Address, cfg.FixedPublicAddress)
 	case "PURE_UDP":
 		return newUDPTransport(cfg.Address, cfg.FixedPublicAddress)
+	case "QUIC":
+		return newQuicTransport(cfg.Address, cfg.FixedPublicAddress, privateKey, peerKeys)
 	default:
 		return nil, "", errors.New("invalid transport configuration")
 	}
@@ -52,17 +52,24 @@ package transport
 
 import (
 	"context"
+	"crypto"
 	"crypto/rand"
 	"encoding/gob"
+	"net"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/insolar/insolar/configuration"
 	"github.com/insolar/insolar/network/hostnetwork/host"
 	"github.com/insolar/insolar/network/hostnetwork/packet"
 	"github.com/insolar/insolar/network/hostnetwork/packet/types"
 	"github.com/insolar/insolar/network/hostnetwork/resolver"
+	"github.com/insolar/insolar/platformpolicy"
 
+	"github.com/lucas-clemente/quic-go"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -91,7 +98,10 @@ func setupNode(t *transportSuite, n *node) {
 	n.host, err = host.NewHost(n.config.Address)
 	t.Assert().NoError(err)
 
-	n.transport, _, err = NewTransport(n.config)
+	key, err := platformpolicy.NewKeyProcessor().GeneratePrivateKey()
+	t.Require().NoError(err)
+
+	n.transport, _, err = NewTransport(n.config, key, nil)
 	t.Require().NoError(err)
 	t.Require().NotNil(n.transport)
 	t.Require().Implements((*Transport)(nil), n.transport)
@@ -198,14 +208,190 @@ func TestTCPTransport(t *testing.T) {
 }
 
 func TestQuicTransport(t *testing.T) {
-	t.Skip("QUIC internals racing atm. Skip until we want to use it in production")
-
 	cfg1 := configuration.Transport{Protocol: "QUIC", Address: "127.0.0.1:17018"}
 	cfg2 := configuration.Transport{Protocol: "QUIC", Address: "127.0.0.1:17019"}
 
 	suite.Run(t, NewSuite(cfg1, cfg2))
 }
 
+func TestQuicTransportWithoutKey(t *testing.T) {
+	cfg := configuration.Transport{Protocol: "QUIC", Address: "127.0.0.1:17020"}
+
+	_, _, err := NewTransport(cfg, nil, nil)
+	assert.Error(t, err)
+}
+
+type peerKeys struct {
+	lock sync.Mutex
+	keys map[string]crypto.PublicKey
+}
+
+func newPeerKeys(keys map[string]crypto.PublicKey) *peerKeys {
+	if keys == nil {
+		keys = map[string]crypto.PublicKey{}
+	}
+	return &peerKeys{keys: keys}
+}
+
+func (k *peerKeys) add(address string, key crypto.PublicKey) {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+	k.keys[address] = key
+}
+
+func (k *peerKeys) ResolvePeerKey(address string) (crypto.PublicKey, bool) {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+	key, ok := k.keys[address]
+	return key, ok
+}
+
+func (k *peerKeys) PeerKeys() []crypto.PublicKey {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+	keys := make([]crypto.PublicKey, 0, len(k.keys))
+	for _, key := range k.keys {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func TestQuicTransport_PeerKey(t *testing.T) {
+	ctx := context.Background()
+	keyProcessor := platformpolicy.NewKeyProcessor()
+
+	serverKey, err := keyProcessor.GeneratePrivateKey()
+	require.NoError(t, err)
+	clientKey, err := keyProcessor.GeneratePrivateKey()
+	require.NoError(t, err)
+	otherKey, err := keyProcessor.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	cfg := configuration.Transport{Protocol: "QUIC", Address: "127.0.0.1:0"}
+	server, address, err := NewTransport(cfg, serverKey, nil)
+	require.NoError(t, err)
+	require.NoError(t, server.Start(ctx))
+	defer server.Stop()
+
+	udpAddress, err := net.ResolveUDPAddr("udp", address)
+	require.NoError(t, err)
+	clientTLS, err := newTLSConfig(clientKey)
+	require.NoError(t, err)
+
+	newFactory := func(keys map[string]crypto.PublicKey) *quicConnectionFactory {
+		return &quicConnectionFactory{
+			tlsConfig: clientTLS,
+			config:    &quic.Config{Versions: quicVersions},
+			peerKeys:  newPeerKeys(keys),
+		}
+	}
+
+	t.Run("known key", func(t *testing.T) {
+		keys := map[string]crypto.PublicKey{address: keyProcessor.ExtractPublicKey(serverKey)}
+		conn, err := newFactory(keys).CreateConnection(ctx, udpAddress)
+		require.NoError(t, err)
+		assert.NoError(t, conn.Close())
+	})
+
+	t.Run("wrong key", func(t *testing.T) {
+		keys := map[string]crypto.PublicKey{address: keyProcessor.ExtractPublicKey(otherKey)}
+		_, err := newFactory(keys).CreateConnection(ctx, udpAddress)
+		assert.Error(t, err)
+	})
+
+	t.Run("unknown peer isn't pooled", func(t *testing.T) {
+		_, err := newFactory(nil).CreateConnection(ctx, udpAddress)
+		assert.Error(t, err)
+	})
+}
+
+func TestQuicTransport_Unauthenticated(t *testing.T) {
+	ctx := context.Background()
+	gob.Register(&packet.RequestTest{})
+	keyProcessor := platformpolicy.NewKeyProcessor()
+
+	serverKey, err := keyProcessor.GeneratePrivateKey()
+	require.NoError(t, err)
+	clientKey, err := keyProcessor.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	serverCfg := configuration.Transport{Protocol: "QUIC", Address: "127.0.0.1:17021"}
+	clientCfg := configuration.Transport{Protocol: "QUIC", Address: "127.0.0.1:17022"}
+	serverKeys := newPeerKeys(nil)
+	clientKeys := newPeerKeys(map[string]crypto.PublicKey{
+		serverCfg.Address: keyProcessor.ExtractPublicKey(serverKey),
+	})
+
+	server, _, err := NewTransport(serverCfg, serverKey, serverKeys)
+	require.NoError(t, err)
+	require.NoError(t, server.Start(ctx))
+	defer server.Stop()
+	client, _, err := NewTransport(clientCfg, clientKey, clientKeys)
+	require.NoError(t, err)
+	require.NoError(t, client.Start(ctx))
+	defer client.Stop()
+
+	serverHost, err := host.NewHost(serverCfg.Address)
+	require.NoError(t, err)
+	clientHost, err := host.NewHost(clientCfg.Address)
+	require.NoError(t, err)
+
+	receive := func(tr Transport) *packet.Packet {
+		select {
+		case p := <-tr.Packets():
+			return p
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "packet is not received")
+			return nil
+		}
+	}
+	testPacket := func(sender, receiver *host.Host) *packet.Packet {
+		return packet.NewBuilder(sender).Receiver(receiver).Type(packet.TestPacket).
+			Request(&packet.RequestTest{Data: []byte{1}}).Build()
+	}
+
+	t.Run("accepted session", func(t *testing.T) {
+		// client key is unknown, so only join packets are accepted
+		require.NoError(t, client.SendPacket(ctx, testPacket(clientHost, serverHost)))
+		ping := packet.NewBuilder(clientHost).Receiver(serverHost).Type(types.Ping).Build()
+		require.NoError(t, client.SendPacket(ctx, ping))
+		assert.Equal(t, types.Ping, receive(server).Type)
+
+		// the same session is authenticated after the key becomes known
+		serverKeys.add(clientCfg.Address, keyProcessor.ExtractPublicKey(clientKey))
+		require.NoError(t, client.SendPacket(ctx, testPacket(clientHost, serverHost)))
+		assert.Equal(t, packet.TestPacket, receive(server).Type)
+	})
+
+	t.Run("dialed session", func(t *testing.T) {
+		otherKeys := newPeerKeys(nil)
+		other, _, err := NewTransport(
+			configuration.Transport{Protocol: "QUIC", Address: "127.0.0.1:17023"}, serverKey, otherKeys,
+		)
+		require.NoError(t, err)
+		require.NoError(t, other.Start(ctx))
+		defer other.Stop()
+		otherHost, err := host.NewHost("127.0.0.1:17023")
+		require.NoError(t, err)
+		quicOther := other.(*quicTransport)
+
+		// connection to unknown peer is kept out of the pool
+		require.NoError(t, other.SendPacket(ctx, testPacket(otherHost, clientHost)))
+		assert.Equal(t, packet.TestPacket, receive(client).Type)
+		quicOther.unauthenticatedLock.Lock()
+		assert.Len(t, quicOther.unauthenticated, 1)
+		quicOther.unauthenticatedLock.Unlock()
+
+		// and replaced with authenticated one once the key is known
+		otherKeys.add(clientCfg.Address, keyProcessor.ExtractPublicKey(clientKey))
+		require.NoError(t, other.SendPacket(ctx, testPacket(otherHost, clientHost)))
+		assert.Equal(t, packet.TestPacket, receive(client).Type)
+		quicOther.unauthenticatedLock.Lock()
+		assert.Empty(t, quicOther.unauthenticated)
+		quicOther.unauthenticatedLock.Unlock()
+	})
+}
+
 func Test_createResolver(t *testing.T) {
 	a := assert.New(t)
 
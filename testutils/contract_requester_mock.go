@@ -25,6 +25,11 @@ type ContractRequesterMock struct {
 	CallConstructorPreCounter uint64
 	CallConstructorMock       mContractRequesterMockCallConstructor
 
+	CallImmutableFunc       func(p context.Context, p1 insolar.Message, p2 *insolar.Reference, p3 string, p4 insolar.Arguments, p5 *insolar.Reference) (r insolar.Reply, r1 error)
+	CallImmutableCounter    uint64
+	CallImmutablePreCounter uint64
+	CallImmutableMock       mContractRequesterMockCallImmutable
+
 	CallMethodFunc       func(p context.Context, p1 insolar.Message, p2 bool, p3 *insolar.Reference, p4 string, p5 insolar.Arguments, p6 *insolar.Reference) (r insolar.Reply, r1 error)
 	CallMethodCounter    uint64
 	CallMethodPreCounter uint64
@@ -45,6 +50,7 @@ func NewContractRequesterMock(t minimock.Tester) *ContractRequesterMock {
 	}
 
 	m.CallConstructorMock = mContractRequesterMockCallConstructor{mock: m}
+	m.CallImmutableMock = mContractRequesterMockCallImmutable{mock: m}
 	m.CallMethodMock = mContractRequesterMockCallMethod{mock: m}
 	m.SendRequestMock = mContractRequesterMockSendRequest{mock: m}
 
@@ -208,6 +214,161 @@ func (m *ContractRequesterMock) CallConstructorFinished() bool {
 	return true
 }
 
+type mContractRequesterMockCallImmutable struct {
+	mock              *ContractRequesterMock
+	mainExpectation   *ContractRequesterMockCallImmutableExpectation
+	expectationSeries []*ContractRequesterMockCallImmutableExpectation
+}
+
+type ContractRequesterMockCallImmutableExpectation struct {
+	input  *ContractRequesterMockCallImmutableInput
+	result *ContractRequesterMockCallImmutableResult
+}
+
+type ContractRequesterMockCallImmutableInput struct {
+	p  context.Context
+	p1 insolar.Message
+	p2 *insolar.Reference
+	p3 string
+	p4 insolar.Arguments
+	p5 *insolar.Reference
+}
+
+type ContractRequesterMockCallImmutableResult struct {
+	r  insolar.Reply
+	r1 error
+}
+
+//Expect specifies that invocation of ContractRequester.CallImmutable is expected from 1 to Infinity times
+func (m *mContractRequesterMockCallImmutable) Expect(p context.Context, p1 insolar.Message, p2 *insolar.Reference, p3 string, p4 insolar.Arguments, p5 *insolar.Reference) *mContractRequesterMockCallImmutable {
+	m.mock.CallImmutableFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ContractRequesterMockCallImmutableExpectation{}
+	}
+	m.mainExpectation.input = &ContractRequesterMockCallImmutableInput{p, p1, p2, p3, p4, p5}
+	return m
+}
+
+//Return specifies results of invocation of ContractRequester.CallImmutable
+func (m *mContractRequesterMockCallImmutable) Return(r insolar.Reply, r1 error) *ContractRequesterMock {
+	m.mock.CallImmutableFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ContractRequesterMockCallImmutableExpectation{}
+	}
+	m.mainExpectation.result = &ContractRequesterMockCallImmutableResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of ContractRequester.CallImmutable is expected once
+func (m *mContractRequesterMockCallImmutable) ExpectOnce(p context.Context, p1 insolar.Message, p2 *insolar.Reference, p3 string, p4 insolar.Arguments, p5 *insolar.Reference) *ContractRequesterMockCallImmutableExpectation {
+	m.mock.CallImmutableFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ContractRequesterMockCallImmutableExpectation{}
+	expectation.input = &ContractRequesterMockCallImmutableInput{p, p1, p2, p3, p4, p5}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ContractRequesterMockCallImmutableExpectation) Return(r insolar.Reply, r1 error) {
+	e.result = &ContractRequesterMockCallImmutableResult{r, r1}
+}
+
+//Set uses given function f as a mock of ContractRequester.CallImmutable method
+func (m *mContractRequesterMockCallImmutable) Set(f func(p context.Context, p1 insolar.Message, p2 *insolar.Reference, p3 string, p4 insolar.Arguments, p5 *insolar.Reference) (r insolar.Reply, r1 error)) *ContractRequesterMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.CallImmutableFunc = f
+	return m.mock
+}
+
+//CallImmutable implements github.com/insolar/insolar/insolar.ContractRequester interface
+func (m *ContractRequesterMock) CallImmutable(p context.Context, p1 insolar.Message, p2 *insolar.Reference, p3 string, p4 insolar.Arguments, p5 *insolar.Reference) (r insolar.Reply, r1 error) {
+	counter := atomic.AddUint64(&m.CallImmutablePreCounter, 1)
+	defer atomic.AddUint64(&m.CallImmutableCounter, 1)
+
+	if len(m.CallImmutableMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.CallImmutableMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ContractRequesterMock.CallImmutable. %v %v %v %v %v %v", p, p1, p2, p3, p4, p5)
+			return
+		}
+
+		input := m.CallImmutableMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ContractRequesterMockCallImmutableInput{p, p1, p2, p3, p4, p5}, "ContractRequester.CallImmutable got unexpected parameters")
+
+		result := m.CallImmutableMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ContractRequesterMock.CallImmutable")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.CallImmutableMock.mainExpectation != nil {
+
+		input := m.CallImmutableMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ContractRequesterMockCallImmutableInput{p, p1, p2, p3, p4, p5}, "ContractRequester.CallImmutable got unexpected parameters")
+		}
+
+		result := m.CallImmutableMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ContractRequesterMock.CallImmutable")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.CallImmutableFunc == nil {
+		m.t.Fatalf("Unexpected call to ContractRequesterMock.CallImmutable. %v %v %v %v %v %v", p, p1, p2, p3, p4, p5)
+		return
+	}
+
+	return m.CallImmutableFunc(p, p1, p2, p3, p4, p5)
+}
+
+//CallImmutableMinimockCounter returns a count of ContractRequesterMock.CallImmutableFunc invocations
+func (m *ContractRequesterMock) CallImmutableMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.CallImmutableCounter)
+}
+
+//CallImmutableMinimockPreCounter returns the value of ContractRequesterMock.CallImmutable invocations
+func (m *ContractRequesterMock) CallImmutableMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.CallImmutablePreCounter)
+}
+
+//CallImmutableFinished returns true if mock invocations count is ok
+func (m *ContractRequesterMock) CallImmutableFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.CallImmutableMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.CallImmutableCounter) == uint64(len(m.CallImmutableMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.CallImmutableMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.CallImmutableCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.CallImmutableFunc != nil {
+		return atomic.LoadUint64(&m.CallImmutableCounter) > 0
+	}
+
+	return true
+}
+
 type mContractRequesterMockCallMethod struct {
 	mock              *ContractRequesterMock
 	mainExpectation   *ContractRequesterMockCallMethodExpectation
@@ -525,6 +686,10 @@ func (m *ContractRequesterMock) ValidateCallCounters() {
 		m.t.Fatal("Expected call to ContractRequesterMock.CallConstructor")
 	}
 
+	if !m.CallImmutableFinished() {
+		m.t.Fatal("Expected call to ContractRequesterMock.CallImmutable")
+	}
+
 	if !m.CallMethodFinished() {
 		m.t.Fatal("Expected call to ContractRequesterMock.CallMethod")
 	}
@@ -554,6 +719,10 @@ func (m *ContractRequesterMock) MinimockFinish() {
 		m.t.Fatal("Expected call to ContractRequesterMock.CallConstructor")
 	}
 
+	if !m.CallImmutableFinished() {
+		m.t.Fatal("Expected call to ContractRequesterMock.CallImmutable")
+	}
+
 	if !m.CallMethodFinished() {
 		m.t.Fatal("Expected call to ContractRequesterMock.CallMethod")
 	}
@@ -577,6 +746,7 @@ func (m *ContractRequesterMock) MinimockWait(timeout time.Duration) {
 	for {
 		ok := true
 		ok = ok && m.CallConstructorFinished()
+		ok = ok && m.CallImmutableFinished()
 		ok = ok && m.CallMethodFinished()
 		ok = ok && m.SendRequestFinished()
 
@@ -591,6 +761,10 @@ func (m *ContractRequesterMock) MinimockWait(timeout time.Duration) {
 				m.t.Error("Expected call to ContractRequesterMock.CallConstructor")
 			}
 
+			if !m.CallImmutableFinished() {
+				m.t.Error("Expected call to ContractRequesterMock.CallImmutable")
+			}
+
 			if !m.CallMethodFinished() {
 				m.t.Error("Expected call to ContractRequesterMock.CallMethod")
 			}
@@ -615,6 +789,10 @@ func (m *ContractRequesterMock) AllMocksCalled() bool {
 		return false
 	}
 
+	if !m.CallImmutableFinished() {
+		return false
+	}
+
 	if !m.CallMethodFinished() {
 		return false
 	}
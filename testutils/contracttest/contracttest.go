@@ -0,0 +1,488 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package contracttest runs contracts in process over in-memory object store, so contracts can be tested
+// with plain `go test` without ledger, network and compiled plugins.
+//
+// Contracts are executed by their builtin wrappers (see `insgocc wrapper --builtin`) and are called through
+// generated proxies the same way as from other contracts:
+//
+//	h := contracttest.New()
+//	h.Register(*walletproxy.PrototypeReference, wallet.Initialize())
+//	ref, err := h.Create(*walletproxy.PrototypeReference, "New", uint(1000))
+//	balance, err := walletproxy.GetObject(ref).GetBalance()
+package contracttest
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tylerb/gls"
+	"github.com/ugorji/go/codec"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/logicrunner/goplugin/foundation"
+	"github.com/insolar/insolar/logicrunner/goplugin/proxyctx"
+)
+
+// glsCallContextKey is a key of call context in goroutine local storage, see foundation.GetContext
+const glsCallContextKey = "callCtx"
+
+type contract struct {
+	wrapper insolar.ContractWrapper
+	code    insolar.Reference
+}
+
+type object struct {
+	prototype   insolar.Reference
+	parent      insolar.Reference
+	memory      []byte
+	states      []proxyctx.ObjectState
+	children    []insolar.Reference
+	delegates   map[insolar.Reference]insolar.Reference
+	events      []insolar.Event
+	deactivated bool
+	executing   bool
+}
+
+type notification struct {
+	callCtx *insolar.LogicCallContext
+	object  insolar.Reference
+	method  string
+	args    []byte
+	proxy   insolar.Reference
+}
+
+// Harness is an in-memory implementation of proxyctx.ProxyHelper, it isn't safe for concurrent use.
+// Calls made by test code have Caller and CallerPrototype of the harness in context, notifications
+// sent during a call are executed after the call returns.
+type Harness struct {
+	// Caller and CallerPrototype are put into context of calls made by test code
+	Caller          insolar.Reference
+	CallerPrototype insolar.Reference
+	// Time and Pulse are put into context of every call
+	Time  time.Time
+	Pulse insolar.Pulse
+
+	contracts     map[insolar.Reference]*contract
+	objects       map[insolar.Reference]*object
+	notifications []notification
+	seq           uint64
+}
+
+// New creates harness and makes it current proxy helper of contracts
+func New() *Harness {
+	h := &Harness{
+		Time:      time.Now(),
+		Pulse:     insolar.Pulse{PulseNumber: insolar.FirstPulseNumber},
+		contracts: make(map[insolar.Reference]*contract),
+		objects:   make(map[insolar.Reference]*object),
+	}
+	proxyctx.Current = h
+	return h
+}
+
+// Register adds contract with prototype reference used by its proxy
+func (h *Harness) Register(prototype insolar.Reference, wrapper insolar.ContractWrapper) {
+	h.contracts[prototype] = &contract{wrapper: wrapper, code: h.newReference()}
+}
+
+// Create creates an object without parent by constructor of registered contract
+func (h *Harness) Create(prototype insolar.Reference, constructor string, args ...interface{}) (insolar.Reference, error) {
+	var argsSerialized []byte
+	err := h.Serialize(args, &argsSerialized)
+	if err != nil {
+		return insolar.Reference{}, errors.Wrap(err, "[ Create ] can't serialize arguments")
+	}
+	return h.SaveAsChild(insolar.Reference{}, prototype, constructor, argsSerialized)
+}
+
+// State decodes the latest memory of the object into contract structure
+func (h *Harness) State(ref insolar.Reference, into interface{}) error {
+	obj, ok := h.objects[ref]
+	if !ok {
+		return errors.Errorf("object %s not found", ref)
+	}
+	return h.Deserialize(obj.memory, into)
+}
+
+// Active returns false if the object doesn't exist or is deactivated
+func (h *Harness) Active(ref insolar.Reference) bool {
+	obj, ok := h.objects[ref]
+	return ok && !obj.deactivated
+}
+
+// Children returns all children of the object including deactivated ones
+func (h *Harness) Children(ref insolar.Reference) []insolar.Reference {
+	obj, ok := h.objects[ref]
+	if !ok {
+		return nil
+	}
+	return append([]insolar.Reference(nil), obj.children...)
+}
+
+// Events returns events emitted by the object
+func (h *Harness) Events(ref insolar.Reference) []insolar.Event {
+	obj, ok := h.objects[ref]
+	if !ok {
+		return nil
+	}
+	return append([]insolar.Event(nil), obj.events...)
+}
+
+func (h *Harness) newID() *insolar.ID {
+	h.seq++
+	hash := make([]byte, 8)
+	binary.BigEndian.PutUint64(hash, h.seq)
+	return insolar.NewID(h.Pulse.PulseNumber, hash)
+}
+
+func (h *Harness) newReference() insolar.Reference {
+	return *insolar.NewReference(insolar.ID{}, *h.newID())
+}
+
+func currentCallContext() *insolar.LogicCallContext {
+	callCtx, _ := gls.Get(glsCallContextKey).(*insolar.LogicCallContext)
+	return callCtx
+}
+
+// newCallContext makes context of a call made by the current contract or by test code
+func (h *Harness) newCallContext(callee, parent insolar.Reference, c *contract, prototype insolar.Reference) *insolar.LogicCallContext {
+	caller, callerPrototype := h.Caller, h.CallerPrototype
+	if current := currentCallContext(); current != nil {
+		caller, callerPrototype = *current.Callee, *current.Prototype
+	}
+	request := h.newReference()
+	return &insolar.LogicCallContext{
+		Mode:            "execution",
+		Caller:          &caller,
+		CallerPrototype: &callerPrototype,
+		Callee:          &callee,
+		Request:         &request,
+		Prototype:       &prototype,
+		Code:            &c.code,
+		Parent:          &parent,
+		Time:            h.Time,
+		Pulse:           h.Pulse,
+	}
+}
+
+// execute runs f with the call context in goroutine local storage, contract panics are returned as errors
+func (h *Harness) execute(callCtx *insolar.LogicCallContext, f func() error) (err error) {
+	prev := gls.Get(glsCallContextKey)
+	gls.Set(glsCallContextKey, callCtx)
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.New(fmt.Sprint("contract panic: ", r))
+		}
+		if prev == nil {
+			gls.Cleanup()
+		} else {
+			gls.Set(glsCallContextKey, prev)
+		}
+	}()
+
+	return f()
+}
+
+// topLevel runs f and then notifications sent during it if f is called by test code
+func (h *Harness) topLevel(f func() error) error {
+	if currentCallContext() != nil {
+		return f()
+	}
+	err := f()
+	if err != nil {
+		h.notifications = nil
+		return err
+	}
+	return h.sendNotifications()
+}
+
+func (h *Harness) sendNotifications() error {
+	for len(h.notifications) > 0 {
+		n := h.notifications[0]
+		h.notifications = h.notifications[1:]
+
+		err := h.execute(n.callCtx, func() error {
+			_, err := h.call(n.object, n.method, n.args, n.proxy)
+			return err
+		})
+		if err != nil {
+			return errors.Wrapf(err, "notification %s of object %s failed", n.method, n.object)
+		}
+	}
+	return nil
+}
+
+func (h *Harness) construct(parent, prototype insolar.Reference, constructor string, args []byte) (insolar.Reference, error) {
+	c, ok := h.contracts[prototype]
+	if !ok {
+		return insolar.Reference{}, errors.Errorf("contract with prototype %s isn't registered", prototype)
+	}
+	f, ok := c.wrapper.Constructors[constructor]
+	if !ok {
+		return insolar.Reference{}, errors.Errorf("no constructor %s in the contract", constructor)
+	}
+	if !parent.IsEmpty() {
+		if _, ok := h.objects[parent]; !ok {
+			return insolar.Reference{}, errors.Errorf("parent object %s not found", parent)
+		}
+	}
+
+	ref := h.newReference()
+	callCtx := h.newCallContext(ref, parent, c, prototype)
+
+	var memory []byte
+	err := h.execute(callCtx, func() error {
+		var err error
+		memory, err = f(args)
+		return err
+	})
+	if err != nil {
+		return insolar.Reference{}, errors.Wrapf(err, "can't call constructor %s", constructor)
+	}
+
+	h.objects[ref] = &object{
+		prototype: prototype,
+		parent:    parent,
+		memory:    memory,
+		states:    []proxyctx.ObjectState{{State: *h.newID(), Request: *callCtx.Request, Memory: memory}},
+		delegates: make(map[insolar.Reference]insolar.Reference),
+	}
+	if !parent.IsEmpty() {
+		p := h.objects[parent]
+		p.children = append(p.children, ref)
+	}
+	return ref, nil
+}
+
+func (h *Harness) call(ref insolar.Reference, method string, args []byte, proxyPrototype insolar.Reference) ([]byte, error) {
+	obj, ok := h.objects[ref]
+	if !ok {
+		return nil, errors.Errorf("object %s not found", ref)
+	}
+	if obj.deactivated {
+		return nil, insolar.ErrDeactivated
+	}
+	if !proxyPrototype.IsEmpty() && !proxyPrototype.Equal(obj.prototype) {
+		return nil, errors.New("proxy call error: try to call method of prototype as method of another prototype")
+	}
+	if obj.executing {
+		return nil, errors.Errorf("loop detected: object %s is called while executing", ref)
+	}
+	c := h.contracts[obj.prototype]
+	m, ok := c.wrapper.Methods[method]
+	if !ok {
+		return nil, errors.Errorf("no method %s in the contract", method)
+	}
+
+	callCtx := h.newCallContext(ref, obj.parent, c, obj.prototype)
+
+	var newMemory, result []byte
+	obj.executing = true
+	err := h.execute(callCtx, func() error {
+		var err error
+		newMemory, result, err = m(obj.memory, args)
+		return err
+	})
+	obj.executing = false
+	if err != nil {
+		return nil, errors.Wrapf(err, "method %s returned error", method)
+	}
+
+	if !obj.deactivated && !bytes.Equal(obj.memory, newMemory) {
+		obj.memory = newMemory
+		obj.states = append(obj.states, proxyctx.ObjectState{State: *h.newID(), Request: *callCtx.Request, Memory: newMemory})
+	}
+	return result, nil
+}
+
+// RouteCall calls method of the object, notifications are queued until the call made by test code returns
+func (h *Harness) RouteCall(ref insolar.Reference, wait bool, method string, args []byte, proxyPrototype insolar.Reference) ([]byte, error) {
+	if callCtx := currentCallContext(); !wait && callCtx != nil {
+		h.notifications = append(h.notifications, notification{
+			callCtx: callCtx, object: ref, method: method, args: args, proxy: proxyPrototype,
+		})
+		return nil, nil
+	}
+
+	var result []byte
+	err := h.topLevel(func() error {
+		var err error
+		result, err = h.call(ref, method, args, proxyPrototype)
+		return err
+	})
+	return result, err
+}
+
+// SaveAsChild creates a child of the object
+func (h *Harness) SaveAsChild(parentRef, classRef insolar.Reference, constructorName string, argsSerialized []byte) (insolar.Reference, error) {
+	var ref insolar.Reference
+	err := h.topLevel(func() error {
+		var err error
+		ref, err = h.construct(parentRef, classRef, constructorName, argsSerialized)
+		return err
+	})
+	return ref, err
+}
+
+// SaveAsDelegate creates a delegate of the object, an object can have only one delegate of a prototype
+func (h *Harness) SaveAsDelegate(intoRef, classRef insolar.Reference, constructorName string, argsSerialized []byte) (insolar.Reference, error) {
+	into, ok := h.objects[intoRef]
+	if !ok {
+		return insolar.Reference{}, errors.Errorf("object %s not found", intoRef)
+	}
+	if _, ok := into.delegates[classRef]; ok {
+		return insolar.Reference{}, errors.Errorf("object %s already has delegate of prototype %s", intoRef, classRef)
+	}
+	var ref insolar.Reference
+	err := h.topLevel(func() error {
+		var err error
+		ref, err = h.construct(intoRef, classRef, constructorName, argsSerialized)
+		if err == nil {
+			into.delegates[classRef] = ref
+		}
+		return err
+	})
+	return ref, err
+}
+
+// GetObjChildrenIterator returns all active children with the prototype at once
+func (h *Harness) GetObjChildrenIterator(head insolar.Reference, prototype insolar.Reference, iteratorID string) (*proxyctx.ChildrenTypedIterator, error) {
+	obj, ok := h.objects[head]
+	if !ok {
+		return nil, errors.Errorf("object %s not found", head)
+	}
+	iterator := &proxyctx.ChildrenTypedIterator{Parent: head, ChildPrototype: prototype}
+	for _, ref := range obj.children {
+		child := h.objects[ref]
+		if child.deactivated {
+			continue
+		}
+		if prototype.IsEmpty() || prototype.Equal(child.prototype) {
+			iterator.Buff = append(iterator.Buff, ref)
+		}
+	}
+	return iterator, nil
+}
+
+// GetObjStates returns a page of object states starting from provided one (latest if from is nil), latest first,
+// and id of the state to continue from
+func (h *Harness) GetObjStates(head insolar.Reference, from *insolar.ID, limit int) ([]proxyctx.ObjectState, *insolar.ID, error) {
+	obj, ok := h.objects[head]
+	if !ok {
+		return nil, nil, errors.Errorf("object %s not found", head)
+	}
+
+	i := len(obj.states) - 1
+	if from != nil {
+		for i >= 0 && !obj.states[i].State.Equal(*from) {
+			i--
+		}
+		if i < 0 {
+			return nil, nil, errors.Errorf("state %s not found", from)
+		}
+	}
+
+	var states []proxyctx.ObjectState
+	for ; i >= 0 && len(states) < limit; i-- {
+		states = append(states, obj.states[i])
+	}
+	if i < 0 {
+		return states, nil, nil
+	}
+	next := obj.states[i].State
+	return states, &next, nil
+}
+
+// GetDelegate returns delegate of the object with the prototype
+func (h *Harness) GetDelegate(object, ofType insolar.Reference) (insolar.Reference, error) {
+	obj, ok := h.objects[object]
+	if !ok {
+		return insolar.Reference{}, errors.Errorf("object %s not found", object)
+	}
+	ref, ok := obj.delegates[ofType]
+	if !ok {
+		return insolar.Reference{}, errors.Errorf("object %s has no delegate of prototype %s", object, ofType)
+	}
+	return ref, nil
+}
+
+// DeactivateObject deactivates the object, state changed by the current call isn't saved
+func (h *Harness) DeactivateObject(ref insolar.Reference) error {
+	callCtx := currentCallContext()
+	if callCtx == nil {
+		return errors.New("object can be deactivated only by contract")
+	}
+	if !callCtx.Callee.Equal(ref) {
+		return errors.New("only object itself can be deactivated")
+	}
+	obj, ok := h.objects[ref]
+	if !ok {
+		return errors.Errorf("object %s not found", ref)
+	}
+	if obj.deactivated {
+		return insolar.ErrDeactivated
+	}
+	obj.deactivated = true
+	return nil
+}
+
+// UpgradePrototype isn't supported, contracts are registered with wrappers compiled in tests
+func (h *Harness) UpgradePrototype(prototype insolar.Reference, code []byte, machineType insolar.MachineType) (insolar.Reference, error) {
+	return insolar.Reference{}, errors.New("upgrade of prototypes isn't supported by contracttest")
+}
+
+// Emit saves an event emitted by the current object
+func (h *Harness) Emit(name string, payload []byte) error {
+	if name == "" {
+		return errors.New("event name is required")
+	}
+	callCtx := currentCallContext()
+	if callCtx == nil {
+		return errors.New("events can be emitted only by contracts")
+	}
+	obj, ok := h.objects[*callCtx.Callee]
+	if !ok {
+		return errors.New("events can't be emitted by constructors")
+	}
+	obj.events = append(obj.events, insolar.Event{Name: name, Payload: payload})
+	return nil
+}
+
+// Serialize - CBOR serializer wrapper: `what` -> `to`
+func (h *Harness) Serialize(what interface{}, to *[]byte) error {
+	ch := new(codec.CborHandle)
+	return codec.NewEncoderBytes(to, ch).Encode(what)
+}
+
+// Deserialize - CBOR de-serializer wrapper: `from` -> `into`
+func (h *Harness) Deserialize(from []byte, into interface{}) error {
+	ch := new(codec.CborHandle)
+	return codec.NewDecoderBytes(from, ch).Decode(into)
+}
+
+// MakeErrorSerializable converts errors satisfying error interface to foundation.Error
+func (h *Harness) MakeErrorSerializable(e error) error {
+	if e == nil || e == (*foundation.Error)(nil) || reflect.ValueOf(e).IsNil() {
+		return nil
+	}
+	return &foundation.Error{S: e.Error()}
+}
@@ -0,0 +1,608 @@
+package testutils
+
+/*
+DO NOT EDIT!
+This code was generated automatically using github.com/gojuno/minimock v1.9
+The original interface "GenesisDataProvider" can be found in github.com/insolar/insolar/insolar
+*/
+import (
+	context "context"
+	"sync/atomic"
+	"time"
+
+	"github.com/gojuno/minimock"
+	insolar "github.com/insolar/insolar/insolar"
+
+	testify_assert "github.com/stretchr/testify/assert"
+)
+
+//GenesisDataProviderMock implements github.com/insolar/insolar/insolar.GenesisDataProvider
+type GenesisDataProviderMock struct {
+	t minimock.Tester
+
+	GetNodeDomainFunc       func(p context.Context) (r *insolar.Reference, r1 error)
+	GetNodeDomainCounter    uint64
+	GetNodeDomainPreCounter uint64
+	GetNodeDomainMock       mGenesisDataProviderMockGetNodeDomain
+
+	GetRootDomainFunc       func(p context.Context) (r *insolar.Reference)
+	GetRootDomainCounter    uint64
+	GetRootDomainPreCounter uint64
+	GetRootDomainMock       mGenesisDataProviderMockGetRootDomain
+
+	GetRootMemberFunc       func(p context.Context) (r *insolar.Reference, r1 error)
+	GetRootMemberCounter    uint64
+	GetRootMemberPreCounter uint64
+	GetRootMemberMock       mGenesisDataProviderMockGetRootMember
+}
+
+//NewGenesisDataProviderMock returns a mock for github.com/insolar/insolar/insolar.GenesisDataProvider
+func NewGenesisDataProviderMock(t minimock.Tester) *GenesisDataProviderMock {
+	m := &GenesisDataProviderMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetNodeDomainMock = mGenesisDataProviderMockGetNodeDomain{mock: m}
+	m.GetRootDomainMock = mGenesisDataProviderMockGetRootDomain{mock: m}
+	m.GetRootMemberMock = mGenesisDataProviderMockGetRootMember{mock: m}
+
+	return m
+}
+
+type mGenesisDataProviderMockGetNodeDomain struct {
+	mock              *GenesisDataProviderMock
+	mainExpectation   *GenesisDataProviderMockGetNodeDomainExpectation
+	expectationSeries []*GenesisDataProviderMockGetNodeDomainExpectation
+}
+
+type GenesisDataProviderMockGetNodeDomainExpectation struct {
+	input  *GenesisDataProviderMockGetNodeDomainInput
+	result *GenesisDataProviderMockGetNodeDomainResult
+}
+
+type GenesisDataProviderMockGetNodeDomainInput struct {
+	p context.Context
+}
+
+type GenesisDataProviderMockGetNodeDomainResult struct {
+	r  *insolar.Reference
+	r1 error
+}
+
+//Expect specifies that invocation of GenesisDataProvider.GetNodeDomain is expected from 1 to Infinity times
+func (m *mGenesisDataProviderMockGetNodeDomain) Expect(p context.Context) *mGenesisDataProviderMockGetNodeDomain {
+	m.mock.GetNodeDomainFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &GenesisDataProviderMockGetNodeDomainExpectation{}
+	}
+	m.mainExpectation.input = &GenesisDataProviderMockGetNodeDomainInput{p}
+	return m
+}
+
+//Return specifies results of invocation of GenesisDataProvider.GetNodeDomain
+func (m *mGenesisDataProviderMockGetNodeDomain) Return(r *insolar.Reference, r1 error) *GenesisDataProviderMock {
+	m.mock.GetNodeDomainFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &GenesisDataProviderMockGetNodeDomainExpectation{}
+	}
+	m.mainExpectation.result = &GenesisDataProviderMockGetNodeDomainResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of GenesisDataProvider.GetNodeDomain is expected once
+func (m *mGenesisDataProviderMockGetNodeDomain) ExpectOnce(p context.Context) *GenesisDataProviderMockGetNodeDomainExpectation {
+	m.mock.GetNodeDomainFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &GenesisDataProviderMockGetNodeDomainExpectation{}
+	expectation.input = &GenesisDataProviderMockGetNodeDomainInput{p}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *GenesisDataProviderMockGetNodeDomainExpectation) Return(r *insolar.Reference, r1 error) {
+	e.result = &GenesisDataProviderMockGetNodeDomainResult{r, r1}
+}
+
+//Set uses given function f as a mock of GenesisDataProvider.GetNodeDomain method
+func (m *mGenesisDataProviderMockGetNodeDomain) Set(f func(p context.Context) (r *insolar.Reference, r1 error)) *GenesisDataProviderMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.GetNodeDomainFunc = f
+	return m.mock
+}
+
+//GetNodeDomain implements github.com/insolar/insolar/insolar.GenesisDataProvider interface
+func (m *GenesisDataProviderMock) GetNodeDomain(p context.Context) (r *insolar.Reference, r1 error) {
+	counter := atomic.AddUint64(&m.GetNodeDomainPreCounter, 1)
+	defer atomic.AddUint64(&m.GetNodeDomainCounter, 1)
+
+	if len(m.GetNodeDomainMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.GetNodeDomainMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to GenesisDataProviderMock.GetNodeDomain. %v", p)
+			return
+		}
+
+		input := m.GetNodeDomainMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, GenesisDataProviderMockGetNodeDomainInput{p}, "GenesisDataProvider.GetNodeDomain got unexpected parameters")
+
+		result := m.GetNodeDomainMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the GenesisDataProviderMock.GetNodeDomain")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.GetNodeDomainMock.mainExpectation != nil {
+
+		input := m.GetNodeDomainMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, GenesisDataProviderMockGetNodeDomainInput{p}, "GenesisDataProvider.GetNodeDomain got unexpected parameters")
+		}
+
+		result := m.GetNodeDomainMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the GenesisDataProviderMock.GetNodeDomain")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.GetNodeDomainFunc == nil {
+		m.t.Fatalf("Unexpected call to GenesisDataProviderMock.GetNodeDomain. %v", p)
+		return
+	}
+
+	return m.GetNodeDomainFunc(p)
+}
+
+//GetNodeDomainMinimockCounter returns a count of GenesisDataProviderMock.GetNodeDomainFunc invocations
+func (m *GenesisDataProviderMock) GetNodeDomainMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.GetNodeDomainCounter)
+}
+
+//GetNodeDomainMinimockPreCounter returns the value of GenesisDataProviderMock.GetNodeDomain invocations
+func (m *GenesisDataProviderMock) GetNodeDomainMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.GetNodeDomainPreCounter)
+}
+
+//GetNodeDomainFinished returns true if mock invocations count is ok
+func (m *GenesisDataProviderMock) GetNodeDomainFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.GetNodeDomainMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.GetNodeDomainCounter) == uint64(len(m.GetNodeDomainMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.GetNodeDomainMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.GetNodeDomainCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.GetNodeDomainFunc != nil {
+		return atomic.LoadUint64(&m.GetNodeDomainCounter) > 0
+	}
+
+	return true
+}
+
+type mGenesisDataProviderMockGetRootDomain struct {
+	mock              *GenesisDataProviderMock
+	mainExpectation   *GenesisDataProviderMockGetRootDomainExpectation
+	expectationSeries []*GenesisDataProviderMockGetRootDomainExpectation
+}
+
+type GenesisDataProviderMockGetRootDomainExpectation struct {
+	input  *GenesisDataProviderMockGetRootDomainInput
+	result *GenesisDataProviderMockGetRootDomainResult
+}
+
+type GenesisDataProviderMockGetRootDomainInput struct {
+	p context.Context
+}
+
+type GenesisDataProviderMockGetRootDomainResult struct {
+	r *insolar.Reference
+}
+
+//Expect specifies that invocation of GenesisDataProvider.GetRootDomain is expected from 1 to Infinity times
+func (m *mGenesisDataProviderMockGetRootDomain) Expect(p context.Context) *mGenesisDataProviderMockGetRootDomain {
+	m.mock.GetRootDomainFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &GenesisDataProviderMockGetRootDomainExpectation{}
+	}
+	m.mainExpectation.input = &GenesisDataProviderMockGetRootDomainInput{p}
+	return m
+}
+
+//Return specifies results of invocation of GenesisDataProvider.GetRootDomain
+func (m *mGenesisDataProviderMockGetRootDomain) Return(r *insolar.Reference) *GenesisDataProviderMock {
+	m.mock.GetRootDomainFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &GenesisDataProviderMockGetRootDomainExpectation{}
+	}
+	m.mainExpectation.result = &GenesisDataProviderMockGetRootDomainResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of GenesisDataProvider.GetRootDomain is expected once
+func (m *mGenesisDataProviderMockGetRootDomain) ExpectOnce(p context.Context) *GenesisDataProviderMockGetRootDomainExpectation {
+	m.mock.GetRootDomainFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &GenesisDataProviderMockGetRootDomainExpectation{}
+	expectation.input = &GenesisDataProviderMockGetRootDomainInput{p}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *GenesisDataProviderMockGetRootDomainExpectation) Return(r *insolar.Reference) {
+	e.result = &GenesisDataProviderMockGetRootDomainResult{r}
+}
+
+//Set uses given function f as a mock of GenesisDataProvider.GetRootDomain method
+func (m *mGenesisDataProviderMockGetRootDomain) Set(f func(p context.Context) (r *insolar.Reference)) *GenesisDataProviderMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.GetRootDomainFunc = f
+	return m.mock
+}
+
+//GetRootDomain implements github.com/insolar/insolar/insolar.GenesisDataProvider interface
+func (m *GenesisDataProviderMock) GetRootDomain(p context.Context) (r *insolar.Reference) {
+	counter := atomic.AddUint64(&m.GetRootDomainPreCounter, 1)
+	defer atomic.AddUint64(&m.GetRootDomainCounter, 1)
+
+	if len(m.GetRootDomainMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.GetRootDomainMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to GenesisDataProviderMock.GetRootDomain. %v", p)
+			return
+		}
+
+		input := m.GetRootDomainMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, GenesisDataProviderMockGetRootDomainInput{p}, "GenesisDataProvider.GetRootDomain got unexpected parameters")
+
+		result := m.GetRootDomainMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the GenesisDataProviderMock.GetRootDomain")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.GetRootDomainMock.mainExpectation != nil {
+
+		input := m.GetRootDomainMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, GenesisDataProviderMockGetRootDomainInput{p}, "GenesisDataProvider.GetRootDomain got unexpected parameters")
+		}
+
+		result := m.GetRootDomainMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the GenesisDataProviderMock.GetRootDomain")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.GetRootDomainFunc == nil {
+		m.t.Fatalf("Unexpected call to GenesisDataProviderMock.GetRootDomain. %v", p)
+		return
+	}
+
+	return m.GetRootDomainFunc(p)
+}
+
+//GetRootDomainMinimockCounter returns a count of GenesisDataProviderMock.GetRootDomainFunc invocations
+func (m *GenesisDataProviderMock) GetRootDomainMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.GetRootDomainCounter)
+}
+
+//GetRootDomainMinimockPreCounter returns the value of GenesisDataProviderMock.GetRootDomain invocations
+func (m *GenesisDataProviderMock) GetRootDomainMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.GetRootDomainPreCounter)
+}
+
+//GetRootDomainFinished returns true if mock invocations count is ok
+func (m *GenesisDataProviderMock) GetRootDomainFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.GetRootDomainMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.GetRootDomainCounter) == uint64(len(m.GetRootDomainMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.GetRootDomainMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.GetRootDomainCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.GetRootDomainFunc != nil {
+		return atomic.LoadUint64(&m.GetRootDomainCounter) > 0
+	}
+
+	return true
+}
+
+type mGenesisDataProviderMockGetRootMember struct {
+	mock              *GenesisDataProviderMock
+	mainExpectation   *GenesisDataProviderMockGetRootMemberExpectation
+	expectationSeries []*GenesisDataProviderMockGetRootMemberExpectation
+}
+
+type GenesisDataProviderMockGetRootMemberExpectation struct {
+	input  *GenesisDataProviderMockGetRootMemberInput
+	result *GenesisDataProviderMockGetRootMemberResult
+}
+
+type GenesisDataProviderMockGetRootMemberInput struct {
+	p context.Context
+}
+
+type GenesisDataProviderMockGetRootMemberResult struct {
+	r  *insolar.Reference
+	r1 error
+}
+
+//Expect specifies that invocation of GenesisDataProvider.GetRootMember is expected from 1 to Infinity times
+func (m *mGenesisDataProviderMockGetRootMember) Expect(p context.Context) *mGenesisDataProviderMockGetRootMember {
+	m.mock.GetRootMemberFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &GenesisDataProviderMockGetRootMemberExpectation{}
+	}
+	m.mainExpectation.input = &GenesisDataProviderMockGetRootMemberInput{p}
+	return m
+}
+
+//Return specifies results of invocation of GenesisDataProvider.GetRootMember
+func (m *mGenesisDataProviderMockGetRootMember) Return(r *insolar.Reference, r1 error) *GenesisDataProviderMock {
+	m.mock.GetRootMemberFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &GenesisDataProviderMockGetRootMemberExpectation{}
+	}
+	m.mainExpectation.result = &GenesisDataProviderMockGetRootMemberResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of GenesisDataProvider.GetRootMember is expected once
+func (m *mGenesisDataProviderMockGetRootMember) ExpectOnce(p context.Context) *GenesisDataProviderMockGetRootMemberExpectation {
+	m.mock.GetRootMemberFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &GenesisDataProviderMockGetRootMemberExpectation{}
+	expectation.input = &GenesisDataProviderMockGetRootMemberInput{p}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *GenesisDataProviderMockGetRootMemberExpectation) Return(r *insolar.Reference, r1 error) {
+	e.result = &GenesisDataProviderMockGetRootMemberResult{r, r1}
+}
+
+//Set uses given function f as a mock of GenesisDataProvider.GetRootMember method
+func (m *mGenesisDataProviderMockGetRootMember) Set(f func(p context.Context) (r *insolar.Reference, r1 error)) *GenesisDataProviderMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.GetRootMemberFunc = f
+	return m.mock
+}
+
+//GetRootMember implements github.com/insolar/insolar/insolar.GenesisDataProvider interface
+func (m *GenesisDataProviderMock) GetRootMember(p context.Context) (r *insolar.Reference, r1 error) {
+	counter := atomic.AddUint64(&m.GetRootMemberPreCounter, 1)
+	defer atomic.AddUint64(&m.GetRootMemberCounter, 1)
+
+	if len(m.GetRootMemberMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.GetRootMemberMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to GenesisDataProviderMock.GetRootMember. %v", p)
+			return
+		}
+
+		input := m.GetRootMemberMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, GenesisDataProviderMockGetRootMemberInput{p}, "GenesisDataProvider.GetRootMember got unexpected parameters")
+
+		result := m.GetRootMemberMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the GenesisDataProviderMock.GetRootMember")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.GetRootMemberMock.mainExpectation != nil {
+
+		input := m.GetRootMemberMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, GenesisDataProviderMockGetRootMemberInput{p}, "GenesisDataProvider.GetRootMember got unexpected parameters")
+		}
+
+		result := m.GetRootMemberMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the GenesisDataProviderMock.GetRootMember")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.GetRootMemberFunc == nil {
+		m.t.Fatalf("Unexpected call to GenesisDataProviderMock.GetRootMember. %v", p)
+		return
+	}
+
+	return m.GetRootMemberFunc(p)
+}
+
+//GetRootMemberMinimockCounter returns a count of GenesisDataProviderMock.GetRootMemberFunc invocations
+func (m *GenesisDataProviderMock) GetRootMemberMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.GetRootMemberCounter)
+}
+
+//GetRootMemberMinimockPreCounter returns the value of GenesisDataProviderMock.GetRootMember invocations
+func (m *GenesisDataProviderMock) GetRootMemberMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.GetRootMemberPreCounter)
+}
+
+//GetRootMemberFinished returns true if mock invocations count is ok
+func (m *GenesisDataProviderMock) GetRootMemberFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.GetRootMemberMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.GetRootMemberCounter) == uint64(len(m.GetRootMemberMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.GetRootMemberMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.GetRootMemberCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.GetRootMemberFunc != nil {
+		return atomic.LoadUint64(&m.GetRootMemberCounter) > 0
+	}
+
+	return true
+}
+
+//ValidateCallCounters checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *GenesisDataProviderMock) ValidateCallCounters() {
+
+	if !m.GetNodeDomainFinished() {
+		m.t.Fatal("Expected call to GenesisDataProviderMock.GetNodeDomain")
+	}
+
+	if !m.GetRootDomainFinished() {
+		m.t.Fatal("Expected call to GenesisDataProviderMock.GetRootDomain")
+	}
+
+	if !m.GetRootMemberFinished() {
+		m.t.Fatal("Expected call to GenesisDataProviderMock.GetRootMember")
+	}
+
+}
+
+//CheckMocksCalled checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *GenesisDataProviderMock) CheckMocksCalled() {
+	m.Finish()
+}
+
+//Finish checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish or use Finish method of minimock.Controller
+func (m *GenesisDataProviderMock) Finish() {
+	m.MinimockFinish()
+}
+
+//MinimockFinish checks that all mocked methods of the interface have been called at least once
+func (m *GenesisDataProviderMock) MinimockFinish() {
+
+	if !m.GetNodeDomainFinished() {
+		m.t.Fatal("Expected call to GenesisDataProviderMock.GetNodeDomain")
+	}
+
+	if !m.GetRootDomainFinished() {
+		m.t.Fatal("Expected call to GenesisDataProviderMock.GetRootDomain")
+	}
+
+	if !m.GetRootMemberFinished() {
+		m.t.Fatal("Expected call to GenesisDataProviderMock.GetRootMember")
+	}
+
+}
+
+//Wait waits for all mocked methods to be called at least once
+//Deprecated: please use MinimockWait or use Wait method of minimock.Controller
+func (m *GenesisDataProviderMock) Wait(timeout time.Duration) {
+	m.MinimockWait(timeout)
+}
+
+//MinimockWait waits for all mocked methods to be called at least once
+//this method is called by minimock.Controller
+func (m *GenesisDataProviderMock) MinimockWait(timeout time.Duration) {
+	timeoutCh := time.After(timeout)
+	for {
+		ok := true
+		ok = ok && m.GetNodeDomainFinished()
+		ok = ok && m.GetRootDomainFinished()
+		ok = ok && m.GetRootMemberFinished()
+
+		if ok {
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+
+			if !m.GetNodeDomainFinished() {
+				m.t.Error("Expected call to GenesisDataProviderMock.GetNodeDomain")
+			}
+
+			if !m.GetRootDomainFinished() {
+				m.t.Error("Expected call to GenesisDataProviderMock.GetRootDomain")
+			}
+
+			if !m.GetRootMemberFinished() {
+				m.t.Error("Expected call to GenesisDataProviderMock.GetRootMember")
+			}
+
+			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+//AllMocksCalled returns true if all mocked methods were called before the execution of AllMocksCalled,
+//it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
+func (m *GenesisDataProviderMock) AllMocksCalled() bool {
+
+	if !m.GetNodeDomainFinished() {
+		return false
+	}
+
+	if !m.GetRootDomainFinished() {
+		return false
+	}
+
+	if !m.GetRootMemberFinished() {
+		return false
+	}
+
+	return true
+}
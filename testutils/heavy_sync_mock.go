@@ -20,17 +20,27 @@ import (
 type HeavySyncMock struct {
 	t minimock.Tester
 
+	AckChunkFunc       func(p context.Context, p1 insolar.ID, p2 insolar.PulseNumber, p3 uint32) (r uint32, r1 error)
+	AckChunkCounter    uint64
+	AckChunkPreCounter uint64
+	AckChunkMock       mHeavySyncMockAckChunk
+
+	NextChunkFunc       func(p context.Context, p1 insolar.ID, p2 insolar.PulseNumber) (r uint32, r1 error)
+	NextChunkCounter    uint64
+	NextChunkPreCounter uint64
+	NextChunkMock       mHeavySyncMockNextChunk
+
 	ResetFunc       func(p context.Context, p1 insolar.ID, p2 insolar.PulseNumber) (r error)
 	ResetCounter    uint64
 	ResetPreCounter uint64
 	ResetMock       mHeavySyncMockReset
 
-	StartFunc       func(p context.Context, p1 insolar.ID, p2 insolar.PulseNumber) (r error)
+	StartFunc       func(p context.Context, p1 insolar.ID, p2 insolar.PulseNumber, p3 []byte) (r uint32, r1 error)
 	StartCounter    uint64
 	StartPreCounter uint64
 	StartMock       mHeavySyncMockStart
 
-	StopFunc       func(p context.Context, p1 insolar.ID, p2 insolar.PulseNumber) (r error)
+	StopFunc       func(p context.Context, p1 insolar.ID, p2 insolar.PulseNumber, p3 uint32) (r error)
 	StopCounter    uint64
 	StopPreCounter uint64
 	StopMock       mHeavySyncMockStop
@@ -64,6 +74,8 @@ func NewHeavySyncMock(t minimock.Tester) *HeavySyncMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.AckChunkMock = mHeavySyncMockAckChunk{mock: m}
+	m.NextChunkMock = mHeavySyncMockNextChunk{mock: m}
 	m.ResetMock = mHeavySyncMockReset{mock: m}
 	m.StartMock = mHeavySyncMockStart{mock: m}
 	m.StopMock = mHeavySyncMockStop{mock: m}
@@ -75,6 +87,311 @@ func NewHeavySyncMock(t minimock.Tester) *HeavySyncMock {
 	return m
 }
 
+type mHeavySyncMockAckChunk struct {
+	mock              *HeavySyncMock
+	mainExpectation   *HeavySyncMockAckChunkExpectation
+	expectationSeries []*HeavySyncMockAckChunkExpectation
+}
+
+type HeavySyncMockAckChunkExpectation struct {
+	input  *HeavySyncMockAckChunkInput
+	result *HeavySyncMockAckChunkResult
+}
+
+type HeavySyncMockAckChunkInput struct {
+	p  context.Context
+	p1 insolar.ID
+	p2 insolar.PulseNumber
+	p3 uint32
+}
+
+type HeavySyncMockAckChunkResult struct {
+	r  uint32
+	r1 error
+}
+
+//Expect specifies that invocation of HeavySync.AckChunk is expected from 1 to Infinity times
+func (m *mHeavySyncMockAckChunk) Expect(p context.Context, p1 insolar.ID, p2 insolar.PulseNumber, p3 uint32) *mHeavySyncMockAckChunk {
+	m.mock.AckChunkFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &HeavySyncMockAckChunkExpectation{}
+	}
+	m.mainExpectation.input = &HeavySyncMockAckChunkInput{p, p1, p2, p3}
+	return m
+}
+
+//Return specifies results of invocation of HeavySync.AckChunk
+func (m *mHeavySyncMockAckChunk) Return(r uint32, r1 error) *HeavySyncMock {
+	m.mock.AckChunkFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &HeavySyncMockAckChunkExpectation{}
+	}
+	m.mainExpectation.result = &HeavySyncMockAckChunkResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of HeavySync.AckChunk is expected once
+func (m *mHeavySyncMockAckChunk) ExpectOnce(p context.Context, p1 insolar.ID, p2 insolar.PulseNumber, p3 uint32) *HeavySyncMockAckChunkExpectation {
+	m.mock.AckChunkFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &HeavySyncMockAckChunkExpectation{}
+	expectation.input = &HeavySyncMockAckChunkInput{p, p1, p2, p3}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *HeavySyncMockAckChunkExpectation) Return(r uint32, r1 error) {
+	e.result = &HeavySyncMockAckChunkResult{r, r1}
+}
+
+//Set uses given function f as a mock of HeavySync.AckChunk method
+func (m *mHeavySyncMockAckChunk) Set(f func(p context.Context, p1 insolar.ID, p2 insolar.PulseNumber, p3 uint32) (r uint32, r1 error)) *HeavySyncMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.AckChunkFunc = f
+	return m.mock
+}
+
+//AckChunk implements github.com/insolar/insolar/insolar.HeavySync interface
+func (m *HeavySyncMock) AckChunk(p context.Context, p1 insolar.ID, p2 insolar.PulseNumber, p3 uint32) (r uint32, r1 error) {
+	counter := atomic.AddUint64(&m.AckChunkPreCounter, 1)
+	defer atomic.AddUint64(&m.AckChunkCounter, 1)
+
+	if len(m.AckChunkMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.AckChunkMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to HeavySyncMock.AckChunk. %v %v %v %v", p, p1, p2, p3)
+			return
+		}
+
+		input := m.AckChunkMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, HeavySyncMockAckChunkInput{p, p1, p2, p3}, "HeavySync.AckChunk got unexpected parameters")
+
+		result := m.AckChunkMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the HeavySyncMock.AckChunk")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.AckChunkMock.mainExpectation != nil {
+
+		input := m.AckChunkMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, HeavySyncMockAckChunkInput{p, p1, p2, p3}, "HeavySync.AckChunk got unexpected parameters")
+		}
+
+		result := m.AckChunkMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the HeavySyncMock.AckChunk")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.AckChunkFunc == nil {
+		m.t.Fatalf("Unexpected call to HeavySyncMock.AckChunk. %v %v %v %v", p, p1, p2, p3)
+		return
+	}
+
+	return m.AckChunkFunc(p, p1, p2, p3)
+}
+
+//AckChunkMinimockCounter returns a count of HeavySyncMock.AckChunkFunc invocations
+func (m *HeavySyncMock) AckChunkMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.AckChunkCounter)
+}
+
+//AckChunkMinimockPreCounter returns the value of HeavySyncMock.AckChunk invocations
+func (m *HeavySyncMock) AckChunkMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.AckChunkPreCounter)
+}
+
+//AckChunkFinished returns true if mock invocations count is ok
+func (m *HeavySyncMock) AckChunkFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.AckChunkMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.AckChunkCounter) == uint64(len(m.AckChunkMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.AckChunkMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.AckChunkCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.AckChunkFunc != nil {
+		return atomic.LoadUint64(&m.AckChunkCounter) > 0
+	}
+
+	return true
+}
+
+type mHeavySyncMockNextChunk struct {
+	mock              *HeavySyncMock
+	mainExpectation   *HeavySyncMockNextChunkExpectation
+	expectationSeries []*HeavySyncMockNextChunkExpectation
+}
+
+type HeavySyncMockNextChunkExpectation struct {
+	input  *HeavySyncMockNextChunkInput
+	result *HeavySyncMockNextChunkResult
+}
+
+type HeavySyncMockNextChunkInput struct {
+	p  context.Context
+	p1 insolar.ID
+	p2 insolar.PulseNumber
+}
+
+type HeavySyncMockNextChunkResult struct {
+	r  uint32
+	r1 error
+}
+
+//Expect specifies that invocation of HeavySync.NextChunk is expected from 1 to Infinity times
+func (m *mHeavySyncMockNextChunk) Expect(p context.Context, p1 insolar.ID, p2 insolar.PulseNumber) *mHeavySyncMockNextChunk {
+	m.mock.NextChunkFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &HeavySyncMockNextChunkExpectation{}
+	}
+	m.mainExpectation.input = &HeavySyncMockNextChunkInput{p, p1, p2}
+	return m
+}
+
+//Return specifies results of invocation of HeavySync.NextChunk
+func (m *mHeavySyncMockNextChunk) Return(r uint32, r1 error) *HeavySyncMock {
+	m.mock.NextChunkFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &HeavySyncMockNextChunkExpectation{}
+	}
+	m.mainExpectation.result = &HeavySyncMockNextChunkResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of HeavySync.NextChunk is expected once
+func (m *mHeavySyncMockNextChunk) ExpectOnce(p context.Context, p1 insolar.ID, p2 insolar.PulseNumber) *HeavySyncMockNextChunkExpectation {
+	m.mock.NextChunkFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &HeavySyncMockNextChunkExpectation{}
+	expectation.input = &HeavySyncMockNextChunkInput{p, p1, p2}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *HeavySyncMockNextChunkExpectation) Return(r uint32, r1 error) {
+	e.result = &HeavySyncMockNextChunkResult{r, r1}
+}
+
+//Set uses given function f as a mock of HeavySync.NextChunk method
+func (m *mHeavySyncMockNextChunk) Set(f func(p context.Context, p1 insolar.ID, p2 insolar.PulseNumber) (r uint32, r1 error)) *HeavySyncMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.NextChunkFunc = f
+	return m.mock
+}
+
+//NextChunk implements github.com/insolar/insolar/insolar.HeavySync interface
+func (m *HeavySyncMock) NextChunk(p context.Context, p1 insolar.ID, p2 insolar.PulseNumber) (r uint32, r1 error) {
+	counter := atomic.AddUint64(&m.NextChunkPreCounter, 1)
+	defer atomic.AddUint64(&m.NextChunkCounter, 1)
+
+	if len(m.NextChunkMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.NextChunkMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to HeavySyncMock.NextChunk. %v %v %v", p, p1, p2)
+			return
+		}
+
+		input := m.NextChunkMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, HeavySyncMockNextChunkInput{p, p1, p2}, "HeavySync.NextChunk got unexpected parameters")
+
+		result := m.NextChunkMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the HeavySyncMock.NextChunk")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.NextChunkMock.mainExpectation != nil {
+
+		input := m.NextChunkMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, HeavySyncMockNextChunkInput{p, p1, p2}, "HeavySync.NextChunk got unexpected parameters")
+		}
+
+		result := m.NextChunkMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the HeavySyncMock.NextChunk")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.NextChunkFunc == nil {
+		m.t.Fatalf("Unexpected call to HeavySyncMock.NextChunk. %v %v %v", p, p1, p2)
+		return
+	}
+
+	return m.NextChunkFunc(p, p1, p2)
+}
+
+//NextChunkMinimockCounter returns a count of HeavySyncMock.NextChunkFunc invocations
+func (m *HeavySyncMock) NextChunkMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.NextChunkCounter)
+}
+
+//NextChunkMinimockPreCounter returns the value of HeavySyncMock.NextChunk invocations
+func (m *HeavySyncMock) NextChunkMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.NextChunkPreCounter)
+}
+
+//NextChunkFinished returns true if mock invocations count is ok
+func (m *HeavySyncMock) NextChunkFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.NextChunkMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.NextChunkCounter) == uint64(len(m.NextChunkMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.NextChunkMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.NextChunkCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.NextChunkFunc != nil {
+		return atomic.LoadUint64(&m.NextChunkCounter) > 0
+	}
+
+	return true
+}
+
 type mHeavySyncMockReset struct {
 	mock              *HeavySyncMock
 	mainExpectation   *HeavySyncMockResetExpectation
@@ -239,53 +556,55 @@ type HeavySyncMockStartInput struct {
 	p  context.Context
 	p1 insolar.ID
 	p2 insolar.PulseNumber
+	p3 []byte
 }
 
 type HeavySyncMockStartResult struct {
-	r error
+	r  uint32
+	r1 error
 }
 
 //Expect specifies that invocation of HeavySync.Start is expected from 1 to Infinity times
-func (m *mHeavySyncMockStart) Expect(p context.Context, p1 insolar.ID, p2 insolar.PulseNumber) *mHeavySyncMockStart {
+func (m *mHeavySyncMockStart) Expect(p context.Context, p1 insolar.ID, p2 insolar.PulseNumber, p3 []byte) *mHeavySyncMockStart {
 	m.mock.StartFunc = nil
 	m.expectationSeries = nil
 
 	if m.mainExpectation == nil {
 		m.mainExpectation = &HeavySyncMockStartExpectation{}
 	}
-	m.mainExpectation.input = &HeavySyncMockStartInput{p, p1, p2}
+	m.mainExpectation.input = &HeavySyncMockStartInput{p, p1, p2, p3}
 	return m
 }
 
 //Return specifies results of invocation of HeavySync.Start
-func (m *mHeavySyncMockStart) Return(r error) *HeavySyncMock {
+func (m *mHeavySyncMockStart) Return(r uint32, r1 error) *HeavySyncMock {
 	m.mock.StartFunc = nil
 	m.expectationSeries = nil
 
 	if m.mainExpectation == nil {
 		m.mainExpectation = &HeavySyncMockStartExpectation{}
 	}
-	m.mainExpectation.result = &HeavySyncMockStartResult{r}
+	m.mainExpectation.result = &HeavySyncMockStartResult{r, r1}
 	return m.mock
 }
 
 //ExpectOnce specifies that invocation of HeavySync.Start is expected once
-func (m *mHeavySyncMockStart) ExpectOnce(p context.Context, p1 insolar.ID, p2 insolar.PulseNumber) *HeavySyncMockStartExpectation {
+func (m *mHeavySyncMockStart) ExpectOnce(p context.Context, p1 insolar.ID, p2 insolar.PulseNumber, p3 []byte) *HeavySyncMockStartExpectation {
 	m.mock.StartFunc = nil
 	m.mainExpectation = nil
 
 	expectation := &HeavySyncMockStartExpectation{}
-	expectation.input = &HeavySyncMockStartInput{p, p1, p2}
+	expectation.input = &HeavySyncMockStartInput{p, p1, p2, p3}
 	m.expectationSeries = append(m.expectationSeries, expectation)
 	return expectation
 }
 
-func (e *HeavySyncMockStartExpectation) Return(r error) {
-	e.result = &HeavySyncMockStartResult{r}
+func (e *HeavySyncMockStartExpectation) Return(r uint32, r1 error) {
+	e.result = &HeavySyncMockStartResult{r, r1}
 }
 
 //Set uses given function f as a mock of HeavySync.Start method
-func (m *mHeavySyncMockStart) Set(f func(p context.Context, p1 insolar.ID, p2 insolar.PulseNumber) (r error)) *HeavySyncMock {
+func (m *mHeavySyncMockStart) Set(f func(p context.Context, p1 insolar.ID, p2 insolar.PulseNumber, p3 []byte) (r uint32, r1 error)) *HeavySyncMock {
 	m.mainExpectation = nil
 	m.expectationSeries = nil
 
@@ -294,18 +613,18 @@ func (m *mHeavySyncMockStart) Set(f func(p context.Context, p1 insolar.ID, p2 in
 }
 
 //Start implements github.com/insolar/insolar/insolar.HeavySync interface
-func (m *HeavySyncMock) Start(p context.Context, p1 insolar.ID, p2 insolar.PulseNumber) (r error) {
+func (m *HeavySyncMock) Start(p context.Context, p1 insolar.ID, p2 insolar.PulseNumber, p3 []byte) (r uint32, r1 error) {
 	counter := atomic.AddUint64(&m.StartPreCounter, 1)
 	defer atomic.AddUint64(&m.StartCounter, 1)
 
 	if len(m.StartMock.expectationSeries) > 0 {
 		if counter > uint64(len(m.StartMock.expectationSeries)) {
-			m.t.Fatalf("Unexpected call to HeavySyncMock.Start. %v %v %v", p, p1, p2)
+			m.t.Fatalf("Unexpected call to HeavySyncMock.Start. %v %v %v %v", p, p1, p2, p3)
 			return
 		}
 
 		input := m.StartMock.expectationSeries[counter-1].input
-		testify_assert.Equal(m.t, *input, HeavySyncMockStartInput{p, p1, p2}, "HeavySync.Start got unexpected parameters")
+		testify_assert.Equal(m.t, *input, HeavySyncMockStartInput{p, p1, p2, p3}, "HeavySync.Start got unexpected parameters")
 
 		result := m.StartMock.expectationSeries[counter-1].result
 		if result == nil {
@@ -314,6 +633,7 @@ func (m *HeavySyncMock) Start(p context.Context, p1 insolar.ID, p2 insolar.Pulse
 		}
 
 		r = result.r
+		r1 = result.r1
 
 		return
 	}
@@ -322,7 +642,7 @@ func (m *HeavySyncMock) Start(p context.Context, p1 insolar.ID, p2 insolar.Pulse
 
 		input := m.StartMock.mainExpectation.input
 		if input != nil {
-			testify_assert.Equal(m.t, *input, HeavySyncMockStartInput{p, p1, p2}, "HeavySync.Start got unexpected parameters")
+			testify_assert.Equal(m.t, *input, HeavySyncMockStartInput{p, p1, p2, p3}, "HeavySync.Start got unexpected parameters")
 		}
 
 		result := m.StartMock.mainExpectation.result
@@ -331,16 +651,17 @@ func (m *HeavySyncMock) Start(p context.Context, p1 insolar.ID, p2 insolar.Pulse
 		}
 
 		r = result.r
+		r1 = result.r1
 
 		return
 	}
 
 	if m.StartFunc == nil {
-		m.t.Fatalf("Unexpected call to HeavySyncMock.Start. %v %v %v", p, p1, p2)
+		m.t.Fatalf("Unexpected call to HeavySyncMock.Start. %v %v %v %v", p, p1, p2, p3)
 		return
 	}
 
-	return m.StartFunc(p, p1, p2)
+	return m.StartFunc(p, p1, p2, p3)
 }
 
 //StartMinimockCounter returns a count of HeavySyncMock.StartFunc invocations
@@ -388,6 +709,7 @@ type HeavySyncMockStopInput struct {
 	p  context.Context
 	p1 insolar.ID
 	p2 insolar.PulseNumber
+	p3 uint32
 }
 
 type HeavySyncMockStopResult struct {
@@ -395,14 +717,14 @@ type HeavySyncMockStopResult struct {
 }
 
 //Expect specifies that invocation of HeavySync.Stop is expected from 1 to Infinity times
-func (m *mHeavySyncMockStop) Expect(p context.Context, p1 insolar.ID, p2 insolar.PulseNumber) *mHeavySyncMockStop {
+func (m *mHeavySyncMockStop) Expect(p context.Context, p1 insolar.ID, p2 insolar.PulseNumber, p3 uint32) *mHeavySyncMockStop {
 	m.mock.StopFunc = nil
 	m.expectationSeries = nil
 
 	if m.mainExpectation == nil {
 		m.mainExpectation = &HeavySyncMockStopExpectation{}
 	}
-	m.mainExpectation.input = &HeavySyncMockStopInput{p, p1, p2}
+	m.mainExpectation.input = &HeavySyncMockStopInput{p, p1, p2, p3}
 	return m
 }
 
@@ -419,12 +741,12 @@ func (m *mHeavySyncMockStop) Return(r error) *HeavySyncMock {
 }
 
 //ExpectOnce specifies that invocation of HeavySync.Stop is expected once
-func (m *mHeavySyncMockStop) ExpectOnce(p context.Context, p1 insolar.ID, p2 insolar.PulseNumber) *HeavySyncMockStopExpectation {
+func (m *mHeavySyncMockStop) ExpectOnce(p context.Context, p1 insolar.ID, p2 insolar.PulseNumber, p3 uint32) *HeavySyncMockStopExpectation {
 	m.mock.StopFunc = nil
 	m.mainExpectation = nil
 
 	expectation := &HeavySyncMockStopExpectation{}
-	expectation.input = &HeavySyncMockStopInput{p, p1, p2}
+	expectation.input = &HeavySyncMockStopInput{p, p1, p2, p3}
 	m.expectationSeries = append(m.expectationSeries, expectation)
 	return expectation
 }
@@ -434,7 +756,7 @@ func (e *HeavySyncMockStopExpectation) Return(r error) {
 }
 
 //Set uses given function f as a mock of HeavySync.Stop method
-func (m *mHeavySyncMockStop) Set(f func(p context.Context, p1 insolar.ID, p2 insolar.PulseNumber) (r error)) *HeavySyncMock {
+func (m *mHeavySyncMockStop) Set(f func(p context.Context, p1 insolar.ID, p2 insolar.PulseNumber, p3 uint32) (r error)) *HeavySyncMock {
 	m.mainExpectation = nil
 	m.expectationSeries = nil
 
@@ -443,18 +765,18 @@ func (m *mHeavySyncMockStop) Set(f func(p context.Context, p1 insolar.ID, p2 ins
 }
 
 //Stop implements github.com/insolar/insolar/insolar.HeavySync interface
-func (m *HeavySyncMock) Stop(p context.Context, p1 insolar.ID, p2 insolar.PulseNumber) (r error) {
+func (m *HeavySyncMock) Stop(p context.Context, p1 insolar.ID, p2 insolar.PulseNumber, p3 uint32) (r error) {
 	counter := atomic.AddUint64(&m.StopPreCounter, 1)
 	defer atomic.AddUint64(&m.StopCounter, 1)
 
 	if len(m.StopMock.expectationSeries) > 0 {
 		if counter > uint64(len(m.StopMock.expectationSeries)) {
-			m.t.Fatalf("Unexpected call to HeavySyncMock.Stop. %v %v %v", p, p1, p2)
+			m.t.Fatalf("Unexpected call to HeavySyncMock.Stop. %v %v %v %v", p, p1, p2, p3)
 			return
 		}
 
 		input := m.StopMock.expectationSeries[counter-1].input
-		testify_assert.Equal(m.t, *input, HeavySyncMockStopInput{p, p1, p2}, "HeavySync.Stop got unexpected parameters")
+		testify_assert.Equal(m.t, *input, HeavySyncMockStopInput{p, p1, p2, p3}, "HeavySync.Stop got unexpected parameters")
 
 		result := m.StopMock.expectationSeries[counter-1].result
 		if result == nil {
@@ -471,7 +793,7 @@ func (m *HeavySyncMock) Stop(p context.Context, p1 insolar.ID, p2 insolar.PulseN
 
 		input := m.StopMock.mainExpectation.input
 		if input != nil {
-			testify_assert.Equal(m.t, *input, HeavySyncMockStopInput{p, p1, p2}, "HeavySync.Stop got unexpected parameters")
+			testify_assert.Equal(m.t, *input, HeavySyncMockStopInput{p, p1, p2, p3}, "HeavySync.Stop got unexpected parameters")
 		}
 
 		result := m.StopMock.mainExpectation.result
@@ -485,11 +807,11 @@ func (m *HeavySyncMock) Stop(p context.Context, p1 insolar.ID, p2 insolar.PulseN
 	}
 
 	if m.StopFunc == nil {
-		m.t.Fatalf("Unexpected call to HeavySyncMock.Stop. %v %v %v", p, p1, p2)
+		m.t.Fatalf("Unexpected call to HeavySyncMock.Stop. %v %v %v %v", p, p1, p2, p3)
 		return
 	}
 
-	return m.StopFunc(p, p1, p2)
+	return m.StopFunc(p, p1, p2, p3)
 }
 
 //StopMinimockCounter returns a count of HeavySyncMock.StopFunc invocations
@@ -1100,6 +1422,14 @@ func (m *HeavySyncMock) StoreRecordsFinished() bool {
 //Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
 func (m *HeavySyncMock) ValidateCallCounters() {
 
+	if !m.AckChunkFinished() {
+		m.t.Fatal("Expected call to HeavySyncMock.AckChunk")
+	}
+
+	if !m.NextChunkFinished() {
+		m.t.Fatal("Expected call to HeavySyncMock.NextChunk")
+	}
+
 	if !m.ResetFinished() {
 		m.t.Fatal("Expected call to HeavySyncMock.Reset")
 	}
@@ -1145,6 +1475,14 @@ func (m *HeavySyncMock) Finish() {
 //MinimockFinish checks that all mocked methods of the interface have been called at least once
 func (m *HeavySyncMock) MinimockFinish() {
 
+	if !m.AckChunkFinished() {
+		m.t.Fatal("Expected call to HeavySyncMock.AckChunk")
+	}
+
+	if !m.NextChunkFinished() {
+		m.t.Fatal("Expected call to HeavySyncMock.NextChunk")
+	}
+
 	if !m.ResetFinished() {
 		m.t.Fatal("Expected call to HeavySyncMock.Reset")
 	}
@@ -1187,6 +1525,8 @@ func (m *HeavySyncMock) MinimockWait(timeout time.Duration) {
 	timeoutCh := time.After(timeout)
 	for {
 		ok := true
+		ok = ok && m.AckChunkFinished()
+		ok = ok && m.NextChunkFinished()
 		ok = ok && m.ResetFinished()
 		ok = ok && m.StartFinished()
 		ok = ok && m.StopFinished()
@@ -1242,6 +1582,14 @@ func (m *HeavySyncMock) MinimockWait(timeout time.Duration) {
 //it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
 func (m *HeavySyncMock) AllMocksCalled() bool {
 
+	if !m.AckChunkFinished() {
+		return false
+	}
+
+	if !m.NextChunkFinished() {
+		return false
+	}
+
 	if !m.ResetFinished() {
 		return false
 	}
@@ -0,0 +1,108 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package compression provides codecs used to compress replicated data.
+package compression
+
+import (
+	"bytes"
+	"compress/flate"
+	"io/ioutil"
+
+	"github.com/pkg/errors"
+)
+
+// Supported compression names.
+const (
+	None  = "none"
+	Flate = "flate"
+)
+
+// Codec compresses and decompresses data.
+type Codec interface {
+	Name() string
+	Compress(src []byte) ([]byte, error)
+	Decompress(src []byte) ([]byte, error)
+}
+
+var codecs = map[string]Codec{
+	None:  noneCodec{},
+	Flate: flateCodec{},
+}
+
+// Get returns codec by its name. Empty name means no compression.
+func Get(name string) (Codec, error) {
+	if name == "" {
+		name = None
+	}
+	c, ok := codecs[name]
+	if !ok {
+		return nil, errors.Errorf("unknown compression %q", name)
+	}
+	return c, nil
+}
+
+// Negotiate returns the first of offered compressions supported by this node.
+// It returns None if there is no such compression.
+func Negotiate(offered []string) string {
+	for _, name := range offered {
+		if _, ok := codecs[name]; ok {
+			return name
+		}
+	}
+	return None
+}
+
+type noneCodec struct{}
+
+func (noneCodec) Name() string {
+	return None
+}
+
+func (noneCodec) Compress(src []byte) ([]byte, error) {
+	return src, nil
+}
+
+func (noneCodec) Decompress(src []byte) ([]byte, error) {
+	return src, nil
+}
+
+type flateCodec struct{}
+
+func (flateCodec) Name() string {
+	return Flate
+}
+
+func (flateCodec) Compress(src []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w, err := flate.NewWriter(&buf, flate.BestSpeed)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(src); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (flateCodec) Decompress(src []byte) ([]byte, error) {
+	r := flate.NewReader(bytes.NewReader(src))
+	defer r.Close()
+	return ioutil.ReadAll(r)
+}
@@ -0,0 +1,61 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package compression
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodecs_RoundTrip(t *testing.T) {
+	data := bytes.Repeat([]byte("insolar heavy replication "), 100)
+
+	for _, name := range []string{None, Flate} {
+		c, err := Get(name)
+		require.NoError(t, err)
+		assert.Equal(t, name, c.Name())
+
+		compressed, err := c.Compress(data)
+		require.NoError(t, err)
+		if name != None {
+			assert.True(t, len(compressed) < len(data), "%s should compress repeated data", name)
+		}
+
+		decompressed, err := c.Decompress(compressed)
+		require.NoError(t, err)
+		assert.Equal(t, data, decompressed)
+	}
+}
+
+func TestGet(t *testing.T) {
+	c, err := Get("")
+	require.NoError(t, err)
+	assert.Equal(t, None, c.Name())
+
+	_, err = Get("lzma")
+	assert.Error(t, err)
+}
+
+func TestNegotiate(t *testing.T) {
+	assert.Equal(t, Flate, Negotiate([]string{Flate, None}))
+	assert.Equal(t, Flate, Negotiate([]string{"lzma", Flate}))
+	assert.Equal(t, None, Negotiate([]string{"lzma"}))
+	assert.Equal(t, None, Negotiate(nil))
+}